@@ -4,12 +4,15 @@ import (
 	_ "gfly/app/console/commands"  // Autoload commands into pool.
 	_ "gfly/app/console/queues"    // Autoload tasks into queue.
 	_ "gfly/app/console/schedules" // Autoload jobs into schedule.
+	_ "gfly/app/listeners"         // Autoload listeners of the domain events.
 	"github.com/gflydev/cache"
 	cacheRedis "github.com/gflydev/cache/redis"
 	"github.com/gflydev/console"
 	mb "github.com/gflydev/db"
 	dbPSQL "github.com/gflydev/db/psql"
 	notificationMail "github.com/gflydev/notification/mail"
+	"github.com/gflydev/storage"
+	storageLocal "github.com/gflydev/storage/local"
 	_ "github.com/joho/godotenv/autoload" // load .env file automatically
 	"os"
 )
@@ -18,6 +21,9 @@ func main() {
 	// Register mail notification
 	notificationMail.AutoRegister()
 
+	// Register Local storage
+	storage.Register(storageLocal.Type, storageLocal.New())
+
 	// Register Redis cache
 	cache.Register(cacheRedis.New())
 
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"gfly/app/dto"
+	"gfly/app/notifications"
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/notification"
+	"strings"
+	"time"
+)
+
+// ---------------------------------------------------------------
+//                      Register command.
+// ./artisan cmd:run content:export --format=zip
+// ./artisan cmd:run content:export --format=jsonl --email=admin@gfly.dev
+// ---------------------------------------------------------------
+
+// Auto-register command.
+func init() {
+	console.RegisterCommand(&exportCommand{}, "content:export")
+}
+
+// ---------------------------------------------------------------
+//                      ExportCommand struct.
+// ---------------------------------------------------------------
+
+// ExportCommand struct for content export command.
+type exportCommand struct {
+	console.Command
+	format dto.BackupFormat
+	email  string
+}
+
+// Validate Check command parameters.
+func (c *exportCommand) Validate(parameters console.CommandParameter) error {
+	format, _ := parameters["format"].(string)
+	email, _ := parameters["email"].(string)
+
+	c.format = dto.BackupFormat(strings.Trim(format, `"'`))
+	c.email = strings.Trim(email, `"'`)
+
+	if c.format == "" {
+		c.format = dto.BackupFormatZip
+	}
+
+	if c.format != dto.BackupFormatJSONL && c.format != dto.BackupFormatZip {
+		return errors.New("Invalid parameter --format=%s (jsonl or zip)", c.format)
+	}
+
+	return nil
+}
+
+// Handle Process command.
+func (c *exportCommand) Handle() {
+	name, err := services.ExportContent(c.format)
+	if err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	log.Infof("ExportCommand :: Written %s/%s", services.BackupDir, name)
+
+	if c.email != "" {
+		if err = notification.Send(notifications.ExportReady{
+			Email: c.email,
+			Name:  name,
+			URL:   services.BackupDownloadURL(name),
+		}); err != nil {
+			log.Error(err)
+		}
+	}
+
+	log.Infof("ExportCommand :: Run at %s", time.Now().Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"gfly/app/console/queues"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ---------------------------------------------------------------
+//                      Register command.
+// ./artisan cmd:run articles:import --format=wxr --file=export.xml
+// ./artisan cmd:run articles:import --format=csv --file=stories.csv --dry_run=true --author_id=1
+// ./artisan cmd:run articles:import --format=json --file=stories.json --queue=true
+// ---------------------------------------------------------------
+
+// Auto-register command.
+func init() {
+	console.RegisterCommand(&importCommand{}, "articles:import")
+}
+
+// ---------------------------------------------------------------
+//                      ImportCommand struct.
+// ---------------------------------------------------------------
+
+// ImportCommand struct for article import command.
+type importCommand struct {
+	console.Command
+	options dto.ImportOptions
+	file    string
+	queue   bool
+}
+
+// Validate Check command parameters.
+func (c *importCommand) Validate(parameters console.CommandParameter) error {
+	param := func(name string) string {
+		value, _ := parameters[name].(string)
+
+		return strings.Trim(value, `"'`)
+	}
+
+	c.file = param("file")
+	if c.file == "" {
+		return errors.New("Missing parameter --file")
+	}
+
+	c.options.Format = dto.ImportFormat(param("format"))
+	if c.options.Format == "" {
+		c.options.Format = dto.ImportFormat(strings.TrimPrefix(filepath.Ext(c.file), "."))
+		if c.options.Format == "xml" {
+			c.options.Format = dto.ImportFormatWXR
+		}
+	}
+
+	switch c.options.Format {
+	case dto.ImportFormatWXR, dto.ImportFormatCSV, dto.ImportFormatJSON:
+	default:
+		return errors.New("Invalid parameter --format=%s (wxr, csv or json)", c.options.Format)
+	}
+
+	c.options.DryRun, _ = strconv.ParseBool(param("dry_run"))
+	c.queue, _ = strconv.ParseBool(param("queue"))
+
+	if authorID := param("author_id"); authorID != "" {
+		id, err := strconv.Atoi(authorID)
+		if err != nil || id < 1 {
+			return errors.New("Invalid parameter --author_id=%s", authorID)
+		}
+		c.options.AuthorID = id
+	}
+
+	return nil
+}
+
+// Handle Process command.
+func (c *importCommand) Handle() {
+	data, err := os.ReadFile(c.file)
+	if err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	report := services.NewImportReport()
+
+	if c.queue {
+		file, err := services.StoreImportFile(report.ID, c.options.Format, data)
+		if err != nil {
+			log.Error(err)
+
+			return
+		}
+
+		if err = services.SaveImportReport(report); err != nil {
+			log.Error(err)
+
+			return
+		}
+
+		console.DispatchTask(queues.NewImportTask(report, c.options, file))
+		log.Infof("ImportCommand :: Queued import %s", report.ID)
+
+		return
+	}
+
+	report = services.RunImport(report, c.options, data)
+	if report.Status == dto.ImportStatusFailed {
+		log.Errorf("ImportCommand :: %s", report.Message)
+
+		return
+	}
+
+	for _, rowError := range report.Errors {
+		log.Warnf("ImportCommand :: Row %d (%s): %s", rowError.Row, rowError.Slug, rowError.Message)
+	}
+
+	log.Infof("ImportCommand :: %d rows, %d created, %d failed, %d slugs renamed, %d authors created, %d categories created (dry run: %v)",
+		report.Total, report.Created, report.Failed, report.RenamedSlugs, len(report.CreatedAuthors), len(report.CreatedCategories), report.DryRun)
+
+	if len(report.IgnoredFields) > 0 {
+		log.Warnf("ImportCommand :: Ignored fields %s", strings.Join(report.IgnoredFields, ", "))
+	}
+
+	log.Infof("ImportCommand :: Run at %s", time.Now().Format("2006-01-02 15:04:05"))
+}
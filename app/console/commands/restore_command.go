@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"os"
+	"strings"
+	"time"
+)
+
+// ---------------------------------------------------------------
+//                      Register command.
+// ./artisan cmd:run content:restore --file=backup-20240102-150405.zip
+// ---------------------------------------------------------------
+
+// Auto-register command.
+func init() {
+	console.RegisterCommand(&restoreCommand{}, "content:restore")
+}
+
+// ---------------------------------------------------------------
+//                      RestoreCommand struct.
+// ---------------------------------------------------------------
+
+// RestoreCommand struct for content restore command.
+type restoreCommand struct {
+	console.Command
+	file string
+}
+
+// Validate Check command parameters.
+func (c *restoreCommand) Validate(parameters console.CommandParameter) error {
+	file, _ := parameters["file"].(string)
+
+	c.file = strings.Trim(file, `"'`)
+	if c.file == "" {
+		return errors.New("Missing parameter --file")
+	}
+
+	return nil
+}
+
+// Handle Process command.
+func (c *restoreCommand) Handle() {
+	data, err := os.ReadFile(c.file)
+	if err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	report, err := services.RestoreContent(data)
+	if err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	log.Infof("RestoreCommand :: %d articles, %d translations, %d categories created, %d categories matched, "+
+		"%d authors created, %d authors matched, %d media files",
+		report.Articles, report.Translations, report.CreatedCategories, report.MatchedCategories,
+		report.CreatedAuthors, report.MatchedAuthors, report.Media)
+
+	log.Infof("RestoreCommand :: Run at %s", time.Now().Format("2006-01-02 15:04:05"))
+}
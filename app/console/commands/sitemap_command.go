@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"time"
+)
+
+// ---------------------------------------------------------------
+//                      Register command.
+// ./artisan cmd:run sitemap:generate
+// ---------------------------------------------------------------
+
+// Auto-register command.
+func init() {
+	console.RegisterCommand(&sitemapCommand{}, "sitemap:generate")
+}
+
+// ---------------------------------------------------------------
+//                      SitemapCommand struct.
+// ---------------------------------------------------------------
+
+// SitemapCommand struct for sitemap generation command.
+type sitemapCommand struct {
+	console.Command
+}
+
+// Handle Process command.
+func (c *sitemapCommand) Handle() {
+	files, err := services.GenerateSitemaps()
+	if err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	for _, file := range files {
+		log.Infof("SitemapCommand :: Written %s", file)
+	}
+
+	log.Infof("SitemapCommand :: Run at %s", time.Now().Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,72 @@
+package queues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"github.com/hibiken/asynq"
+)
+
+// ---------------------------------------------------------------
+// 					Register task.
+// ---------------------------------------------------------------
+
+// Auto-register task into queue.
+func init() {
+	console.RegisterTask(&DigestTask{}, "newsletter:digest")
+}
+
+// ---------------------------------------------------------------
+// 					Task info.
+// ---------------------------------------------------------------
+
+// NewDigestTask Constructor DigestTask.
+func NewDigestTask(digest string, items []dto.DigestItem, deliveryIDs []int) (DigestTaskPayload, string) {
+	return DigestTaskPayload{
+		Digest:      digest,
+		Items:       items,
+		DeliveryIDs: deliveryIDs,
+	}, "newsletter:digest"
+}
+
+// DigestTaskPayload Task payload.
+type DigestTaskPayload struct {
+	Digest      string           // ISO week of the digest
+	Items       []dto.DigestItem // Stories of the digest
+	DeliveryIDs []int            // Batch of recipients
+}
+
+// DigestTask Send weekly digest task.
+type DigestTask struct {
+	console.Task
+}
+
+// Dequeue Handle a task in queue.
+func (t DigestTask) Dequeue(ctx context.Context, task *asynq.Task) error {
+	// Decode task payload
+	var payload DigestTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	// Process payload. Sent deliveries are skipped, so a retry only sends the failed ones.
+	failed := 0
+	for _, deliveryID := range payload.DeliveryIDs {
+		if err := services.SendDigestDelivery(deliveryID, payload.Items); err != nil {
+			log.Warnf("Failed to send digest delivery %d: %v", deliveryID, err)
+			failed++
+		}
+	}
+
+	log.Infof("Handle DigestTask %s with %d recipients (%d failed)", payload.Digest, len(payload.DeliveryIDs), failed)
+
+	if failed > 0 {
+		return fmt.Errorf("%d digest deliveries of %s failed", failed, payload.Digest)
+	}
+
+	return nil
+}
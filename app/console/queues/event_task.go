@@ -0,0 +1,41 @@
+package queues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gfly/app/events"
+	"github.com/gflydev/console"
+	"github.com/hibiken/asynq"
+)
+
+// ---------------------------------------------------------------
+// 					Register task.
+// ---------------------------------------------------------------
+
+// Auto-register task into queue.
+func init() {
+	console.RegisterTask(&EventTask{}, events.QueueTask)
+}
+
+// ---------------------------------------------------------------
+// 					Task info.
+// ---------------------------------------------------------------
+
+// EventTask Run a queued listener of a domain event task.
+// Dispatched by events.Dispatch for the listeners registered with events.ListenQueued.
+type EventTask struct {
+	console.Task
+}
+
+// Dequeue Handle a task in queue.
+func (t EventTask) Dequeue(ctx context.Context, task *asynq.Task) error {
+	// Decode task payload
+	var payload events.Queued
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	// Process payload
+	return events.HandleQueued(payload)
+}
@@ -0,0 +1,75 @@
+package queues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gfly/app/dto"
+	"gfly/app/notifications"
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/notification"
+	"github.com/hibiken/asynq"
+)
+
+// ---------------------------------------------------------------
+// 					Register task.
+// ---------------------------------------------------------------
+
+// Auto-register task into queue.
+func init() {
+	console.RegisterTask(&ExportTask{}, "content:export")
+}
+
+// ---------------------------------------------------------------
+// 					Task info.
+// ---------------------------------------------------------------
+
+// NewExportTask Constructor ExportTask.
+func NewExportTask(format dto.BackupFormat, email string) (ExportTaskPayload, string) {
+	return ExportTaskPayload{
+		Format: format,
+		Email:  email,
+	}, "content:export"
+}
+
+// ExportTaskPayload Task payload.
+type ExportTaskPayload struct {
+	Format dto.BackupFormat
+	Email  string // Recipient of the download link
+}
+
+// ExportTask Export content task.
+type ExportTask struct {
+	console.Task
+}
+
+// Dequeue Handle a task in queue.
+func (t ExportTask) Dequeue(ctx context.Context, task *asynq.Task) error {
+	// Decode task payload
+	var payload ExportTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	// Process payload
+	name, err := services.ExportContent(payload.Format)
+	if err != nil {
+		return err
+	}
+
+	if payload.Email != "" {
+		if err = notification.Send(notifications.ExportReady{
+			Email: payload.Email,
+			Name:  name,
+			URL:   services.BackupDownloadURL(name),
+		}); err != nil {
+			return fmt.Errorf("send export link failed: %v: %w", err, asynq.SkipRetry)
+		}
+	}
+
+	log.Infof("Handle ExportTask with file %s", name)
+
+	return nil
+}
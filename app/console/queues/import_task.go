@@ -0,0 +1,72 @@
+package queues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/storage"
+	"github.com/hibiken/asynq"
+)
+
+// ---------------------------------------------------------------
+// 					Register task.
+// ---------------------------------------------------------------
+
+// Auto-register task into queue.
+func init() {
+	console.RegisterTask(&ImportTask{}, "articles:import")
+}
+
+// ---------------------------------------------------------------
+// 					Task info.
+// ---------------------------------------------------------------
+
+// NewImportTask Constructor ImportTask.
+func NewImportTask(report dto.ImportReport, options dto.ImportOptions, file string) (ImportTaskPayload, string) {
+	return ImportTaskPayload{
+		Report:  report,
+		Options: options,
+		File:    file,
+	}, "articles:import"
+}
+
+// ImportTaskPayload Task payload.
+type ImportTaskPayload struct {
+	Report  dto.ImportReport
+	Options dto.ImportOptions
+	File    string // Storage path of the import file
+}
+
+// ImportTask Import articles task.
+type ImportTask struct {
+	console.Task
+}
+
+// Dequeue Handle a task in queue.
+func (t ImportTask) Dequeue(ctx context.Context, task *asynq.Task) error {
+	// Decode task payload
+	var payload ImportTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	fs := storage.Instance()
+
+	data, err := fs.Get(payload.File)
+	if err != nil {
+		return fmt.Errorf("read import file %s failed: %v: %w", payload.File, err, asynq.SkipRetry)
+	}
+
+	// Process payload
+	report := services.RunImport(payload.Report, payload.Options, data)
+
+	fs.Delete(payload.File)
+
+	log.Infof("Handle ImportTask %s with status %s", report.ID, report.Status)
+
+	return nil
+}
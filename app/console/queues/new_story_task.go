@@ -0,0 +1,42 @@
+package queues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/hibiken/asynq"
+)
+
+// ---------------------------------------------------------------
+// 					Register task.
+// ---------------------------------------------------------------
+
+// Auto-register task into queue.
+func init() {
+	console.RegisterTask(&NewStoryTask{}, services.NewStoryTask)
+}
+
+// ---------------------------------------------------------------
+// 					Task info.
+// ---------------------------------------------------------------
+
+// NewStoryTask Notify followers of a new story task.
+// Dispatched by the article services when a story is published for the first time.
+type NewStoryTask struct {
+	console.Task
+}
+
+// Dequeue Handle a task in queue.
+func (t NewStoryTask) Dequeue(ctx context.Context, task *asynq.Task) error {
+	// Decode task payload
+	var payload dto.NewStoryNotice
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	// Process payload (followers already notified are skipped on retry)
+	return services.NotifyNewStory(payload)
+}
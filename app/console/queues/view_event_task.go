@@ -0,0 +1,53 @@
+package queues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/hibiken/asynq"
+)
+
+// ---------------------------------------------------------------
+// 					Register task.
+// ---------------------------------------------------------------
+
+// Auto-register task into queue.
+func init() {
+	console.RegisterTask(&ViewEventTask{}, "analytics:view_event")
+}
+
+// ---------------------------------------------------------------
+// 					Task info.
+// ---------------------------------------------------------------
+
+// NewViewEventTask Constructor ViewEventTask.
+func NewViewEventTask(event dto.ViewEvent) (ViewEventTaskPayload, string) {
+	return ViewEventTaskPayload{
+		Event: event,
+	}, "analytics:view_event"
+}
+
+// ViewEventTaskPayload Task payload.
+type ViewEventTaskPayload struct {
+	Event dto.ViewEvent
+}
+
+// ViewEventTask Save reading event task.
+type ViewEventTask struct {
+	console.Task
+}
+
+// Dequeue Handle a task in queue.
+func (t ViewEventTask) Dequeue(ctx context.Context, task *asynq.Task) error {
+	// Decode task payload
+	var payload ViewEventTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	// Process payload (retried by the queue on database errors)
+	return services.SaveViewEvent(payload.Event)
+}
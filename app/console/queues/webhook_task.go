@@ -0,0 +1,42 @@
+package queues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/hibiken/asynq"
+)
+
+// ---------------------------------------------------------------
+// 					Register task.
+// ---------------------------------------------------------------
+
+// Auto-register task into queue.
+func init() {
+	console.RegisterTask(&WebhookTask{}, services.WebhookTask)
+}
+
+// ---------------------------------------------------------------
+// 					Task info.
+// ---------------------------------------------------------------
+
+// WebhookTask Deliver a webhook task.
+// Dispatched by the webhook services for content lifecycle events, redeliveries and retries.
+type WebhookTask struct {
+	console.Task
+}
+
+// Dequeue Handle a task in queue.
+func (t WebhookTask) Dequeue(ctx context.Context, task *asynq.Task) error {
+	// Decode task payload
+	var payload dto.WebhookNotice
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	// Process payload (failed attempts are rescheduled by the webhook retry job, not by the queue)
+	return services.DeliverWebhook(payload.DeliveryID)
+}
@@ -0,0 +1,40 @@
+package schedules
+
+import (
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	"time"
+)
+
+// ---------------------------------------------------------------
+// 					Register job.
+// ---------------------------------------------------------------
+
+// Auto-register job into scheduler.
+func init() {
+	console.RegisterJob(&entitlementExpiryJob{})
+}
+
+// ---------------------------------------------------------------
+// 					EntitlementExpiryJob struct.
+// ---------------------------------------------------------------
+
+// entitlementExpiryJob struct for removing the plans of users once they expire.
+type entitlementExpiryJob struct{}
+
+// GetTime Get time format. Every hour by default (`ENTITLEMENT_EXPIRY_SCHEDULE`).
+func (c *entitlementExpiryJob) GetTime() string {
+	return utils.Getenv("ENTITLEMENT_EXPIRY_SCHEDULE", "0 0 * * * *")
+}
+
+// Handle Process the job.
+func (c *entitlementExpiryJob) Handle() {
+	expired, err := services.ExpireEntitlements()
+	if err != nil {
+		log.Error(err)
+	}
+
+	log.Infof("EntitlementExpiryJob :: Expired %d entitlements at %s", expired, time.Now().Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,41 @@
+package schedules
+
+import (
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	"time"
+)
+
+// ---------------------------------------------------------------
+// 					Register job.
+// ---------------------------------------------------------------
+
+// Auto-register job into scheduler.
+func init() {
+	console.RegisterJob(&sitemapJob{})
+}
+
+// ---------------------------------------------------------------
+// 					SitemapJob struct.
+// ---------------------------------------------------------------
+
+// sitemapJob struct for sitemap regeneration job.
+type sitemapJob struct{}
+
+// GetTime Get time format. Every day at 03:00 by default (`SITEMAP_SCHEDULE`).
+func (c *sitemapJob) GetTime() string {
+	return utils.Getenv("SITEMAP_SCHEDULE", "0 0 3 * * *")
+}
+
+// Handle Process the job.
+func (c *sitemapJob) Handle() {
+	if _, err := services.GenerateSitemaps(); err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	log.Infof("SitemapJob :: Run at %s", time.Now().Format("2006-01-02 15:04:05"))
+}
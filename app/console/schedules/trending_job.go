@@ -0,0 +1,42 @@
+package schedules
+
+import (
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	"time"
+)
+
+// ---------------------------------------------------------------
+// 					Register job.
+// ---------------------------------------------------------------
+
+// Auto-register job into scheduler.
+func init() {
+	console.RegisterJob(&trendingJob{})
+}
+
+// ---------------------------------------------------------------
+// 					TrendingJob struct.
+// ---------------------------------------------------------------
+
+// trendingJob struct for computing trending scores of articles.
+type trendingJob struct{}
+
+// GetTime Get time format. Every 5 minutes by default (`TRENDING_SCHEDULE`).
+func (c *trendingJob) GetTime() string {
+	return utils.Getenv("TRENDING_SCHEDULE", "0 */5 * * * *")
+}
+
+// Handle Process the job.
+func (c *trendingJob) Handle() {
+	ranked, err := services.ComputeTrendingScores()
+	if err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	log.Infof("TrendingJob :: Ranked %d articles at %s", ranked, time.Now().Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,47 @@
+package schedules
+
+import (
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	"time"
+)
+
+// ---------------------------------------------------------------
+// 					Register job.
+// ---------------------------------------------------------------
+
+// Auto-register job into scheduler.
+func init() {
+	console.RegisterJob(&viewFlushJob{})
+}
+
+// ---------------------------------------------------------------
+// 					ViewFlushJob struct.
+// ---------------------------------------------------------------
+
+// viewFlushJob struct for writing article views and A/B test counts accumulated in Redis to the database.
+type viewFlushJob struct{}
+
+// GetTime Get time format. Every minute by default (`VIEW_FLUSH_SCHEDULE`).
+func (c *viewFlushJob) GetTime() string {
+	return utils.Getenv("VIEW_FLUSH_SCHEDULE", "0 * * * * *")
+}
+
+// Handle Process the job.
+func (c *viewFlushJob) Handle() {
+	updated, err := services.FlushArticleViews()
+	if err != nil {
+		log.Error(err)
+	}
+
+	log.Infof("ViewFlushJob :: Flushed views of %d articles at %s", updated, time.Now().Format("2006-01-02 15:04:05"))
+
+	// Impressions and clicks of A/B test variants
+	if updated, err = services.FlushVariantCounts(); err != nil {
+		log.Error(err)
+	}
+
+	log.Infof("ViewFlushJob :: Flushed counts of %d variants at %s", updated, time.Now().Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,44 @@
+package schedules
+
+import (
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	"time"
+)
+
+// ---------------------------------------------------------------
+// 					Register job.
+// ---------------------------------------------------------------
+
+// Auto-register job into scheduler.
+func init() {
+	console.RegisterJob(&webhookRetryJob{})
+}
+
+// ---------------------------------------------------------------
+// 					WebhookRetryJob struct.
+// ---------------------------------------------------------------
+
+// webhookRetryJob struct for queuing the failed webhook deliveries whose backoff delay is over.
+type webhookRetryJob struct{}
+
+// GetTime Get time format. Every minute by default (`WEBHOOK_RETRY_SCHEDULE`).
+func (c *webhookRetryJob) GetTime() string {
+	return utils.Getenv("WEBHOOK_RETRY_SCHEDULE", "0 * * * * *")
+}
+
+// Handle Process the job.
+func (c *webhookRetryJob) Handle() {
+	queued, err := services.QueueWebhookRetries()
+	if err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	if queued > 0 {
+		log.Infof("WebhookRetryJob :: Queued %d webhook deliveries at %s", queued, time.Now().Format("2006-01-02 15:04:05"))
+	}
+}
@@ -0,0 +1,64 @@
+package schedules
+
+import (
+	"gfly/app/console/queues"
+	"gfly/app/services"
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	"time"
+)
+
+// ---------------------------------------------------------------
+// 					Register job.
+// ---------------------------------------------------------------
+
+// Auto-register job into scheduler.
+func init() {
+	console.RegisterJob(&weeklyDigestJob{})
+}
+
+// ---------------------------------------------------------------
+// 					WeeklyDigestJob struct.
+// ---------------------------------------------------------------
+
+// weeklyDigestJob struct for sending the "Top ghost stories" digest to the newsletter subscribers.
+// Recipients are fanned out to the queue worker in batches.
+type weeklyDigestJob struct{}
+
+// GetTime Get time format. Monday at 8:00 by default (`DIGEST_SCHEDULE`).
+func (c *weeklyDigestJob) GetTime() string {
+	return utils.Getenv("DIGEST_SCHEDULE", "0 0 8 * * 1")
+}
+
+// Handle Process the job.
+func (c *weeklyDigestJob) Handle() {
+	digest := services.DigestKey(time.Now())
+
+	items, err := services.WeeklyDigestItems()
+	if err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	if len(items) == 0 {
+		log.Infof("WeeklyDigestJob :: No story published for digest %s", digest)
+
+		return
+	}
+
+	batches, err := services.QueueDigestDeliveries(digest)
+	if err != nil {
+		log.Error(err)
+	}
+
+	recipients := 0
+	for _, batch := range batches {
+		console.DispatchTask(queues.NewDigestTask(digest, items, batch))
+		recipients += len(batch)
+	}
+
+	log.Infof("WeeklyDigestJob :: Queued digest %s for %d subscribers in %d batches at %s",
+		digest, recipients, len(batches), time.Now().Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableArticleTranslation Table name
+const TableArticleTranslation = "article_translations"
+
+// ArticleTranslation struct to describe the translation of an article in a locale.
+// The base article holds the content of the default locale.
+type ArticleTranslation struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:article_translations"`
+
+	// Table fields
+	ID             int            `db:"id" model:"name:id; type:serial,primary"`
+	ArticleID      int            `db:"article_id" model:"name:article_id"`
+	Locale         string         `db:"locale" model:"name:locale"`
+	Title          string         `db:"title" model:"name:title"`
+	Slug           string         `db:"slug" model:"name:slug"`
+	Excerpt        sql.NullString `db:"excerpt" model:"name:excerpt"`
+	Content        string         `db:"content" model:"name:content"`
+	SEODescription sql.NullString `db:"seo_description" model:"name:seo_description"`
+	SEOKeywords    sql.NullString `db:"seo_keywords" model:"name:seo_keywords"`
+	CreatedAt      time.Time      `db:"created_at" model:"name:created_at"`
+	UpdatedAt      sql.NullTime   `db:"updated_at" model:"name:updated_at"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableArticleVariant Table name
+const TableArticleVariant = "article_variants"
+
+// ArticleVariant struct to describe an A/B test variant of the title and cover image of an article.
+// Variant `a` is the control: it has neither title nor cover image and serves the article as is.
+type ArticleVariant struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:article_variants"`
+
+	// Table fields
+	ID          int            `db:"id" model:"name:id; type:serial,primary"`
+	ArticleID   int            `db:"article_id" model:"name:article_id"`
+	Key         string         `db:"key" model:"name:key"`
+	Title       sql.NullString `db:"title" model:"name:title"`
+	CoverImage  sql.NullString `db:"cover_image" model:"name:cover_image"`
+	Impressions int            `db:"impressions" model:"name:impressions"`
+	Clicks      int            `db:"clicks" model:"name:clicks"`
+	CreatedAt   time.Time      `db:"created_at" model:"name:created_at"`
+	UpdatedAt   sql.NullTime   `db:"updated_at" model:"name:updated_at"`
+}
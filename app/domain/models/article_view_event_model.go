@@ -0,0 +1,33 @@
+package models
+
+import (
+	"database/sql"
+	"gfly/app/domain/models/types"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableArticleViewEvent Table name
+const TableArticleViewEvent = "article_view_events"
+
+// ArticleViewEvent struct to describe a reading event of an article (analytics).
+type ArticleViewEvent struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:article_view_events"`
+
+	// Table fields
+	ID            int             `db:"id" model:"name:id; type:serial,primary"`
+	ArticleID     int             `db:"article_id" model:"name:article_id"`
+	Event         types.ViewEvent `db:"event" model:"name:event"`
+	Progress      sql.NullInt16   `db:"progress" model:"name:progress"`
+	VisitorID     string          `db:"visitor_id" model:"name:visitor_id"`
+	ReferrerHost  sql.NullString  `db:"referrer_host" model:"name:referrer_host"`
+	Device        string          `db:"device" model:"name:device"`
+	Authenticated bool            `db:"authenticated" model:"name:authenticated"`
+	CreatedAt     time.Time       `db:"created_at" model:"name:created_at"`
+}
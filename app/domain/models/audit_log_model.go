@@ -0,0 +1,36 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableAuditLog Table name
+const TableAuditLog = "audit_logs"
+
+// AuditLog struct to describe a privileged mutation made by an administrator.
+type AuditLog struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:audit_logs"`
+
+	// Table fields
+	ID         int            `db:"id" model:"name:id; type:serial,primary"`
+	ActorID    int            `db:"actor_id" model:"name:actor_id"`
+	ActorEmail string         `db:"actor_email" model:"name:actor_email"`
+	Action     string         `db:"action" model:"name:action"`
+	TargetType string         `db:"target_type" model:"name:target_type"`
+	TargetID   sql.NullInt32  `db:"target_id" model:"name:target_id"`
+	Changes    sql.NullString `db:"changes" model:"name:changes"` // JSON {"<field>": {"before": ..., "after": ...}}
+	Method     string         `db:"method" model:"name:method"`
+	Path       string         `db:"path" model:"name:path"`
+	StatusCode int            `db:"status_code" model:"name:status_code"`
+	IP         string         `db:"ip" model:"name:ip"`
+	UserAgent  sql.NullString `db:"user_agent" model:"name:user_agent"`
+	CreatedAt  time.Time      `db:"created_at" model:"name:created_at"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableCategory Table name
+const TableCategory = "categories"
+
+// Category struct to describe a story category.
+type Category struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:categories"`
+
+	// Table fields
+	ID        int          `db:"id" model:"name:id; type:serial,primary"`
+	Name      string       `db:"name" model:"name:name"`
+	Slug      string       `db:"slug" model:"name:slug"`
+	CreatedAt time.Time    `db:"created_at" model:"name:created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at" model:"name:updated_at"`
+}
+
+// TableArticleCategory Table name
+const TableArticleCategory = "article_categories"
+
+// ArticleCategory struct to describe a category of an article.
+type ArticleCategory struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:article_categories"`
+
+	// Table fields
+	ID         int       `db:"id" model:"name:id; type:serial,primary"`
+	ArticleID  int       `db:"article_id" model:"name:article_id"`
+	CategoryID int       `db:"category_id" model:"name:category_id"`
+	CreatedAt  time.Time `db:"created_at" model:"name:created_at"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"database/sql"
+	"gfly/app/domain/models/types"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableDigestDelivery Table name
+const TableDigestDelivery = "digest_deliveries"
+
+// DigestDelivery struct to describe the delivery of a weekly digest to a subscriber.
+type DigestDelivery struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:digest_deliveries"`
+
+	// Table fields
+	ID           int                  `db:"id" model:"name:id; type:serial,primary"`
+	Digest       string               `db:"digest" model:"name:digest"`
+	SubscriberID int                  `db:"subscriber_id" model:"name:subscriber_id"`
+	Status       types.DeliveryStatus `db:"status" model:"name:status"`
+	Attempts     int                  `db:"attempts" model:"name:attempts"`
+	Error        sql.NullString       `db:"error" model:"name:error"`
+	SentAt       sql.NullTime         `db:"sent_at" model:"name:sent_at"`
+	CreatedAt    time.Time            `db:"created_at" model:"name:created_at"`
+	UpdatedAt    sql.NullTime         `db:"updated_at" model:"name:updated_at"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"gfly/app/domain/models/types"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableFollow Table name
+const TableFollow = "follows"
+
+// Follow struct to describe a category or an author followed by a user.
+type Follow struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:follows"`
+
+	// Table fields
+	ID         int              `db:"id" model:"name:id; type:serial,primary"`
+	UserID     int              `db:"user_id" model:"name:user_id"`
+	TargetType types.FollowType `db:"target_type" model:"name:target_type"`
+	TargetID   int              `db:"target_id" model:"name:target_id"`
+	CreatedAt  time.Time        `db:"created_at" model:"name:created_at"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"database/sql"
+	"gfly/app/domain/models/types"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableNotification Table name
+const TableNotification = "notifications"
+
+// Notification struct to describe an in-app notification of a user.
+type Notification struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:notifications"`
+
+	// Table fields
+	ID        int                    `db:"id" model:"name:id; type:serial,primary"`
+	UserID    int                    `db:"user_id" model:"name:user_id"`
+	Type      types.NotificationType `db:"type" model:"name:type"`
+	ArticleID int                    `db:"article_id" model:"name:article_id"`
+	ReadAt    sql.NullTime           `db:"read_at" model:"name:read_at"`
+	CreatedAt time.Time              `db:"created_at" model:"name:created_at"`
+}
+
+// TableNotificationPreference Table name
+const TableNotificationPreference = "notification_preferences"
+
+// NotificationPreference struct to describe the notification channels of a user.
+// Users without preferences get both channels.
+type NotificationPreference struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:notification_preferences"`
+
+	// Table fields
+	ID        int          `db:"id" model:"name:id; type:serial,primary"`
+	UserID    int          `db:"user_id" model:"name:user_id"`
+	Email     bool         `db:"email" model:"name:email"`
+	InApp     bool         `db:"in_app" model:"name:in_app"`
+	CreatedAt time.Time    `db:"created_at" model:"name:created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at" model:"name:updated_at"`
+}
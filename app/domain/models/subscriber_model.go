@@ -0,0 +1,35 @@
+package models
+
+import (
+	"database/sql"
+	"gfly/app/domain/models/types"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableSubscriber Table name
+const TableSubscriber = "subscribers"
+
+// Subscriber struct to describe a newsletter subscriber, with or without an account.
+type Subscriber struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:subscribers"`
+
+	// Table fields
+	ID               int                    `db:"id" model:"name:id; type:serial,primary"`
+	Email            string                 `db:"email" model:"name:email"`
+	UserID           sql.NullInt32          `db:"user_id" model:"name:user_id"`
+	Status           types.SubscriberStatus `db:"status" model:"name:status"`
+	ConfirmToken     sql.NullString         `db:"confirm_token" model:"name:confirm_token"`
+	UnsubscribeToken string                 `db:"unsubscribe_token" model:"name:unsubscribe_token"`
+	ConfirmSentAt    sql.NullTime           `db:"confirm_sent_at" model:"name:confirm_sent_at"`
+	ConfirmedAt      sql.NullTime           `db:"confirmed_at" model:"name:confirmed_at"`
+	UnsubscribedAt   sql.NullTime           `db:"unsubscribed_at" model:"name:unsubscribed_at"`
+	CreatedAt        time.Time              `db:"created_at" model:"name:created_at"`
+	UpdatedAt        sql.NullTime           `db:"updated_at" model:"name:updated_at"`
+}
@@ -0,0 +1,60 @@
+package types
+
+// ====================================================================
+// ============================ Data Types ============================
+// ====================================================================
+
+type AccessLevel string
+
+// Access levels of articles
+const (
+	AccessLevelPublic  AccessLevel = "public"  // Everyone
+	AccessLevelMembers AccessLevel = "members" // Users with a members or premium plan
+	AccessLevelPremium AccessLevel = "premium" // Users with a premium plan
+)
+
+var AccessLevelList = []AccessLevel{
+	AccessLevelPublic,
+	AccessLevelMembers,
+	AccessLevelPremium,
+}
+
+type Plan string
+
+// Plans of user entitlements
+const (
+	PlanMembers Plan = "members"
+	PlanPremium Plan = "premium"
+)
+
+var PlanList = []Plan{
+	PlanMembers,
+	PlanPremium,
+}
+
+// ====================================================================
+// ============================= Methods ==============================
+// ====================================================================
+
+// Rank orders access levels, a plan opens the articles of an access level with the same or a lower rank.
+//
+// Returns:
+//   - int: 0 for public, 1 for members and 2 for premium
+func (e AccessLevel) Rank() int {
+	switch e {
+	case AccessLevelMembers:
+		return 1
+	case AccessLevelPremium:
+		return 2
+	}
+
+	return 0
+}
+
+// Rank orders plans like the access levels they open.
+//
+// Returns:
+//   - int: 1 for members and 2 for premium (0 for an unknown plan)
+func (e Plan) Rank() int {
+	return AccessLevel(e).Rank()
+}
@@ -0,0 +1,41 @@
+package types
+
+// ====================================================================
+// ============================ Data Types ============================
+// ====================================================================
+
+type BulkArticleAction string
+
+// Actions of the bulk article API
+const (
+	BulkArticlePublish          BulkArticleAction = "publish"
+	BulkArticleArchive          BulkArticleAction = "archive"
+	BulkArticleDelete           BulkArticleAction = "delete"  // Soft delete, reverted by restore
+	BulkArticleRestore          BulkArticleAction = "restore" // Undo a bulk delete
+	BulkArticleAssignCategories BulkArticleAction = "assign_categories"
+)
+
+var BulkArticleActionList = []BulkArticleAction{
+	BulkArticlePublish,
+	BulkArticleArchive,
+	BulkArticleDelete,
+	BulkArticleRestore,
+	BulkArticleAssignCategories,
+}
+
+type BulkUserAction string
+
+// Actions of the bulk user API
+const (
+	BulkUserActivate BulkUserAction = "activate"
+	BulkUserBlock    BulkUserAction = "block"
+	BulkUserDelete   BulkUserAction = "delete"
+	BulkUserSetRoles BulkUserAction = "set_roles"
+)
+
+var BulkUserActionList = []BulkUserAction{
+	BulkUserActivate,
+	BulkUserBlock,
+	BulkUserDelete,
+	BulkUserSetRoles,
+}
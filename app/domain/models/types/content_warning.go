@@ -0,0 +1,61 @@
+package types
+
+// ====================================================================
+// ============================ Data Types ============================
+// ====================================================================
+
+type ContentWarning string
+
+// Content warning labels of articles
+const (
+	ContentWarningViolence      ContentWarning = "violence"
+	ContentWarningGore          ContentWarning = "gore"
+	ContentWarningSuicide       ContentWarning = "suicide"
+	ContentWarningSelfHarm      ContentWarning = "self_harm"
+	ContentWarningSexualContent ContentWarning = "sexual_content"
+	ContentWarningAbuse         ContentWarning = "abuse"
+	ContentWarningDrugs         ContentWarning = "drugs"
+)
+
+var ContentWarningList = []ContentWarning{
+	ContentWarningViolence,
+	ContentWarningGore,
+	ContentWarningSuicide,
+	ContentWarningSelfHarm,
+	ContentWarningSexualContent,
+	ContentWarningAbuse,
+	ContentWarningDrugs,
+}
+
+// AgeRatingList minimum reader ages of articles (0 for all ages)
+var AgeRatingList = []int{0, 13, 16, 18}
+
+// ====================================================================
+// ============================= Methods ==============================
+// ====================================================================
+
+type contentWarningCollection []ContentWarning
+
+// String converts a collection of ContentWarning to an array of strings.
+//
+// Returns:
+//   - []string: Array of ContentWarning values as strings (e.g. ["violence", "gore"])
+func (e contentWarningCollection) String() []string {
+	result := make([]string, len(e))
+	for i, v := range e {
+		result[i] = string(v)
+	}
+
+	return result
+}
+
+// ContentWarningArrStr converts variable number of ContentWarning to array of strings.
+//
+// Parameters:
+//   - contentWarnings: Variable number of ContentWarning values
+//
+// Returns:
+//   - []string: Array of ContentWarning values as strings (e.g. ["violence", "gore"])
+func ContentWarningArrStr(contentWarnings ...ContentWarning) []string {
+	return append(contentWarningCollection{}, contentWarnings...).String()
+}
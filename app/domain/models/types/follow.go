@@ -0,0 +1,29 @@
+package types
+
+// ====================================================================
+// ============================ Data Types ============================
+// ====================================================================
+
+type FollowType string
+
+// Targets followed by users
+const (
+	FollowTypeCategory FollowType = "category"
+	FollowTypeAuthor   FollowType = "author"
+)
+
+var FollowTypeList = []FollowType{
+	FollowTypeCategory,
+	FollowTypeAuthor,
+}
+
+type NotificationType string
+
+// Types of in-app notifications
+const (
+	NotificationTypeNewStory NotificationType = "new_story" // A followed category or author published a story
+)
+
+var NotificationTypeList = []NotificationType{
+	NotificationTypeNewStory,
+}
@@ -0,0 +1,35 @@
+package types
+
+// ====================================================================
+// ============================ Data Types ============================
+// ====================================================================
+
+type SubscriberStatus string
+
+// Statuses of newsletter subscribers
+const (
+	SubscriberStatusPending      SubscriberStatus = "pending"      // Waiting for the confirmation link (double opt-in)
+	SubscriberStatusActive       SubscriberStatus = "active"       // Receives the weekly digest
+	SubscriberStatusUnsubscribed SubscriberStatus = "unsubscribed" // Left with the unsubscribe link
+)
+
+var SubscriberStatusList = []SubscriberStatus{
+	SubscriberStatusPending,
+	SubscriberStatusActive,
+	SubscriberStatusUnsubscribed,
+}
+
+type DeliveryStatus string
+
+// Delivery statuses of the weekly digest
+const (
+	DeliveryStatusQueued DeliveryStatus = "queued" // Dispatched to the queue worker
+	DeliveryStatusSent   DeliveryStatus = "sent"
+	DeliveryStatusFailed DeliveryStatus = "failed" // Gave up after DIGEST_MAX_ATTEMPTS
+)
+
+var DeliveryStatusList = []DeliveryStatus{
+	DeliveryStatusQueued,
+	DeliveryStatusSent,
+	DeliveryStatusFailed,
+}
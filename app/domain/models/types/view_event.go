@@ -0,0 +1,23 @@
+package types
+
+// ====================================================================
+// ============================ Data Types ============================
+// ====================================================================
+
+type ViewEvent string
+
+// Reading events of articles
+const (
+	ViewEventView     ViewEvent = "view"     // Counted view of the article
+	ViewEventProgress ViewEvent = "progress" // Scroll depth reported by the reader
+)
+
+type DeviceClass string
+
+// Device classes of readers
+const (
+	DeviceDesktop DeviceClass = "desktop"
+	DeviceMobile  DeviceClass = "mobile"
+	DeviceTablet  DeviceClass = "tablet"
+	DeviceBot     DeviceClass = "bot"
+)
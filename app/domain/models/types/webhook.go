@@ -0,0 +1,39 @@
+package types
+
+// ====================================================================
+// ============================ Data Types ============================
+// ====================================================================
+
+type WebhookEvent string
+
+// Content lifecycle events sent to webhooks
+const (
+	WebhookEventArticlePublished WebhookEvent = "article.published"
+	WebhookEventArticleUpdated   WebhookEvent = "article.updated"
+	WebhookEventArticleDeleted   WebhookEvent = "article.deleted"
+	WebhookEventUserCreated      WebhookEvent = "user.created"
+)
+
+var WebhookEventList = []WebhookEvent{
+	WebhookEventArticlePublished,
+	WebhookEventArticleUpdated,
+	WebhookEventArticleDeleted,
+	WebhookEventUserCreated,
+}
+
+type WebhookDeliveryStatus string
+
+// Statuses of webhook deliveries
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"   // Dispatched to the queue worker
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered" // The receiver answered with a 2xx status
+	WebhookDeliveryRetrying  WebhookDeliveryStatus = "retrying"  // Failed, retried at next_attempt_at
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"    // Gave up after WEBHOOK_MAX_ATTEMPTS
+)
+
+var WebhookDeliveryStatusList = []WebhookDeliveryStatus{
+	WebhookDeliveryPending,
+	WebhookDeliveryDelivered,
+	WebhookDeliveryRetrying,
+	WebhookDeliveryFailed,
+}
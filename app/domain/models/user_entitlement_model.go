@@ -0,0 +1,31 @@
+package models
+
+import (
+	"database/sql"
+	"gfly/app/domain/models/types"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableUserEntitlement Table name
+const TableUserEntitlement = "user_entitlements"
+
+// UserEntitlement struct to describe the paid plan of a user.
+// An entitlement without expiry never expires.
+type UserEntitlement struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:user_entitlements"`
+
+	// Table fields
+	ID        int          `db:"id" model:"name:id; type:serial,primary"`
+	UserID    int          `db:"user_id" model:"name:user_id"`
+	Plan      types.Plan   `db:"plan" model:"name:plan"`
+	ExpiresAt sql.NullTime `db:"expires_at" model:"name:expires_at"`
+	CreatedAt time.Time    `db:"created_at" model:"name:created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at" model:"name:updated_at"`
+}
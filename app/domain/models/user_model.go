@@ -40,4 +40,5 @@ type User struct {
 	BlockedAt    sql.NullTime     `db:"blocked_at" model:"name:blocked_at"`
 	DeletedAt    sql.NullTime     `db:"deleted_at" model:"name:deleted_at"`
 	LastAccessAt sql.NullTime     `db:"last_access_at" model:"name:last_access_at"`
+	Birthdate    sql.NullTime     `db:"birthdate" model:"name:birthdate"`
 }
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"database/sql"
+	"gfly/app/domain/models/types"
+	"time"
+
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ============================== Table ===============================
+// ====================================================================
+
+// TableWebhook Table name
+const TableWebhook = "webhooks"
+
+// Webhook struct to describe an outbound webhook subscription.
+type Webhook struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:webhooks"`
+
+	// Table fields
+	ID          int            `db:"id" model:"name:id; type:serial,primary"`
+	URL         string         `db:"url" model:"name:url"`
+	Secret      string         `db:"secret" model:"name:secret"`
+	Events      string         `db:"events" model:"name:events"` // Comma separated event types
+	Description sql.NullString `db:"description" model:"name:description"`
+	Active      bool           `db:"active" model:"name:active"`
+	CreatedAt   time.Time      `db:"created_at" model:"name:created_at"`
+	UpdatedAt   sql.NullTime   `db:"updated_at" model:"name:updated_at"`
+}
+
+// TableWebhookDelivery Table name
+const TableWebhookDelivery = "webhook_deliveries"
+
+// WebhookDelivery struct to describe a delivery of an event to a webhook and its last attempt.
+type WebhookDelivery struct {
+	// Table meta data
+	MetaData mb.MetaData `db:"-" model:"table:webhook_deliveries"`
+
+	// Table fields
+	ID            int                         `db:"id" model:"name:id; type:serial,primary"`
+	WebhookID     int                         `db:"webhook_id" model:"name:webhook_id"`
+	Event         types.WebhookEvent          `db:"event" model:"name:event"`
+	Payload       string                      `db:"payload" model:"name:payload"`
+	Status        types.WebhookDeliveryStatus `db:"status" model:"name:status"`
+	Attempts      int                         `db:"attempts" model:"name:attempts"`
+	ResponseCode  sql.NullInt32               `db:"response_code" model:"name:response_code"`
+	ResponseBody  sql.NullString              `db:"response_body" model:"name:response_body"`
+	Error         sql.NullString              `db:"error" model:"name:error"`
+	NextAttemptAt sql.NullTime                `db:"next_attempt_at" model:"name:next_attempt_at"`
+	DeliveredAt   sql.NullTime                `db:"delivered_at" model:"name:delivered_at"`
+	CreatedAt     time.Time                   `db:"created_at" model:"name:created_at"`
+	UpdatedAt     sql.NullTime                `db:"updated_at" model:"name:updated_at"`
+}
@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"gfly/app/domain/models/types"
+	"time"
+)
+
+// ViewEvent struct to describe a reading event collected by the analytics ingest (queue payload).
+type ViewEvent struct {
+	ArticleID     int             // Read article
+	Event         types.ViewEvent // view or progress
+	Progress      int             // Scroll depth in percent (progress events)
+	VisitorID     string          // Anonymous visitor hash (IP + User-Agent)
+	ReferrerHost  string          // Empty for direct visits
+	Device        string          // desktop, mobile, tablet or bot
+	Authenticated bool            // Signed-in reader
+	CreatedAt     time.Time       // Time of the event
+}
+
+// ReadingProgress struct to describe the request body to report how far a reader scrolled an article.
+// @Description Request payload for reporting the reading progress of an article.
+// @Tags Articles
+type ReadingProgress struct {
+	Progress int `json:"progress" example:"50" validate:"required,gte=1,lte=100" doc:"Scroll depth in percent (required, 1 to 100)"`
+}
+
+// ArticleStatsFilter struct to describe the query of the analytics of an article.
+// @Description Query parameters of the analytics of an article.
+// @Tags Articles
+type ArticleStatsFilter struct {
+	ArticleID   int    `json:"-" validate:"omitempty,gte=1" doc:"Article ID (greater than or equal to 1)"`
+	From        string `json:"from" example:"2024-01-01" validate:"omitempty,datetime=2006-01-02" doc:"First day of the period (optional, YYYY-MM-DD, 30 days ago by default)"`
+	To          string `json:"to" example:"2024-01-31" validate:"omitempty,datetime=2006-01-02" doc:"Last day of the period (optional, YYYY-MM-DD, today by default)"`
+	Granularity string `json:"granularity" example:"day" validate:"omitempty,oneof=hour day week month" doc:"Bucket of the views over time (optional, one of: hour, day, week, month)"`
+}
+
+// ArticleStats struct to describe the analytics of an article over a period.
+type ArticleStats struct {
+	ArticleID          int
+	From               time.Time
+	To                 time.Time // Exclusive end of the period
+	Granularity        string
+	Views              int
+	UniqueVisitors     int
+	AuthenticatedViews int
+	ReadThroughRate    *float64 // Nil without progress data
+	Series             []StatsPoint
+	TopReferrers       []StatsCount
+	Devices            []StatsCount
+}
+
+// StatsPoint struct to describe the views of a time bucket.
+type StatsPoint struct {
+	Time           time.Time `db:"bucket"`
+	Views          int       `db:"views"`
+	UniqueVisitors int       `db:"unique_visitors"`
+}
+
+// StatsCount struct to describe the views of a referrer host or device class.
+type StatsCount struct {
+	Name  string `db:"name"`
+	Views int    `db:"views"`
+}
@@ -1,39 +1,50 @@
 package dto
 
-import "gfly/app/domain/models/types"
+import (
+	"gfly/app/domain/models/types"
+	"time"
+)
 
 // CreateArticle struct to describe the request body to create a new article.
 // @Description Request payload for creating a new article.
 // @Tags Articles
 type CreateArticle struct {
-	Title          string              `json:"title" example:"How to Build a Go Web Application" validate:"required,max=255" doc:"Article title (required, max length 255)"`
-	Slug           string              `json:"slug" example:"how-to-build-go-web-application" validate:"required,max=255" doc:"URL-friendly slug (required, max length 255)"`
-	Excerpt        string              `json:"excerpt" example:"Learn how to build a web application using Go" validate:"omitempty" doc:"Short excerpt/summary of the article (optional)"`
-	Content        string              `json:"content" example:"<p>This is the full content of the article...</p>" validate:"required" doc:"Full HTML content of the article (required)"`
-	CoverImage     string              `json:"cover_image" example:"https://example.com/images/cover.jpg" validate:"omitempty,max=255" doc:"URL of the article cover image (optional, max length 255)"`
-	Status         types.ArticleStatus `json:"status" example:"draft" validate:"omitempty,oneof=draft published archived" doc:"Article status (optional, one of: draft, published, archived)"`
-	SEODescription string              `json:"seo_description" example:"Comprehensive guide to building Go web applications" validate:"omitempty,max=300" doc:"SEO meta description (optional, max length 300)"`
-	SEOKeywords    string              `json:"seo_keywords" example:"golang,web development,tutorial" validate:"omitempty" doc:"SEO keywords (optional)"`
-	AuthorID       int                 `json:"author_id" example:"1" validate:"required" doc:"ID of the article author (required)"`
-	YouTubeURL     string              `json:"youtube_url" example:"https://youtube.com/watch?v=abcdef" validate:"omitempty,max=255" doc:"YouTube video URL (optional, max length 255)"`
-	TikTokURL      string              `json:"tiktok_url" example:"https://tiktok.com/@user/video/123456" validate:"omitempty,max=255" doc:"TikTok video URL (optional, max length 255)"`
+	Title           string                 `json:"title" example:"How to Build a Go Web Application" validate:"required,max=255" doc:"Article title (required, max length 255)"`
+	Slug            string                 `json:"slug" example:"how-to-build-go-web-application" validate:"required,max=255" doc:"URL-friendly slug (required, max length 255)"`
+	Excerpt         string                 `json:"excerpt" example:"Learn how to build a web application using Go" validate:"omitempty" doc:"Short excerpt/summary of the article (optional)"`
+	Content         string                 `json:"content" example:"<p>This is the full content of the article...</p>" validate:"required" doc:"Full HTML content of the article (required)"`
+	CoverImage      string                 `json:"cover_image" example:"https://example.com/images/cover.jpg" validate:"omitempty,max=255" doc:"URL of the article cover image (optional, max length 255)"`
+	Status          types.ArticleStatus    `json:"status" example:"draft" validate:"omitempty,oneof=draft published archived" doc:"Article status (optional, one of: draft, published, archived)"`
+	SEODescription  string                 `json:"seo_description" example:"Comprehensive guide to building Go web applications" validate:"omitempty,max=300" doc:"SEO meta description (optional, max length 300)"`
+	SEOKeywords     string                 `json:"seo_keywords" example:"golang,web development,tutorial" validate:"omitempty" doc:"SEO keywords (optional)"`
+	AuthorID        int                    `json:"author_id" example:"1" validate:"required" doc:"ID of the article author (required)"`
+	YouTubeURL      string                 `json:"youtube_url" example:"https://youtube.com/watch?v=dQw4w9WgXcQ" validate:"omitempty,max=255,youtube_url" doc:"YouTube video URL: watch, youtu.be, shorts, embed or live link (optional, max length 255)"`
+	TikTokURL       string                 `json:"tiktok_url" example:"https://www.tiktok.com/@user/video/6718335390845095173" validate:"omitempty,max=255,tiktok_url" doc:"TikTok video URL: video, embed or vm.tiktok.com short link (optional, max length 255)"`
+	ContentWarnings []types.ContentWarning `json:"content_warnings" example:"violence,gore" validate:"omitempty,dive,oneof=violence gore suicide self_harm sexual_content abuse drugs" doc:"Content warning labels (optional, each one of: violence, gore, suicide, self_harm, sexual_content, abuse, drugs)"`
+	AgeRating       int                    `json:"age_rating" example:"16" validate:"omitempty,oneof=0 13 16 18" doc:"Minimum reader age (optional, one of: 0, 13, 16, 18; 0 for all ages)"`
+	AccessLevel     types.AccessLevel      `json:"access_level" example:"premium" validate:"omitempty,oneof=public members premium" doc:"Readers of the full content (optional, one of: public, members, premium; public by default)"`
+	CategoryIDs     []int                  `json:"category_ids" example:"1,2" validate:"omitempty,max=10,unique,dive,gte=1" doc:"IDs of the story categories (optional, max 10)"`
 }
 
 // UpdateArticle struct to partially update an existing article.
 // @Description Request payload for updating an existing article.
 // @Tags Articles
 type UpdateArticle struct {
-	ID             int                 `json:"-" validate:"omitempty,gte=1" doc:"Article ID (greater than or equal to 1)"`
-	Title          string              `json:"title" example:"Updated: How to Build a Go Web Application" validate:"omitempty,max=255" doc:"Updated article title (optional, max length 255)"`
-	Slug           string              `json:"slug" example:"updated-how-to-build-go-web-application" validate:"omitempty,max=255" doc:"Updated URL-friendly slug (optional, max length 255)"`
-	Excerpt        string              `json:"excerpt" example:"Updated summary of building a Go web application" validate:"omitempty" doc:"Updated excerpt/summary (optional)"`
-	Content        string              `json:"content" example:"<p>Updated content of the article...</p>" validate:"omitempty" doc:"Updated HTML content (optional)"`
-	CoverImage     string              `json:"cover_image" example:"https://example.com/images/updated-cover.jpg" validate:"omitempty,max=255" doc:"Updated cover image URL (optional, max length 255)"`
-	Status         types.ArticleStatus `json:"status" example:"published" validate:"omitempty,oneof=draft published archived" doc:"Updated article status (optional, one of: draft, published, archived)"`
-	SEODescription string              `json:"seo_description" example:"Updated guide to building Go web applications" validate:"omitempty,max=300" doc:"Updated SEO description (optional, max length 300)"`
-	SEOKeywords    string              `json:"seo_keywords" example:"updated,golang,web development" validate:"omitempty" doc:"Updated SEO keywords (optional)"`
-	YouTubeURL     string              `json:"youtube_url" example:"https://youtube.com/watch?v=updated" validate:"omitempty,max=255" doc:"Updated YouTube URL (optional, max length 255)"`
-	TikTokURL      string              `json:"tiktok_url" example:"https://tiktok.com/@user/video/updated" validate:"omitempty,max=255" doc:"Updated TikTok URL (optional, max length 255)"`
+	ID              int                    `json:"-" validate:"omitempty,gte=1" doc:"Article ID (greater than or equal to 1)"`
+	Title           string                 `json:"title" example:"Updated: How to Build a Go Web Application" validate:"omitempty,max=255" doc:"Updated article title (optional, max length 255)"`
+	Slug            string                 `json:"slug" example:"updated-how-to-build-go-web-application" validate:"omitempty,max=255" doc:"Updated URL-friendly slug (optional, max length 255)"`
+	Excerpt         string                 `json:"excerpt" example:"Updated summary of building a Go web application" validate:"omitempty" doc:"Updated excerpt/summary (optional)"`
+	Content         string                 `json:"content" example:"<p>Updated content of the article...</p>" validate:"omitempty" doc:"Updated HTML content (optional)"`
+	CoverImage      string                 `json:"cover_image" example:"https://example.com/images/updated-cover.jpg" validate:"omitempty,max=255" doc:"Updated cover image URL (optional, max length 255)"`
+	Status          types.ArticleStatus    `json:"status" example:"published" validate:"omitempty,oneof=draft published archived" doc:"Updated article status (optional, one of: draft, published, archived)"`
+	SEODescription  string                 `json:"seo_description" example:"Updated guide to building Go web applications" validate:"omitempty,max=300" doc:"Updated SEO description (optional, max length 300)"`
+	SEOKeywords     string                 `json:"seo_keywords" example:"updated,golang,web development" validate:"omitempty" doc:"Updated SEO keywords (optional)"`
+	YouTubeURL      string                 `json:"youtube_url" example:"https://youtu.be/dQw4w9WgXcQ?t=90" validate:"omitempty,max=255,youtube_url" doc:"Updated YouTube video URL (optional, max length 255)"`
+	TikTokURL       string                 `json:"tiktok_url" example:"https://vm.tiktok.com/ZMeAbCdEf/" validate:"omitempty,max=255,tiktok_url" doc:"Updated TikTok video URL (optional, max length 255)"`
+	ContentWarnings []types.ContentWarning `json:"content_warnings" example:"violence" validate:"omitempty,dive,oneof=violence gore suicide self_harm sexual_content abuse drugs" doc:"Updated content warning labels (optional, an empty list removes them)"`
+	AgeRating       *int                   `json:"age_rating" example:"18" validate:"omitempty,oneof=0 13 16 18" doc:"Updated minimum reader age (optional, one of: 0, 13, 16, 18)"`
+	AccessLevel     types.AccessLevel      `json:"access_level" example:"members" validate:"omitempty,oneof=public members premium" doc:"Updated readers of the full content (optional, one of: public, members, premium)"`
+	CategoryIDs     []int                  `json:"category_ids" example:"1" validate:"omitempty,max=10,unique,dive,gte=1" doc:"Updated story categories (optional, an empty list removes them)"`
 }
 
 // UpdateArticleStatus struct allows update `status` field from an existing article.
@@ -46,5 +57,31 @@ type UpdateArticleStatus struct {
 
 type ArticleFilter struct {
 	Filter
-	Status types.ArticleStatus `json:"status" example:"published" validate:"omitempty,oneof=draft published archived" doc:"Article status (optional, one of: draft, published, archived)"`
+	Status          types.ArticleStatus `json:"status" example:"published" validate:"omitempty,oneof=draft published archived" doc:"Article status (optional, one of: draft, published, archived)"`
+	AuthorID        int                 `json:"author_id" example:"1" validate:"omitempty,gte=1" doc:"ID of the article author (optional)"`
+	CategoryID      int                 `json:"category_id" example:"2" validate:"omitempty,gte=1" doc:"ID of a story category (optional)"`
+	PublishedFrom   string              `json:"published_from" example:"2024-01-01" validate:"omitempty,datetime=2006-01-02" doc:"Published on or after this date (optional, YYYY-MM-DD)"`
+	PublishedTo     string              `json:"published_to" example:"2024-12-31" validate:"omitempty,datetime=2006-01-02" doc:"Published on or before this date (optional, YYYY-MM-DD)"`
+	CreatedFrom     string              `json:"created_from" example:"2024-01-01" validate:"omitempty,datetime=2006-01-02" doc:"Created on or after this date (optional, YYYY-MM-DD)"`
+	CreatedTo       string              `json:"created_to" example:"2024-12-31" validate:"omitempty,datetime=2006-01-02" doc:"Created on or before this date (optional, YYYY-MM-DD)"`
+	HasVideo        string              `json:"has_video" example:"true" validate:"omitempty,boolean" doc:"With (true) or without (false) a YouTube or TikTok video (optional)"`
+	HasCover        string              `json:"has_cover" example:"true" validate:"omitempty,boolean" doc:"With (true) or without (false) a cover image (optional)"`
+	MinViews        int                 `json:"min_views" example:"100" validate:"omitempty,gte=0" doc:"Minimum view count (optional)"`
+	ExcludeWarnings string              `json:"exclude_warnings" example:"gore,suicide" validate:"omitempty,max=255,content_warnings" doc:"Comma separated content warnings to exclude (optional)"`
+	AccessLevel     types.AccessLevel   `json:"access_level" example:"premium" validate:"omitempty,oneof=public members premium" doc:"Access level (optional, one of: public, members, premium)"`
+	Deleted         string              `json:"deleted" example:"only" validate:"omitempty,oneof=only with" doc:"Soft deleted articles: only them (only) or included (with), left out by default (optional, admin list)"`
+}
+
+// ConfirmAge struct to describe the request body to confirm the age of a reader.
+// @Description Request payload for confirming the age of a reader before opening age-gated stories.
+// @Tags Articles
+type ConfirmAge struct {
+	Birthdate string `json:"birthdate" example:"2000-01-31" validate:"required,datetime=2006-01-02" doc:"Birthdate of the reader (required, YYYY-MM-DD)"`
+}
+
+// AgeToken struct to describe a signed age confirmation.
+type AgeToken struct {
+	Token     string    // Signed token sent back by the reader in `X-Age-Token`
+	AgeRating int       // Highest age rating the reader may open
+	ExpiresAt time.Time // Expiry of the token
 }
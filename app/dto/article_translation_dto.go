@@ -0,0 +1,15 @@
+package dto
+
+// SaveArticleTranslation struct to describe the request body to create or update the translation of an article.
+// @Description Request payload for creating or updating an article translation.
+// @Tags Articles
+type SaveArticleTranslation struct {
+	ArticleID      int    `json:"-" validate:"required,gte=1" doc:"Article ID (from path)"`
+	Locale         string `json:"-" validate:"required,min=2,max=10" doc:"Locale (from path)"`
+	Title          string `json:"title" example:"The ghost of the old house" validate:"required,max=255" doc:"Translated title (required, max length 255)"`
+	Slug           string `json:"slug" example:"the-ghost-of-the-old-house" validate:"required,max=255" doc:"Slug in the locale (required, max length 255)"`
+	Excerpt        string `json:"excerpt" example:"A short summary" validate:"omitempty" doc:"Translated excerpt (optional)"`
+	Content        string `json:"content" example:"<p>Story</p>" validate:"required" doc:"Translated HTML content (required)"`
+	SEODescription string `json:"seo_description" example:"A ghost story" validate:"omitempty,max=300" doc:"Translated SEO description (optional, max length 300)"`
+	SEOKeywords    string `json:"seo_keywords" example:"ghost,horror" validate:"omitempty" doc:"Translated SEO keywords (optional)"`
+}
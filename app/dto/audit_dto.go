@@ -0,0 +1,28 @@
+package dto
+
+// AuditEntry struct to describe a privileged mutation to record in the audit log.
+type AuditEntry struct {
+	ActorID    int
+	ActorEmail string
+	Action     string // e.g. user.status
+	TargetType string // e.g. user
+	TargetID   int    // 0 when the mutation has no target
+	Before     map[string]any
+	After      map[string]any
+	Method     string
+	Path       string
+	StatusCode int
+	IP         string // Client IP, forwarded headers are only honored from TRUSTED_PROXIES
+	UserAgent  string
+}
+
+// AuditLogFilter struct to describe the filters of the audit log.
+type AuditLogFilter struct {
+	Filter
+	ActorID    int    `json:"actor_id" example:"1" validate:"omitempty,gte=1" doc:"ID of the administrator (optional)"`
+	Action     string `json:"action" example:"user.status" validate:"omitempty,max=50" doc:"Action (optional, e.g. user.status, article.update)"`
+	TargetType string `json:"target_type" example:"user" validate:"omitempty,max=20" doc:"Target type (optional, e.g. user, article, category, webhook)"`
+	TargetID   int    `json:"target_id" example:"12" validate:"omitempty,gte=1" doc:"Target ID (optional)"`
+	From       string `json:"from" example:"2024-01-01" validate:"omitempty,datetime=2006-01-02" doc:"Recorded on or after this date (optional, YYYY-MM-DD)"`
+	To         string `json:"to" example:"2024-01-31" validate:"omitempty,datetime=2006-01-02" doc:"Recorded on or before this date (optional, YYYY-MM-DD)"`
+}
@@ -0,0 +1,137 @@
+package dto
+
+import (
+	"gfly/app/domain/models/types"
+	"time"
+)
+
+// BackupFormat file format of a content export.
+type BackupFormat string
+
+// Backup formats
+const (
+	BackupFormatJSONL BackupFormat = "jsonl" // JSON Lines file
+	BackupFormatZip   BackupFormat = "zip"   // Zip bundle with the JSON Lines file and the referenced media
+)
+
+// BackupVersion version of the export file layout. Version 2 adds categories and translations.
+const BackupVersion = 2
+
+// BackupRecordType type of a line in an export file.
+type BackupRecordType string
+
+// Backup record types
+const (
+	BackupRecordManifest    BackupRecordType = "manifest"
+	BackupRecordCategory    BackupRecordType = "category"
+	BackupRecordAuthor      BackupRecordType = "author"
+	BackupRecordArticle     BackupRecordType = "article"
+	BackupRecordTranslation BackupRecordType = "translation"
+)
+
+// CreateExport struct to describe a content export request.
+type CreateExport struct {
+	Format BackupFormat `json:"format" example:"zip" validate:"required,oneof=jsonl zip" doc:"Export format (required, one of: jsonl, zip)"`
+}
+
+// BackupRecord struct to describe a line of an export file. Only the field matching Type is set.
+type BackupRecord struct {
+	Type        BackupRecordType   `json:"type" example:"article" doc:"Record type (manifest, category, author, article, translation)"`
+	Manifest    *BackupManifest    `json:"manifest,omitempty" doc:"Export information (first line)"`
+	Category    *BackupCategory    `json:"category,omitempty" doc:"Story category"`
+	Author      *BackupAuthor      `json:"author,omitempty" doc:"Author of exported articles"`
+	Article     *BackupArticle     `json:"article,omitempty" doc:"Exported article"`
+	Translation *BackupTranslation `json:"translation,omitempty" doc:"Translation of an exported article"`
+}
+
+// BackupContent struct to describe the records of an export file, grouped by type.
+type BackupContent struct {
+	Manifest     BackupManifest
+	Categories   []BackupCategory
+	Authors      []BackupAuthor
+	Articles     []BackupArticle
+	Translations []BackupTranslation
+}
+
+// BackupManifest struct to describe an export file.
+type BackupManifest struct {
+	Version      int       `json:"version" example:"2" doc:"Export file layout version"`
+	ExportedAt   time.Time `json:"exported_at" example:"2024-01-02T15:04:05Z" doc:"Export time"`
+	Categories   int       `json:"categories" example:"15" doc:"Number of categories"`
+	Authors      int       `json:"authors" example:"12" doc:"Number of authors"`
+	Articles     int       `json:"articles" example:"4000" doc:"Number of articles"`
+	Translations int       `json:"translations" example:"800" doc:"Number of article translations"`
+	Media        []string  `json:"media" example:"articles/cover.jpg" doc:"Storage paths of the media files bundled in the zip export"`
+}
+
+// BackupCategory struct to describe an exported story category.
+type BackupCategory struct {
+	ID        int        `json:"id" example:"1" doc:"Category ID in the exported database"`
+	Name      string     `json:"name" example:"Urban legends" doc:"Name"`
+	Slug      string     `json:"slug" example:"urban-legends" doc:"Slug"`
+	CreatedAt time.Time  `json:"created_at" example:"2024-01-02T15:04:05Z" doc:"Creation time"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" example:"2024-01-02T15:04:05Z" doc:"Last update time"`
+}
+
+// BackupAuthor struct to describe an exported author. Passwords are not exported.
+type BackupAuthor struct {
+	ID         int              `json:"id" example:"1" doc:"Author ID in the exported database"`
+	Email      string           `json:"email" example:"writer@example.com" doc:"Email"`
+	Fullname   string           `json:"fullname" example:"Jane Writer" doc:"Full name"`
+	Phone      string           `json:"phone" example:"0989831911" doc:"Phone number"`
+	Avatar     string           `json:"avatar,omitempty" example:"avatars/jane.png" doc:"Avatar path or URL"`
+	Status     types.UserStatus `json:"status" example:"active" doc:"User status"`
+	Roles      []types.Role     `json:"roles" example:"member" doc:"Role slugs"`
+	CreatedAt  time.Time        `json:"created_at" example:"2024-01-02T15:04:05Z" doc:"Creation time"`
+	VerifiedAt *time.Time       `json:"verified_at,omitempty" example:"2024-01-02T15:04:05Z" doc:"Verification time"`
+}
+
+// BackupArticle struct to describe an exported article.
+type BackupArticle struct {
+	ID              int                 `json:"id" example:"1" doc:"Article ID in the exported database"`
+	Title           string              `json:"title" example:"The ghost of the old house" doc:"Title"`
+	Slug            string              `json:"slug" example:"the-ghost-of-the-old-house" doc:"Slug"`
+	Excerpt         string              `json:"excerpt,omitempty" example:"A short summary" doc:"Excerpt"`
+	Content         string              `json:"content" example:"<p>Story</p>" doc:"Content"`
+	CoverImage      string              `json:"cover_image,omitempty" example:"articles/cover.jpg" doc:"Cover image path or URL"`
+	Status          types.ArticleStatus `json:"status" example:"published" doc:"Status"`
+	SEODescription  string              `json:"seo_description,omitempty" example:"SEO description" doc:"SEO description"`
+	SEOKeywords     string              `json:"seo_keywords,omitempty" example:"ghost,horror" doc:"SEO keywords"`
+	AuthorID        int                 `json:"author_id" example:"1" doc:"Author ID in the exported database"`
+	YouTubeURL      string              `json:"youtube_url,omitempty" example:"https://youtu.be/dQw4w9WgXcQ" doc:"YouTube video URL"`
+	TikTokURL       string              `json:"tiktok_url,omitempty" example:"https://www.tiktok.com/@user/video/1" doc:"TikTok video URL"`
+	ViewCount       int                 `json:"view_count" example:"100" doc:"View count"`
+	ContentWarnings string              `json:"content_warnings,omitempty" example:"violence,gore" doc:"Comma separated content warnings"`
+	AgeRating       int                 `json:"age_rating,omitempty" example:"18" doc:"Minimum reader age"`
+	AccessLevel     types.AccessLevel   `json:"access_level,omitempty" example:"premium" doc:"Access level (public when missing)"`
+	PublishedAt     *time.Time          `json:"published_at,omitempty" example:"2024-01-02T15:04:05Z" doc:"Publishing time"`
+	CreatedAt       time.Time           `json:"created_at" example:"2024-01-02T15:04:05Z" doc:"Creation time"`
+	UpdatedAt       *time.Time          `json:"updated_at,omitempty" example:"2024-01-02T15:04:05Z" doc:"Last update time"`
+	DeletedAt       *time.Time          `json:"deleted_at,omitempty" example:"2024-01-02T15:04:05Z" doc:"Deletion time (soft deleted articles are exported too)"`
+	CategoryIDs     []int               `json:"category_ids,omitempty" example:"1,3" doc:"Category IDs in the exported database"`
+}
+
+// BackupTranslation struct to describe an exported article translation.
+type BackupTranslation struct {
+	ArticleID      int        `json:"article_id" example:"1" doc:"Article ID in the exported database"`
+	Locale         string     `json:"locale" example:"en" doc:"Locale"`
+	Title          string     `json:"title" example:"The ghost of the old house" doc:"Title"`
+	Slug           string     `json:"slug" example:"the-ghost-of-the-old-house-en" doc:"Slug"`
+	Excerpt        string     `json:"excerpt,omitempty" example:"A short summary" doc:"Excerpt"`
+	Content        string     `json:"content" example:"<p>Story</p>" doc:"Content"`
+	SEODescription string     `json:"seo_description,omitempty" example:"SEO description" doc:"SEO description"`
+	SEOKeywords    string     `json:"seo_keywords,omitempty" example:"ghost,horror" doc:"SEO keywords"`
+	CreatedAt      time.Time  `json:"created_at" example:"2024-01-02T15:04:05Z" doc:"Creation time"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty" example:"2024-01-02T15:04:05Z" doc:"Last update time"`
+}
+
+// RestoreReport struct to describe the result of a restore.
+type RestoreReport struct {
+	CreatedCategories int `json:"created_categories" example:"12" doc:"Number of categories created"`
+	MatchedCategories int `json:"matched_categories" example:"3" doc:"Number of categories matched with existing categories by slug"`
+	CreatedAuthors    int `json:"created_authors" example:"10" doc:"Number of authors created"`
+	MatchedAuthors    int `json:"matched_authors" example:"2" doc:"Number of authors matched with existing users by email"`
+	Articles          int `json:"articles" example:"4000" doc:"Number of articles restored"`
+	Translations      int `json:"translations" example:"800" doc:"Number of article translations restored"`
+	Media             int `json:"media" example:"350" doc:"Number of media files restored"`
+}
@@ -0,0 +1,50 @@
+package dto
+
+import "gfly/app/domain/models/types"
+
+// BulkArticles struct to describe the request body to apply an action to several articles.
+// @Description Request payload for publishing, archiving, deleting, restoring or categorizing several articles.
+// @Tags Articles
+type BulkArticles struct {
+	IDs         []int                   `json:"ids" example:"12,13,14" validate:"required,min=1,max=100,unique,dive,gte=1" doc:"IDs of the articles (required, 1 to 100)"`
+	Action      types.BulkArticleAction `json:"action" example:"publish" validate:"required,oneof=publish archive delete restore assign_categories" doc:"Action (required, one of: publish, archive, delete, restore, assign_categories)"`
+	CategoryIDs []int                   `json:"category_ids" example:"2,5" validate:"required_if=Action assign_categories,omitempty,max=10,unique,dive,gte=1" doc:"Categories added to the articles (required by assign_categories, max 10)"`
+}
+
+// BulkUsers struct to describe the request body to apply an action to several users.
+// @Description Request payload for activating, blocking, deleting or setting the roles of several users.
+// @Tags Users
+type BulkUsers struct {
+	IDs    []int                `json:"ids" example:"21,22" validate:"required,min=1,max=100,unique,dive,gte=1" doc:"IDs of the users (required, 1 to 100)"`
+	Action types.BulkUserAction `json:"action" example:"block" validate:"required,oneof=activate block delete set_roles" doc:"Action (required, one of: activate, block, delete, set_roles)"`
+	Roles  []types.Role         `json:"roles" example:"member" validate:"required_if=Action set_roles,omitempty,min=1,unique,dive,oneof=admin moderator member guest" doc:"New roles of the users (required by set_roles, each one of: admin, moderator, member, guest)"`
+}
+
+// BulkResult struct to describe the result of a bulk action, item by item.
+type BulkResult struct {
+	Action    string           `json:"action" example:"publish" doc:"Applied action"`
+	Succeeded int              `json:"succeeded" example:"2" doc:"Number of items changed"`
+	Failed    int              `json:"failed" example:"1" doc:"Number of items left unchanged"`
+	Results   []BulkItemResult `json:"results" doc:"Result of each item, in the order of the request"`
+}
+
+// BulkItemResult struct to describe the result of a bulk action on an item.
+type BulkItemResult struct {
+	ID      int    `json:"id" example:"14" doc:"Item ID"`
+	Success bool   `json:"success" example:"false" doc:"Whether the item was changed"`
+	Error   string `json:"error,omitempty" example:"Article not found" doc:"Reason of the failure"`
+}
+
+// Add records the result of an item.
+func (r *BulkResult) Add(id int, err error) {
+	item := BulkItemResult{ID: id, Success: err == nil}
+
+	if err != nil {
+		item.Error = err.Error()
+		r.Failed++
+	} else {
+		r.Succeeded++
+	}
+
+	r.Results = append(r.Results, item)
+}
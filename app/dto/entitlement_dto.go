@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"gfly/app/domain/models/types"
+	"time"
+)
+
+// GrantEntitlement struct to describe the request body to grant a plan to a user.
+// @Description Request payload for granting a plan to a user.
+// @Tags Users
+type GrantEntitlement struct {
+	UserID    int        `json:"-" validate:"omitempty,gte=1" doc:"User ID (greater than or equal to 1)"`
+	Plan      types.Plan `json:"plan" example:"premium" validate:"required,oneof=members premium" doc:"Plan (required, one of: members, premium)"`
+	ExpiresAt *time.Time `json:"expires_at" example:"2026-12-31T23:59:59Z" validate:"omitempty" doc:"Expiry of the plan (optional, RFC 3339, never expires when missing)"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "gfly/app/domain/models"
+
+// SaveArticleVariants struct to describe the request body to start an A/B test of an article.
+// @Description Request payload for starting an A/B test of the title and cover image of an article.
+// @Tags Articles
+type SaveArticleVariants struct {
+	ArticleID int                     `json:"-" validate:"omitempty,gte=1" doc:"Article ID (greater than or equal to 1)"`
+	Variants  []ArticleVariantPayload `json:"variants" validate:"required,min=1,max=3,dive" doc:"Alternatives to the current title and cover image (required, 1 to 3)"`
+}
+
+// ArticleVariantPayload struct to describe an alternative title and/or cover image of an article.
+type ArticleVariantPayload struct {
+	Title      string `json:"title" example:"The House That Breathes at Night" validate:"omitempty,max=255" doc:"Alternative title (optional, max length 255)"`
+	CoverImage string `json:"cover_image" example:"https://example.com/images/cover-b.jpg" validate:"omitempty,max=255" doc:"Alternative cover image URL (optional, max length 255)"`
+}
+
+// ArticleExperiment struct to describe the results of the A/B test of an article.
+type ArticleExperiment struct {
+	ArticleID   int
+	Variants    []VariantStats // Control first
+	Leader      string         // Key of the variant with the best click-through rate
+	Significant bool           // The leader differs from the control (or the control from every alternative) with the required confidence
+	Confidence  float64        // Required confidence (`EXPERIMENT_CONFIDENCE`)
+}
+
+// VariantStats struct to describe the results of an A/B test variant.
+type VariantStats struct {
+	Variant    models.ArticleVariant
+	CTR        float64  // Clicks / impressions
+	Lift       *float64 // Relative CTR change against the control, nil for the control or without control clicks
+	Confidence *float64 // Confidence that the CTR differs from the control (0..1), nil for the control
+}
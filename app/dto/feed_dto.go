@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"gfly/app/domain/models"
+	"time"
+)
+
+// FeedFormat syndication format of a feed document.
+type FeedFormat string
+
+// Feed formats
+const (
+	FeedFormatRSS  FeedFormat = "rss"
+	FeedFormatAtom FeedFormat = "atom"
+)
+
+// Feed struct to describe a rendered feed document and its cache validators.
+type Feed struct {
+	Format       FeedFormat `json:"format" doc:"Feed format (rss or atom)"`
+	Body         string     `json:"body" doc:"Rendered XML document"`
+	ETag         string     `json:"etag" doc:"Entity tag of the rendered document"`
+	LastModified time.Time  `json:"last_modified" doc:"Latest modification time of the feed items"`
+}
+
+// FeedChannel struct to describe the channel metadata of a feed being rendered.
+type FeedChannel struct {
+	Title       string `json:"title" doc:"Feed title"`
+	Description string `json:"description" doc:"Feed description"`
+	Link        string `json:"link" doc:"URL of the HTML page the feed belongs to"`
+	SelfURL     string `json:"self_url" doc:"URL of the feed document itself"`
+	Language    string `json:"language" doc:"Language of the feed items (e.g. vi)"`
+	FullContent bool   `json:"full_content" doc:"Embed full article content instead of the excerpt only"`
+}
+
+// FeedScope struct to describe the articles of a feed: site-wide, of an author or of a category.
+type FeedScope struct {
+	AuthorID int              `json:"author_id" doc:"Author of a per-author feed (0 otherwise)"`
+	Category *models.Category `json:"category" doc:"Category of a per-category feed (nil otherwise)"`
+}
+
+// FeedItem struct to describe an article being syndicated, with the content and media computed by the services.
+type FeedItem struct {
+	Article   models.Article `json:"article" doc:"The syndicated article"`
+	Content   string         `json:"content" doc:"Syndicated content, empty when the feed or the story doesn't embed it"`
+	CoverSize int64          `json:"cover_size" doc:"Size in bytes of the cover image, 0 when unknown"`
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+)
+
+// CreateCategory struct to describe the request body to create a story category.
+// @Description Request payload for creating a story category.
+// @Tags Categories
+type CreateCategory struct {
+	Name string `json:"name" example:"Truyện ma có thật" validate:"required,max=100" doc:"Category name (required, max length 100)"`
+	Slug string `json:"slug" example:"truyen-ma-co-that" validate:"required,max=120" doc:"URL-friendly slug (required, max length 120)"`
+}
+
+// Follow struct to describe the request body to follow a category or an author.
+// @Description Request payload for following a category or an author.
+// @Tags Follows
+type Follow struct {
+	UserID     int              `json:"-" validate:"omitempty,gte=1" doc:"Follower (current user)"`
+	TargetType types.FollowType `json:"type" example:"category" validate:"required,oneof=category author" doc:"Followed target (required, one of: category, author)"`
+	TargetID   int              `json:"id" example:"1" validate:"required,gte=1" doc:"ID of the category or of the author (required)"`
+}
+
+// FollowItem struct to describe a followed category or author with its name.
+type FollowItem struct {
+	Follow models.Follow
+	Name   string // Category name or author full name
+	Slug   string // Category slug, empty for authors
+}
+
+// NotificationPreferences struct to describe the request body to update the notification channels of a user.
+// @Description Request payload for choosing how new stories of followed categories and authors are notified.
+// @Tags Notifications
+type NotificationPreferences struct {
+	UserID int   `json:"-" validate:"omitempty,gte=1" doc:"Current user"`
+	Email  *bool `json:"email" example:"false" validate:"omitempty" doc:"Notify by email (optional, keeps the current value when missing)"`
+	InApp  *bool `json:"in_app" example:"true" validate:"omitempty" doc:"Notify in the app (optional, keeps the current value when missing)"`
+}
+
+// NotificationItem struct to describe an in-app notification with its story.
+type NotificationItem struct {
+	Notification models.Notification
+	Article      models.Article
+}
+
+// NewStoryNotice struct to describe a batch of followers to notify of a new story (queue payload).
+type NewStoryNotice struct {
+	ArticleID int
+	UserIDs   []int
+}
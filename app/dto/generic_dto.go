@@ -1,14 +1,36 @@
 package dto
 
+// Pagination modes
+const (
+	PaginationPage   = "page"   // Page number with total count (default)
+	PaginationCursor = "cursor" // Opaque cursors, stable while new records are added
+)
+
 type Filter struct {
-	Page    int    `json:"page" example:"1" validate:"number" doc:"Current page number"`
-	PerPage int    `json:"per_page" example:"10" validate:"number" doc:"Number of items per page"`
-	Keyword string `json:"keyword" example:"" validate:"" doc:"Search keyword"`
-	OrderBy string `json:"order_by" example:"-full_name" validate:"" doc:"Field to order by, prefix with '-' for descending"`
+	Page       int    `json:"page" example:"1" validate:"number" doc:"Current page number"`
+	PerPage    int    `json:"per_page" example:"10" validate:"number" doc:"Number of items per page"`
+	Keyword    string `json:"keyword" example:"" validate:"" doc:"Search keyword"`
+	OrderBy    string `json:"order_by" example:"-full_name" validate:"" doc:"Field to order by, prefix with '-' for descending"`
+	Pagination string `json:"pagination" example:"cursor" validate:"omitempty,oneof=page cursor" doc:"Pagination mode (optional, one of: page, cursor). Implied by cursor"`
+	Cursor     string `json:"cursor" example:"eyJvIjoiLXB1Ymxpc2hlZF9hdCIsInYiOiIyMDI0LTAxLTAyVDE1OjA0OjA1WiIsImkiOjQyfQ" validate:"omitempty,max=512" doc:"Cursor returned as next_cursor or prev_cursor (cursor mode)"`
+	Fields     string `json:"fields" example:"id,title,slug,cover_image" validate:"omitempty,max=512" doc:"Comma separated response fields (optional). List APIs return lightweight card fields by default"`
+}
+
+// IsCursor checks whether the filter uses cursor pagination.
+func (f Filter) IsCursor() bool {
+	return f.Pagination == PaginationCursor || f.Cursor != ""
 }
 
 type Meta struct {
-	Page    int `json:"page,omitempty" example:"1" doc:"Current page number"`
-	PerPage int `json:"per_page,omitempty" example:"10" doc:"Number of items per page"`
-	Total   int `json:"total" example:"1354" doc:"Total number of records"`
+	Page       int    `json:"page,omitempty" example:"1" doc:"Current page number"`
+	PerPage    int    `json:"per_page,omitempty" example:"10" doc:"Number of items per page"`
+	Total      int    `json:"total" example:"1354" doc:"Total number of records"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJvIjoiaWQiLCJpIjoxMH0" doc:"Cursor of the next page (cursor mode)"`
+	PrevCursor string `json:"prev_cursor,omitempty" example:"eyJvIjoiaWQiLCJpIjoxLCJiIjp0cnVlfQ" doc:"Cursor of the previous page (cursor mode)"`
+}
+
+// Cursors struct to describe the cursors of the pages around a page in cursor mode.
+type Cursors struct {
+	Next string `json:"next_cursor,omitempty" example:"eyJvIjoiaWQiLCJpIjoxMH0" doc:"Cursor of the next page, empty on the last page"`
+	Prev string `json:"prev_cursor,omitempty" example:"eyJvIjoiaWQiLCJpIjoxLCJiIjp0cnVlfQ" doc:"Cursor of the previous page, empty on the first page"`
 }
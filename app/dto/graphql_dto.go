@@ -0,0 +1,10 @@
+package dto
+
+// GraphQLQuery struct to describe the request body of the GraphQL API.
+// @Description Request payload for running a GraphQL query on the story catalogue.
+// @Tags GraphQL
+type GraphQLQuery struct {
+	Query         string         `json:"query" example:"{ articles(perPage: 5) { items { title slug author { fullname } } } }" validate:"required,max=20000" doc:"GraphQL query document (required, max length 20000)"`
+	OperationName string         `json:"operationName" example:"" validate:"omitempty,max=255" doc:"Operation to run when the document has several (optional)"`
+	Variables     map[string]any `json:"variables" validate:"omitempty" doc:"Values of the query variables (optional)"`
+}
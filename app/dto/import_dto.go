@@ -0,0 +1,73 @@
+package dto
+
+import "time"
+
+// ImportFormat file format of an article import.
+type ImportFormat string
+
+// Import formats
+const (
+	ImportFormatWXR  ImportFormat = "wxr"
+	ImportFormatCSV  ImportFormat = "csv"
+	ImportFormatJSON ImportFormat = "json"
+)
+
+// ImportStatus processing status of an article import.
+type ImportStatus string
+
+// Import statuses
+const (
+	ImportStatusQueued    ImportStatus = "queued"
+	ImportStatusRunning   ImportStatus = "running"
+	ImportStatusCompleted ImportStatus = "completed"
+	ImportStatusFailed    ImportStatus = "failed"
+)
+
+// ImportArticle struct to describe an article row read from an import file.
+type ImportArticle struct {
+	CreateArticle
+	AuthorEmail string    `json:"author_email" example:"writer@example.com" doc:"Email of the author, created when missing (optional)"`
+	AuthorName  string    `json:"author_name" example:"Jane Writer" doc:"Full name of the author (optional)"`
+	PublishedAt time.Time `json:"published_at" example:"2024-01-02T15:04:05Z" doc:"Original publishing time, RFC 3339, 2006-01-02 15:04:05 or 2006-01-02 (optional)"`
+	Categories  []string  `json:"categories" example:"horror,urban legend" doc:"Category names (optional)"`
+	Tags        []string  `json:"tags" example:"ghost,hanoi" doc:"Tag names, not imported: stories have no tags (listed in ignored_fields)"`
+}
+
+// ImportOptions struct to describe how an import is processed.
+type ImportOptions struct {
+	Format   ImportFormat `json:"format" example:"wxr" validate:"required,oneof=wxr csv json" doc:"Import file format (required, one of: wxr, csv, json)"`
+	DryRun   bool         `json:"dry_run" example:"true" doc:"Validate and report without writing to the database"`
+	AuthorID int          `json:"author_id" example:"1" validate:"omitempty,gte=1" doc:"Author of rows without author email (optional)"`
+}
+
+// ImportRowError struct to describe a row that failed to import.
+type ImportRowError struct {
+	Row     int    `json:"row" example:"12" doc:"Row number in the import file (starting from 1)"`
+	Slug    string `json:"slug,omitempty" example:"ghost-story" doc:"Slug of the failed row"`
+	Message string `json:"message" example:"title: required" doc:"Error message"`
+}
+
+// ImportAuthor struct to describe how the author email of import rows was resolved.
+type ImportAuthor struct {
+	Email   string `json:"email" example:"writer@example.com" doc:"Author email of the file"`
+	UserID  int    `json:"user_id" example:"42" doc:"ID of the matched or created user, 0 for a user a dry run would create"`
+	Created bool   `json:"created" example:"false" doc:"Whether the user was created by the import (or would be in a dry run)"`
+}
+
+// ImportReport struct to describe the progress and result of an import.
+type ImportReport struct {
+	ID                string           `json:"id" example:"1718000000000000000" doc:"Import job ID"`
+	Status            ImportStatus     `json:"status" example:"completed" doc:"Import status"`
+	DryRun            bool             `json:"dry_run" example:"false" doc:"Whether the import was a dry run"`
+	Total             int              `json:"total" example:"4000" doc:"Number of rows in the import file"`
+	Processed         int              `json:"processed" example:"4000" doc:"Number of rows processed so far"`
+	Created           int              `json:"created" example:"3990" doc:"Number of articles created (or that would be created in a dry run)"`
+	Failed            int              `json:"failed" example:"10" doc:"Number of rows that failed"`
+	RenamedSlugs      int              `json:"renamed_slugs" example:"25" doc:"Number of slugs renamed to resolve collisions"`
+	CreatedAuthors    []string         `json:"created_authors" example:"writer@example.com" doc:"Emails of authors created by the import"`
+	Authors           []ImportAuthor   `json:"authors" doc:"Resolution of each author email of the file"`
+	CreatedCategories []string         `json:"created_categories" example:"Urban legend" doc:"Names of categories created by the import"`
+	IgnoredFields     []string         `json:"ignored_fields,omitempty" example:"categories,tags" doc:"Fields present in the file which are not stored"`
+	Errors            []ImportRowError `json:"errors" doc:"Per-row error log"`
+	Message           string           `json:"message,omitempty" example:"Invalid file" doc:"Failure reason of the whole import"`
+}
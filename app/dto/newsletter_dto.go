@@ -0,0 +1,17 @@
+package dto
+
+// Subscribe struct to describe the request body to subscribe to the weekly digest.
+// @Description Request payload for subscribing to the weekly digest.
+// @Tags Newsletter
+type Subscribe struct {
+	Email  string `json:"email" example:"reader@example.com" validate:"omitempty,email,max=255" doc:"Email address (required for guests, the account email by default)"`
+	UserID int    `json:"-" validate:"omitempty,gte=1" doc:"Signed-in user (optional)"`
+}
+
+// DigestItem struct to describe a story listed in the weekly digest.
+type DigestItem struct {
+	Title      string
+	Excerpt    string
+	CoverImage string
+	URL        string // Story page
+}
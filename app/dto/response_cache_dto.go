@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+// CachedResponse struct to describe a serialized public API response and its cache validators.
+type CachedResponse struct {
+	Body         string    `json:"body" doc:"Serialized JSON response"`
+	LastModified time.Time `json:"last_modified" doc:"Latest modification time of the response data"`
+	Tags         []string  `json:"tags" doc:"Dependency tags of the response"`
+}
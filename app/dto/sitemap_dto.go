@@ -0,0 +1,37 @@
+package dto
+
+import "encoding/xml"
+
+// SitemapIndex struct to describe a sitemap index document.
+type SitemapIndex struct {
+	XMLName  xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+	Sitemaps []SitemapRef `xml:"sitemap"`
+}
+
+// SitemapRef struct to describe a child sitemap listed in a sitemap index.
+type SitemapRef struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// SitemapURLSet struct to describe a sitemap document with the image extension.
+type SitemapURLSet struct {
+	XMLName    xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	XmlnsImage string       `xml:"xmlns:image,attr"`
+	URLs       []SitemapURL `xml:"url"`
+}
+
+// SitemapURL struct to describe a page entry of a sitemap.
+type SitemapURL struct {
+	Loc        string         `xml:"loc"`
+	LastMod    string         `xml:"lastmod,omitempty"`
+	ChangeFreq string         `xml:"changefreq,omitempty"`
+	Priority   string         `xml:"priority,omitempty"`
+	Images     []SitemapImage `xml:"image:image"`
+}
+
+// SitemapImage struct to describe an image attached to a sitemap entry.
+type SitemapImage struct {
+	Loc   string `xml:"image:loc"`
+	Title string `xml:"image:title,omitempty"`
+}
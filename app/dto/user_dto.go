@@ -20,12 +20,13 @@ type CreateUser struct {
 // @Description Request payload for updating an existing user.
 // @Tags Users
 type UpdateUser struct {
-	ID       int          `json:"-" validate:"omitempty,gte=1" doc:"User ID (greater than or equal to 1)"`
-	Password string       `json:"password" example:"M1PassW@s" validate:"omitempty,max=255" doc:"User's new password (optional, max length 255)"`
-	Fullname string       `json:"fullname" example:"John Doe" validate:"max=255" doc:"User's updated full name (optional, max length 255)"`
-	Phone    string       `json:"phone" example:"0989831911" validate:"max=20" doc:"User's updated phone number (optional, max length 20)"`
-	Avatar   string       `json:"avatar" example:"https://i.pravatar.cc/32" validate:"max=255" doc:"Updated URL of the user's avatar (optional, max length 255)"`
-	Roles    []types.Role `json:"roles" example:"admin,user" validate:"omitempty" doc:"Updated list of user's roles (optional)"`
+	ID        int          `json:"-" validate:"omitempty,gte=1" doc:"User ID (greater than or equal to 1)"`
+	Password  string       `json:"password" example:"M1PassW@s" validate:"omitempty,max=255" doc:"User's new password (optional, max length 255)"`
+	Fullname  string       `json:"fullname" example:"John Doe" validate:"max=255" doc:"User's updated full name (optional, max length 255)"`
+	Phone     string       `json:"phone" example:"0989831911" validate:"max=20" doc:"User's updated phone number (optional, max length 20)"`
+	Avatar    string       `json:"avatar" example:"https://i.pravatar.cc/32" validate:"max=255" doc:"Updated URL of the user's avatar (optional, max length 255)"`
+	Roles     []types.Role `json:"roles" example:"admin,user" validate:"omitempty" doc:"Updated list of user's roles (optional)"`
+	Birthdate string       `json:"birthdate" example:"2000-01-31" validate:"omitempty,datetime=2006-01-02" doc:"Updated birthdate of the user (optional, YYYY-MM-DD)"`
 }
 
 // UpdateUserStatus struct allows update `status` field from an existing user.
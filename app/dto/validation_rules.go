@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"gfly/app/domain/models/types"
+	"gfly/app/utils"
+	"slices"
+	"strings"
+
+	"github.com/gflydev/validation"
+	"github.com/go-playground/validator/v10"
+)
+
+// ====================================================================
+// ========================= Custom Validations =======================
+// ====================================================================
+
+// Register the custom validation rules used by DTO tags before the validator instance is created.
+// They live beside the DTOs so that every process validating them (web, CLI, queue worker) has them.
+func init() {
+	validation.AddRule(youTubeURLRule{})
+	validation.AddRule(tikTokURLRule{})
+	validation.AddRule(contentWarningsRule{})
+}
+
+// youTubeURLRule validates supported YouTube video links. Use `validate:"youtube_url"`
+type youTubeURLRule struct{}
+
+func (r youTubeURLRule) GetTag() string {
+	return "youtube_url"
+}
+
+func (r youTubeURLRule) Handler() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return utils.IsValidYouTubeURL(fl.Field().String())
+	}
+}
+
+// tikTokURLRule validates supported TikTok video links. Use `validate:"tiktok_url"`
+type tikTokURLRule struct{}
+
+func (r tikTokURLRule) GetTag() string {
+	return "tiktok_url"
+}
+
+func (r tikTokURLRule) Handler() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return utils.IsValidTikTokURL(fl.Field().String())
+	}
+}
+
+// contentWarningsRule validates comma separated content warning labels. Use `validate:"content_warnings"`
+type contentWarningsRule struct{}
+
+func (r contentWarningsRule) GetTag() string {
+	return "content_warnings"
+}
+
+func (r contentWarningsRule) Handler() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		labels := types.ContentWarningArrStr(types.ContentWarningList...)
+
+		for _, label := range utils.SplitList(fl.Field().String()) {
+			if !slices.Contains(labels, label) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// MsgForTag builds validation messages for custom rules and falls back to the default messages.
+func MsgForTag(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "youtube_url":
+		return "invalid YouTube video URL"
+	case "tiktok_url":
+		return "invalid TikTok video URL"
+	case "content_warnings":
+		return "invalid content warnings, expected a comma separated list of: " + strings.Join(types.ContentWarningArrStr(types.ContentWarningList...), ", ")
+	case "datetime":
+		if fe.Param() == "2006-01-02" {
+			return "invalid date, expected YYYY-MM-DD"
+		}
+	}
+
+	return validation.MsgForTag(fe)
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"gfly/app/domain/models/types"
+	"time"
+)
+
+// CreateWebhook struct to describe the request body to create a webhook subscription.
+// @Description Request payload for subscribing a URL to content lifecycle events.
+// @Tags Webhooks
+type CreateWebhook struct {
+	URL         string               `json:"url" example:"https://bot.example.com/hooks/stories" validate:"required,url,max=500" doc:"Receiver URL (required, https or http outside prod, public host, max length 500)"`
+	Secret      string               `json:"secret" example:"whsec_9f8e7d6c5b4a39281706f5e4d3c2b1a0" validate:"omitempty,min=16,max=100" doc:"HMAC-SHA256 signing secret (optional, generated when missing, 16 to 100 characters)"`
+	Events      []types.WebhookEvent `json:"events" example:"article.published,article.deleted" validate:"required,min=1,unique,dive,oneof=article.published article.updated article.deleted user.created" doc:"Event types (required, each one of: article.published, article.updated, article.deleted, user.created)"`
+	Description string               `json:"description" example:"Discord bot" validate:"omitempty,max=255" doc:"Description (optional, max length 255)"`
+	Active      *bool                `json:"active" example:"true" validate:"omitempty" doc:"Deliver events (optional, true by default)"`
+}
+
+// UpdateWebhook struct to partially update a webhook subscription.
+// @Description Request payload for updating a webhook subscription.
+// @Tags Webhooks
+type UpdateWebhook struct {
+	ID          int                  `json:"-" validate:"omitempty,gte=1" doc:"Webhook ID (greater than or equal to 1)"`
+	URL         string               `json:"url" example:"https://bot.example.com/hooks/v2/stories" validate:"omitempty,url,max=500" doc:"Updated receiver URL (optional, https or http outside prod, public host, max length 500)"`
+	Secret      string               `json:"secret" example:"whsec_0a1b2c3d4e5f60718293a4b5c6d7e8f9" validate:"omitempty,min=16,max=100" doc:"New signing secret (optional, 16 to 100 characters)"`
+	Events      []types.WebhookEvent `json:"events" example:"article.published" validate:"omitempty,min=1,unique,dive,oneof=article.published article.updated article.deleted user.created" doc:"Updated event types (optional)"`
+	Description *string              `json:"description" example:"Mobile app backend" validate:"omitempty,max=255" doc:"Updated description (optional, empty to remove it)"`
+	Active      *bool                `json:"active" example:"false" validate:"omitempty" doc:"Deliver events (optional)"`
+}
+
+// WebhookDeliveryFilter struct to describe the filters of the delivery log of a webhook.
+type WebhookDeliveryFilter struct {
+	Filter
+	WebhookID int                         `json:"-" validate:"omitempty,gte=1" doc:"Webhook ID"`
+	Status    types.WebhookDeliveryStatus `json:"status" example:"failed" validate:"omitempty,oneof=pending delivered retrying failed" doc:"Delivery status (optional, one of: pending, delivered, retrying, failed)"`
+	Event     types.WebhookEvent          `json:"event" example:"article.published" validate:"omitempty,oneof=article.published article.updated article.deleted user.created" doc:"Event type (optional)"`
+}
+
+// WebhookPayload struct to describe the JSON body sent to webhook receivers.
+type WebhookPayload struct {
+	Event     types.WebhookEvent `json:"event" example:"article.published"`
+	CreatedAt time.Time          `json:"created_at"`
+	Data      any                `json:"data"` // Article or user of the event
+}
+
+// WebhookNotice struct to describe a webhook delivery to attempt (queue payload).
+type WebhookNotice struct {
+	DeliveryID int
+}
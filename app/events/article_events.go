@@ -0,0 +1,42 @@
+package events
+
+import "gfly/app/domain/models"
+
+// ArticleCreated dispatched when an article is created, published or not.
+// Imported articles only dispatch ArticleCreated, with Imported set: old stories don't notify anyone.
+type ArticleCreated struct {
+	Article  models.Article
+	Imported bool
+}
+
+func (e ArticleCreated) EventName() string {
+	return "article.created"
+}
+
+// ArticlePublished dispatched when an article is published for the first time, when it's created or updated.
+// Re-publishing an archived story dispatches ArticleUpdated.
+type ArticlePublished struct {
+	Article models.Article
+}
+
+func (e ArticlePublished) EventName() string {
+	return "article.published"
+}
+
+// ArticleUpdated dispatched when the content or the status of a published or draft article changes.
+type ArticleUpdated struct {
+	Article models.Article
+}
+
+func (e ArticleUpdated) EventName() string {
+	return "article.updated"
+}
+
+// ArticleDeleted dispatched when an article is deleted.
+type ArticleDeleted struct {
+	Article models.Article
+}
+
+func (e ArticleDeleted) EventName() string {
+	return "article.deleted"
+}
@@ -0,0 +1,21 @@
+package events
+
+import "gfly/app/domain/models"
+
+// CategoryCreated dispatched when an administrator creates a category.
+type CategoryCreated struct {
+	Category models.Category
+}
+
+func (e CategoryCreated) EventName() string {
+	return "category.created"
+}
+
+// CategoryDeleted dispatched when an administrator deletes a category.
+type CategoryDeleted struct {
+	Category models.Category
+}
+
+func (e CategoryDeleted) EventName() string {
+	return "category.deleted"
+}
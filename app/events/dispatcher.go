@@ -0,0 +1,126 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gflydev/console"
+	"github.com/gflydev/core/log"
+)
+
+// QueueTask name of the queue task running queued listeners (see queues.EventTask)
+const QueueTask = "events:listen"
+
+// Event a domain event dispatched by the services.
+type Event interface {
+	// EventName the name of the event, e.g. `article.published`.
+	EventName() string
+}
+
+// Queued struct to describe an event handed to a queued listener (queue payload).
+type Queued struct {
+	Event    string
+	Listener string
+	Payload  json.RawMessage // JSON encoded event
+}
+
+// queuedListener a listener run by the queue worker.
+type queuedListener struct {
+	name   string
+	handle func(payload []byte) error
+}
+
+// Listeners by event name. They are registered at startup (see app/listeners), before any event is dispatched.
+var (
+	listeners       = map[string][]func(event Event) error{}
+	queuedListeners = map[string][]queuedListener{}
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// Listen registers a synchronous listener of the events of type E. Synchronous listeners run in
+// registration order when the event is dispatched.
+func Listen[E Event](listener func(event E) error) {
+	var event E
+
+	listeners[event.EventName()] = append(listeners[event.EventName()], func(event Event) error {
+		return listener(event.(E))
+	})
+}
+
+// ListenQueued registers an asynchronous listener of the events of type E. The event is JSON encoded and handed
+// to the queue worker (`./artisan queue:run`), one task per listener so a failed listener is retried alone.
+// The name identifies the listener in the queue and must be unique for the event.
+func ListenQueued[E Event](name string, listener func(event E) error) {
+	var event E
+
+	queuedListeners[event.EventName()] = append(queuedListeners[event.EventName()], queuedListener{
+		name: name,
+		handle: func(payload []byte) error {
+			var event E
+			if err := json.Unmarshal(payload, &event); err != nil {
+				return err
+			}
+
+			return listener(event)
+		},
+	})
+}
+
+// Dispatch runs the synchronous listeners of an event and queues its asynchronous listeners.
+//
+// Parameters:
+//   - event (Event): The event.
+//
+// Returns:
+//   - error: The errors of the synchronous listeners, already logged. Services only check it
+//     when a listener is part of the operation (e.g. the password reset mail).
+func Dispatch(event Event) error {
+	var errs []error
+
+	for _, listener := range listeners[event.EventName()] {
+		if err := listener(event); err != nil {
+			log.Errorf("Listener of %s failed: %v", event.EventName(), err)
+			errs = append(errs, err)
+		}
+	}
+
+	if queued := queuedListeners[event.EventName()]; len(queued) > 0 {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			log.Errorf("Failed to encode %s: %v", event.EventName(), err)
+
+			return errors.Join(append(errs, err)...)
+		}
+
+		for _, listener := range queued {
+			console.DispatchTask(Queued{
+				Event:    event.EventName(),
+				Listener: listener.name,
+				Payload:  payload,
+			}, QueueTask)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// HandleQueued runs a queued listener of an event, called by the queue worker.
+//
+// Parameters:
+//   - queued (Queued): The event and the name of the listener.
+//
+// Returns:
+//   - error: The error of the listener, the task is retried by the queue.
+func HandleQueued(queued Queued) error {
+	for _, listener := range queuedListeners[queued.Event] {
+		if listener.name == queued.Listener {
+			return listener.handle(queued.Payload)
+		}
+	}
+
+	return fmt.Errorf("no queued listener %q of %s", queued.Listener, queued.Event)
+}
@@ -0,0 +1,42 @@
+package events
+
+import "gfly/app/domain/models"
+
+// UserRegistered dispatched when a visitor signs up.
+type UserRegistered struct {
+	User models.User
+}
+
+func (e UserRegistered) EventName() string {
+	return "user.registered"
+}
+
+// UserCreated dispatched when an administrator creates a user.
+type UserCreated struct {
+	User models.User
+}
+
+func (e UserCreated) EventName() string {
+	return "user.created"
+}
+
+// PasswordResetRequested dispatched when a user asks for a password reset link.
+type PasswordResetRequested struct {
+	UserID int
+	Email  string
+}
+
+func (e PasswordResetRequested) EventName() string {
+	return "password.reset_requested"
+}
+
+// PasswordChanged dispatched when a user sets a new password with a reset link.
+type PasswordChanged struct {
+	UserID   int
+	Email    string
+	Fullname string
+}
+
+func (e PasswordChanged) EventName() string {
+	return "password.changed"
+}
@@ -0,0 +1,56 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"gfly/app/dto"
+	appUtils "gfly/app/utils"
+
+	"github.com/gflydev/core/utils"
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+// listSizes expected sizes of the list fields without size argument, used to compute the complexity.
+var listSizes = map[string]int{
+	"articles":   defaultPerPage,
+	"trending":   10,
+	"categories": 10, // At most 10 per article
+}
+
+// Execute runs a query on the read-only catalogue schema. Queries deeper or more complex than
+// GRAPHQL_MAX_DEPTH and GRAPHQL_MAX_COMPLEXITY are rejected before any resolver runs.
+//
+// Parameters:
+//   - query (dto.GraphQLQuery): The query document, its operation name and its variables.
+//   - locale (string): The locale of the article contents.
+//
+// Returns:
+//   - *gql.Result: The data and the errors of the query. Data is nil for rejected queries.
+func Execute(query dto.GraphQLQuery, locale string) *gql.Result {
+	// Syntax errors and unknown operations are reported by the executor with their location
+	if cost, err := appUtils.MeasureGraphQL(query.Query, query.OperationName, query.Variables, listSizes); err == nil {
+		if maxDepth := utils.Getenv("GRAPHQL_MAX_DEPTH", 8); cost.Depth > maxDepth {
+			return rejected(fmt.Sprintf("Query depth %d exceeds the limit of %d", cost.Depth, maxDepth))
+		}
+
+		if maxComplexity := utils.Getenv("GRAPHQL_MAX_COMPLEXITY", 2000); cost.Complexity > maxComplexity {
+			return rejected(fmt.Sprintf("Query complexity %d exceeds the limit of %d", cost.Complexity, maxComplexity))
+		}
+	}
+
+	return gql.Do(gql.Params{
+		Schema:         schema,
+		RequestString:  query.Query,
+		VariableValues: query.Variables,
+		OperationName:  query.OperationName,
+		Context:        context.WithValue(context.Background(), requestKey{}, newRequestState(locale)),
+	})
+}
+
+// rejected result of a query refused before it runs.
+func rejected(message string) *gql.Result {
+	return &gql.Result{
+		Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(message)},
+	}
+}
@@ -0,0 +1,101 @@
+package graphql
+
+import (
+	"context"
+	"gfly/app/domain/models"
+	"gfly/app/services"
+	"slices"
+)
+
+// requestKey key of the request state in the context of the resolvers.
+type requestKey struct{}
+
+// requestState data shared by the resolvers of a request.
+type requestState struct {
+	locale     string                          // Locale of the article contents
+	authors    *batchLoader[models.User]       // Authors by user ID
+	categories *batchLoader[[]models.Category] // Categories by article ID
+}
+
+// newRequestState creates the loaders of a request. Loaders cache their values,
+// they must not be shared between requests.
+func newRequestState(locale string) *requestState {
+	return &requestState{
+		locale: locale,
+		authors: newBatchLoader(func(userIDs []int) (map[int]models.User, error) {
+			users, err := services.FindUsersByIDs(userIDs)
+			if err != nil {
+				return nil, err
+			}
+
+			authors := make(map[int]models.User, len(users))
+			for _, user := range users {
+				if !user.DeletedAt.Valid {
+					authors[user.ID] = user
+				}
+			}
+
+			return authors, nil
+		}),
+		categories: newBatchLoader(services.FindCategoriesOfArticles),
+	}
+}
+
+// stateOf returns the request state of the resolver context.
+func stateOf(ctx context.Context) *requestState {
+	if state, ok := ctx.Value(requestKey{}).(*requestState); ok {
+		return state
+	}
+
+	return newRequestState(services.DefaultLocale())
+}
+
+// batchLoader collects the keys requested by sibling fields and loads them with a single query.
+//
+// The executor resolves all the fields of a level before it calls their thunks (breadth-first),
+// so the authors of a page of articles are requested first and loaded together by the first thunk.
+// Resolvers of a request run sequentially, the loader needs no lock.
+type batchLoader[V any] struct {
+	fetch   func(keys []int) (map[int]V, error)
+	pending []int
+	values  map[int]V
+}
+
+// newBatchLoader creates a loader fetching its values with the given function.
+func newBatchLoader[V any](fetch func(keys []int) (map[int]V, error)) *batchLoader[V] {
+	return &batchLoader[V]{
+		fetch:  fetch,
+		values: map[int]V{},
+	}
+}
+
+// load queues a key and returns the thunk of its value. The value is missing (false) for an unknown key.
+func (l *batchLoader[V]) load(key int) func() (V, bool, error) {
+	if _, ok := l.values[key]; !ok && !slices.Contains(l.pending, key) {
+		l.pending = append(l.pending, key)
+	}
+
+	return func() (V, bool, error) {
+		if len(l.pending) > 0 {
+			keys := l.pending
+			l.pending = nil
+
+			values, err := l.fetch(keys)
+			if err != nil {
+				var zero V
+
+				return zero, false, err
+			}
+
+			for _, key := range keys {
+				if value, ok := values[key]; ok {
+					l.values[key] = value
+				}
+			}
+		}
+
+		value, ok := l.values[key]
+
+		return value, ok, nil
+	}
+}
@@ -0,0 +1,306 @@
+package graphql
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	"github.com/gflydev/validation"
+	gql "github.com/graphql-go/graphql"
+)
+
+// defaultPerPage page size of the article lists without `perPage` argument.
+const defaultPerPage = 10
+
+// articleListFields columns of the listed articles: the card fields, never the content.
+const articleListFields = "id,title,slug,excerpt,cover_image,author_id,published_at,videos,view_count,content_warnings,age_rating,access_level"
+
+// ====================================================================
+// ========================== Root resolvers ==========================
+// ====================================================================
+
+// resolveArticles resolves a page of published articles.
+func resolveArticles(p gql.ResolveParams, filter dto.ArticleFilter) (any, error) {
+	if err := checkArticleFilter(filter); err != nil {
+		return nil, err
+	}
+
+	var articles []models.Article
+	var pagination map[string]any
+
+	if filter.IsCursor() {
+		var cursors dto.Cursors
+		var total int
+		var err error
+
+		articles, cursors, total, err = services.FindArticlesByCursor(filter)
+		if err != nil {
+			if err.Error() == "Invalid cursor" || strings.HasPrefix(err.Error(), "Cursor pagination") {
+				return nil, err
+			}
+
+			log.Errorf("Error while fetching articles: %v", err)
+
+			return nil, errors.New("Error occurred while fetching articles")
+		}
+
+		pagination = map[string]any{
+			"perPage":    filter.PerPage,
+			"total":      total,
+			"hasMore":    cursors.Next != "",
+			"nextCursor": nullString(cursors.Next),
+			"prevCursor": nullString(cursors.Prev),
+		}
+	} else {
+		var total int
+		var err error
+
+		articles, total, err = services.FindArticles(filter)
+		if err != nil {
+			log.Errorf("Error while fetching articles: %v", err)
+
+			return nil, errors.New("Error occurred while fetching articles")
+		}
+
+		totalPages := (total + filter.PerPage - 1) / filter.PerPage
+		pagination = map[string]any{
+			"currentPage": filter.Page,
+			"perPage":     filter.PerPage,
+			"total":       total,
+			"totalPages":  totalPages,
+			"hasMore":     filter.Page < totalPages,
+		}
+	}
+
+	return map[string]any{
+		"items":      articleNodes(p, articles),
+		"pagination": pagination,
+	}, nil
+}
+
+// resolveArticle resolves a published article by its slug. Missing articles are null.
+func resolveArticle(p gql.ResolveParams) (any, error) {
+	article, err := services.GetPublishedArticleBySlug(p.Args["slug"].(string))
+	if err != nil {
+		return nil, nil
+	}
+
+	return articleNodes(p, []models.Article{*article})[0], nil
+}
+
+// resolveTrending resolves the trending articles.
+func resolveTrending(p gql.ResolveParams) (any, error) {
+	limit, _ := p.Args["limit"].(int)
+	if limit < 1 || limit > maxPerPage() {
+		return nil, errors.New("limit must be between 1 and %d", maxPerPage())
+	}
+
+	articles, err := services.FindTrendingArticles(limit)
+	if err != nil {
+		log.Errorf("Error while fetching trending articles: %v", err)
+
+		return nil, errors.New("Error occurred while fetching articles")
+	}
+
+	return articleNodes(p, articles), nil
+}
+
+// resolveCategories resolves all the story categories.
+func resolveCategories(_ gql.ResolveParams) (any, error) {
+	categories, err := services.FindCategories()
+	if err != nil {
+		log.Errorf("Error while fetching categories: %v", err)
+
+		return nil, errors.New("Error occurred while fetching categories")
+	}
+
+	return categories, nil
+}
+
+// resolveCategory resolves a category by its slug. Missing categories are null.
+func resolveCategory(p gql.ResolveParams) (any, error) {
+	category, err := services.GetCategoryBySlug(p.Args["slug"].(string))
+	if err != nil {
+		return nil, nil
+	}
+
+	return *category, nil
+}
+
+// resolveAuthor resolves an author by ID. Users without published articles are null,
+// the catalogue doesn't list the accounts of readers.
+func resolveAuthor(p gql.ResolveParams) (any, error) {
+	authorID := p.Args["id"].(int)
+
+	_, total, err := services.FindArticles(dto.ArticleFilter{
+		Filter:   dto.Filter{Page: 1, PerPage: 1, Fields: "id"},
+		Status:   types.ArticleStatusPublished,
+		AuthorID: authorID,
+	})
+	if err != nil {
+		log.Errorf("Error while fetching articles of author %d: %v", authorID, err)
+
+		return nil, errors.New("Error occurred while fetching authors")
+	}
+
+	if total == 0 {
+		return nil, nil
+	}
+
+	return authorThunk(p, authorID), nil
+}
+
+// ====================================================================
+// ========================= Field resolvers ==========================
+// ====================================================================
+
+// resolveArticleAuthor resolves the author of an article with the batch loader of the request.
+func resolveArticleAuthor(p gql.ResolveParams) (any, error) {
+	return authorThunk(p, p.Source.(articleNode).AuthorID), nil
+}
+
+// resolveArticleCategories resolves the categories of an article with the batch loader of the request.
+func resolveArticleCategories(p gql.ResolveParams) (any, error) {
+	load := stateOf(p.Context).categories.load(p.Source.(articleNode).ID)
+
+	return func() (any, error) {
+		categories, _, err := load()
+		if err != nil {
+			log.Errorf("Error while fetching article categories: %v", err)
+
+			return nil, errors.New("Error occurred while fetching categories")
+		}
+
+		if categories == nil {
+			categories = []models.Category{}
+		}
+
+		return categories, nil
+	}, nil
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// maxPerPage the largest page of the article lists.
+func maxPerPage() int {
+	return utils.Getenv("GRAPHQL_MAX_PER_PAGE", 50)
+}
+
+// articleFilter converts the arguments of an article list to the filter of the article list API.
+// Only published articles are listed.
+func articleFilter(args map[string]any) dto.ArticleFilter {
+	filter := dto.ArticleFilter{
+		Filter: dto.Filter{
+			Fields: articleListFields,
+		},
+		Status: types.ArticleStatusPublished,
+	}
+
+	filter.Page, _ = args["page"].(int)
+	filter.PerPage, _ = args["perPage"].(int)
+	filter.Pagination, _ = args["pagination"].(string)
+	filter.Cursor, _ = args["cursor"].(string)
+	filter.Keyword, _ = args["keyword"].(string)
+	filter.OrderBy, _ = args["orderBy"].(string)
+	filter.AuthorID, _ = args["authorId"].(int)
+	filter.CategoryID, _ = args["categoryId"].(int)
+	filter.PublishedFrom, _ = args["publishedFrom"].(string)
+	filter.PublishedTo, _ = args["publishedTo"].(string)
+	filter.CreatedFrom, _ = args["createdFrom"].(string)
+	filter.CreatedTo, _ = args["createdTo"].(string)
+	filter.MinViews, _ = args["minViews"].(int)
+
+	if hasVideo, ok := args["hasVideo"].(bool); ok {
+		filter.HasVideo = strconv.FormatBool(hasVideo)
+	}
+
+	if hasCover, ok := args["hasCover"].(bool); ok {
+		filter.HasCover = strconv.FormatBool(hasCover)
+	}
+
+	if warnings, ok := args["excludeWarnings"].([]any); ok {
+		var names []string
+		for _, warning := range warnings {
+			names = append(names, warning.(string))
+		}
+
+		filter.ExcludeWarnings = strings.Join(names, ",")
+	}
+
+	if accessLevel, ok := args["accessLevel"].(string); ok {
+		filter.AccessLevel = types.AccessLevel(accessLevel)
+	}
+
+	return filter
+}
+
+// checkArticleFilter validates an article list filter with the rules of the article list API.
+func checkArticleFilter(filter dto.ArticleFilter) error {
+	if filter.Page < 1 {
+		return errors.New("page must be greater than or equal to 1")
+	}
+
+	if filter.PerPage < 1 || filter.PerPage > maxPerPage() {
+		return errors.New("perPage must be between 1 and %d", maxPerPage())
+	}
+
+	errorData, err := validation.Check(filter, dto.MsgForTag)
+	if err == nil {
+		return nil
+	}
+
+	fields := make([]string, 0, len(errorData))
+	for field := range errorData {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	messages := make([]string, len(fields))
+	for i, field := range fields {
+		fieldMessages, _ := errorData[field].([]string)
+		messages[i] = field + ": " + strings.Join(fieldMessages, ", ")
+	}
+
+	return errors.New("Invalid input: %s", strings.Join(messages, "; "))
+}
+
+// articleNodes localizes articles to the locale of the request.
+func articleNodes(p gql.ResolveParams, articles []models.Article) []articleNode {
+	articles, locales := services.LocalizeArticles(articles, stateOf(p.Context).locale)
+
+	nodes := make([]articleNode, len(articles))
+	for i := range articles {
+		nodes[i] = articleNode{Article: articles[i], locale: locales[i]}
+	}
+
+	return nodes
+}
+
+// authorThunk queues an author in the batch loader of the request and returns the thunk of its value.
+func authorThunk(p gql.ResolveParams, authorID int) func() (any, error) {
+	load := stateOf(p.Context).authors.load(authorID)
+
+	return func() (any, error) {
+		author, ok, err := load()
+		if err != nil {
+			log.Errorf("Error while fetching authors: %v", err)
+
+			return nil, errors.New("Error occurred while fetching authors")
+		}
+
+		if !ok {
+			return nil, nil
+		}
+
+		return author, nil
+	}
+}
@@ -0,0 +1,265 @@
+package graphql
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"time"
+
+	gql "github.com/graphql-go/graphql"
+)
+
+// articleNode an article of the catalogue with the locale of its content.
+type articleNode struct {
+	models.Article
+	locale string
+}
+
+// ====================================================================
+// ============================== Types ===============================
+// ====================================================================
+
+// Types of the schema. They reference each other, so they are created by init.
+var (
+	paginationType        *gql.Object
+	articleType           *gql.Object
+	articleConnectionType *gql.Object
+	authorType            *gql.Object
+	categoryType          *gql.Object
+	queryType             *gql.Object
+)
+
+// schema the read-only schema of the story catalogue.
+var schema gql.Schema
+
+func init() {
+	paginationType = gql.NewObject(gql.ObjectConfig{
+		Name:        "Pagination",
+		Description: "Page details of a list. Page mode fills currentPage and totalPages, cursor mode nextCursor and prevCursor.",
+		Fields: gql.Fields{
+			"currentPage": &gql.Field{Type: gql.Int},
+			"perPage":     &gql.Field{Type: gql.NewNonNull(gql.Int)},
+			"total":       &gql.Field{Type: gql.NewNonNull(gql.Int)},
+			"totalPages":  &gql.Field{Type: gql.Int},
+			"hasMore":     &gql.Field{Type: gql.NewNonNull(gql.Boolean)},
+			"nextCursor":  &gql.Field{Type: gql.String},
+			"prevCursor":  &gql.Field{Type: gql.String},
+		},
+	})
+
+	articleType = gql.NewObject(gql.ObjectConfig{
+		Name:        "Article",
+		Description: "A published story. The full content is served by the article API (age gate, members-only teaser).",
+		Fields: gql.FieldsThunk(func() gql.Fields {
+			return gql.Fields{
+				"id":              articleField(gql.NewNonNull(gql.Int), func(a articleNode) any { return a.ID }),
+				"title":           articleField(gql.NewNonNull(gql.String), func(a articleNode) any { return a.Title }),
+				"slug":            articleField(gql.NewNonNull(gql.String), func(a articleNode) any { return a.Slug }),
+				"excerpt":         articleField(gql.String, func(a articleNode) any { return nullString(a.Excerpt.String) }),
+				"coverImage":      articleField(gql.String, func(a articleNode) any { return nullString(a.CoverImage.String) }),
+				"publishedAt":     articleField(gql.DateTime, func(a articleNode) any { return nullTime(a.PublishedAt.Time) }),
+				"viewCount":       articleField(gql.NewNonNull(gql.Int), func(a articleNode) any { return a.ViewCount }),
+				"contentWarnings": articleField(gql.NewNonNull(gql.NewList(gql.NewNonNull(gql.String))), func(a articleNode) any { return services.ContentWarnings(a.Article) }),
+				"ageRating":       articleField(gql.NewNonNull(gql.Int), func(a articleNode) any { return a.AgeRating }),
+				"accessLevel":     articleField(gql.NewNonNull(gql.String), func(a articleNode) any { return string(a.AccessLevel) }),
+				"hasVideo":        articleField(gql.NewNonNull(gql.Boolean), func(a articleNode) any { return a.YouTubeURL.String != "" || a.TikTokURL.String != "" }),
+				"locale":          articleField(gql.NewNonNull(gql.String), func(a articleNode) any { return a.locale }),
+				"author": &gql.Field{
+					Type:    authorType,
+					Resolve: resolveArticleAuthor,
+				},
+				"categories": &gql.Field{
+					Type:    gql.NewNonNull(gql.NewList(gql.NewNonNull(categoryType))),
+					Resolve: resolveArticleCategories,
+				},
+			}
+		}),
+	})
+
+	articleConnectionType = gql.NewObject(gql.ObjectConfig{
+		Name: "ArticleConnection",
+		Fields: gql.Fields{
+			"items":      &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(articleType)))},
+			"pagination": &gql.Field{Type: gql.NewNonNull(paginationType)},
+		},
+	})
+
+	authorType = gql.NewObject(gql.ObjectConfig{
+		Name:        "Author",
+		Description: "The author of published stories.",
+		Fields: gql.FieldsThunk(func() gql.Fields {
+			return gql.Fields{
+				"id":       userField(gql.NewNonNull(gql.Int), func(u models.User) any { return u.ID }),
+				"fullname": userField(gql.NewNonNull(gql.String), func(u models.User) any { return u.Fullname }),
+				"avatar":   userField(gql.String, func(u models.User) any { return nullString(u.Avatar.String) }),
+				"articles": articlesField("Published stories of the author.", func(filter *dto.ArticleFilter, source any) {
+					filter.AuthorID = source.(models.User).ID
+				}),
+			}
+		}),
+	})
+
+	categoryType = gql.NewObject(gql.ObjectConfig{
+		Name:        "Category",
+		Description: "A story category.",
+		Fields: gql.FieldsThunk(func() gql.Fields {
+			return gql.Fields{
+				"id":   categoryField(gql.NewNonNull(gql.Int), func(c models.Category) any { return c.ID }),
+				"name": categoryField(gql.NewNonNull(gql.String), func(c models.Category) any { return c.Name }),
+				"slug": categoryField(gql.NewNonNull(gql.String), func(c models.Category) any { return c.Slug }),
+				"articles": articlesField("Published stories of the category.", func(filter *dto.ArticleFilter, source any) {
+					filter.CategoryID = source.(models.Category).ID
+				}),
+			}
+		}),
+	})
+
+	queryType = gql.NewObject(gql.ObjectConfig{
+		Name: "Query",
+		Fields: gql.Fields{
+			"articles": articlesField("Published stories, filtered like the article list API.", nil),
+			"article": &gql.Field{
+				Type:        articleType,
+				Description: "A published story by its slug.",
+				Args: gql.FieldConfigArgument{
+					"slug": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+				},
+				Resolve: resolveArticle,
+			},
+			"trending": &gql.Field{
+				Type:        gql.NewNonNull(gql.NewList(gql.NewNonNull(articleType))),
+				Description: "Trending stories, highest score first.",
+				Args: gql.FieldConfigArgument{
+					"limit": &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 10},
+				},
+				Resolve: resolveTrending,
+			},
+			"categories": &gql.Field{
+				Type:        gql.NewNonNull(gql.NewList(gql.NewNonNull(categoryType))),
+				Description: "Story categories ordered by name.",
+				Resolve:     resolveCategories,
+			},
+			"category": &gql.Field{
+				Type:        categoryType,
+				Description: "A story category by its slug.",
+				Args: gql.FieldConfigArgument{
+					"slug": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+				},
+				Resolve: resolveCategory,
+			},
+			"author": &gql.Field{
+				Type:        authorType,
+				Description: "An author by its ID, null for users without published stories.",
+				Args: gql.FieldConfigArgument{
+					"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Int)},
+				},
+				Resolve: resolveAuthor,
+			},
+		},
+	})
+
+	schema = mustSchema()
+}
+
+// mustSchema builds the schema. The types are static, an error is a programming error.
+func mustSchema() gql.Schema {
+	catalogue, err := gql.NewSchema(gql.SchemaConfig{Query: queryType})
+	if err != nil {
+		panic(err)
+	}
+
+	return catalogue
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// articlesField the paginated article list field. The list of an author or a category
+// is scoped to its source, the author and category arguments are only given to the root list.
+func articlesField(description string, scope func(filter *dto.ArticleFilter, source any)) *gql.Field {
+	args := gql.FieldConfigArgument{
+		"page":            &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 1},
+		"perPage":         &gql.ArgumentConfig{Type: gql.Int, DefaultValue: defaultPerPage},
+		"pagination":      &gql.ArgumentConfig{Type: gql.String, Description: "page (default) or cursor"},
+		"cursor":          &gql.ArgumentConfig{Type: gql.String, Description: "nextCursor or prevCursor of the previous page (cursor mode)"},
+		"keyword":         &gql.ArgumentConfig{Type: gql.String},
+		"orderBy":         &gql.ArgumentConfig{Type: gql.String, Description: "id, title, slug, published_at, created_at or trending, prefix with '-' for descending"},
+		"publishedFrom":   &gql.ArgumentConfig{Type: gql.String, Description: "YYYY-MM-DD"},
+		"publishedTo":     &gql.ArgumentConfig{Type: gql.String, Description: "YYYY-MM-DD"},
+		"createdFrom":     &gql.ArgumentConfig{Type: gql.String, Description: "YYYY-MM-DD"},
+		"createdTo":       &gql.ArgumentConfig{Type: gql.String, Description: "YYYY-MM-DD"},
+		"hasVideo":        &gql.ArgumentConfig{Type: gql.Boolean},
+		"hasCover":        &gql.ArgumentConfig{Type: gql.Boolean},
+		"minViews":        &gql.ArgumentConfig{Type: gql.Int},
+		"excludeWarnings": &gql.ArgumentConfig{Type: gql.NewList(gql.NewNonNull(gql.String))},
+		"accessLevel":     &gql.ArgumentConfig{Type: gql.String, Description: "public, members or premium"},
+	}
+
+	if scope == nil {
+		args["authorId"] = &gql.ArgumentConfig{Type: gql.Int}
+		args["categoryId"] = &gql.ArgumentConfig{Type: gql.Int}
+	}
+
+	return &gql.Field{
+		Type:        gql.NewNonNull(articleConnectionType),
+		Description: description,
+		Args:        args,
+		Resolve: func(p gql.ResolveParams) (any, error) {
+			filter := articleFilter(p.Args)
+			if scope != nil {
+				scope(&filter, p.Source)
+			}
+
+			return resolveArticles(p, filter)
+		},
+	}
+}
+
+// articleField a field of the Article type.
+func articleField(fieldType gql.Output, value func(article articleNode) any) *gql.Field {
+	return &gql.Field{
+		Type: fieldType,
+		Resolve: func(p gql.ResolveParams) (any, error) {
+			return value(p.Source.(articleNode)), nil
+		},
+	}
+}
+
+// userField a field of the Author type.
+func userField(fieldType gql.Output, value func(user models.User) any) *gql.Field {
+	return &gql.Field{
+		Type: fieldType,
+		Resolve: func(p gql.ResolveParams) (any, error) {
+			return value(p.Source.(models.User)), nil
+		},
+	}
+}
+
+// categoryField a field of the Category type.
+func categoryField(fieldType gql.Output, value func(category models.Category) any) *gql.Field {
+	return &gql.Field{
+		Type: fieldType,
+		Resolve: func(p gql.ResolveParams) (any, error) {
+			return value(p.Source.(models.Category)), nil
+		},
+	}
+}
+
+// nullString returns nil for an empty string.
+func nullString(value string) any {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
+
+// nullTime returns nil for a zero time.
+func nullTime(value time.Time) any {
+	if value.IsZero() {
+		return nil
+	}
+
+	return value
+}
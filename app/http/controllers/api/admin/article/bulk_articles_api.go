@@ -0,0 +1,69 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type BulkArticlesApi struct {
+	core.Api
+}
+
+func NewBulkArticlesApi() *BulkArticlesApi {
+	return &BulkArticlesApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *BulkArticlesApi) Validate(c *core.Ctx) error {
+	return http.ProcessRequest[request.BulkArticles, dto.BulkArticles](c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function applies an action to several articles
+// @Description Function applies an action to up to 100 articles: publish, archive, delete, restore or assign_categories.
+// @Description Articles are changed one by one, the result of each one is in `results`; failed items are left unchanged.
+// @Description `delete` hides the articles (soft delete, unlike `DELETE /admin/articles/{id}` which is permanent) and `restore` brings them back.
+// @Description Deleted articles are listed with `GET /admin/articles?deleted=only` and found in the audit log (`article.bulk_delete`).
+// @Description `assign_categories` adds `category_ids` to the categories of each article (max 10 per article).
+// @Summary Bulk update articles
+// @Tags Articles
+// @Accept json
+// @Produce json
+// @Param request body request.BulkArticles true "Article IDs and action"
+// @Success 200 {object} dto.BulkResult
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /admin/articles/bulk [post]
+func (h *BulkArticlesApi) Handle(c *core.Ctx) error {
+	bulkDto := c.GetData(constants.Data).(dto.BulkArticles)
+
+	result, err := services.BulkUpdateArticles(bulkDto)
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	return c.Success(result)
+}
@@ -61,6 +61,7 @@ func (h *CreateArticleApi) Handle(c *core.Ctx) error {
 
 	// Transform to response data
 	articleResponse := transformers.ToArticleResponse(*article)
+	articleResponse.CategoryIDs, _ = services.FindArticleCategoryIDs(article.ID)
 
 	return c.
 		Status(core.StatusCreated).
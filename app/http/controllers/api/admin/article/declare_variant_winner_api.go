@@ -0,0 +1,72 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type DeclareVariantWinnerApi struct {
+	core.Api
+}
+
+func NewDeclareVariantWinnerApi() *DeclareVariantWinnerApi {
+	return &DeclareVariantWinnerApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *DeclareVariantWinnerApi) Validate(c *core.Ctx) error {
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function ends the A/B test of an article with a winner
+// @Description Function writes the title and cover image of the winning variant to the article and ends its A/B test.
+// @Description Declaring the control `a` keeps the article as is.
+// @Summary Declare article A/B test winner
+// @Tags Articles
+// @Produce json
+// @Param id path int true "Article ID"
+// @Param key path string true "Variant key (a to d)"
+// @Success 200 {object} response.Article
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/articles/{id}/variants/{key}/winner [post]
+func (h *DeclareVariantWinnerApi) Handle(c *core.Ctx) error {
+	articleID := c.GetData(constants.Data).(int)
+
+	article, err := services.DeclareVariantWinner(articleID, c.PathVal("key"))
+	if err != nil {
+		log.Error(err)
+
+		if err.Error() == "Article not found" || err.Error() == "Variant not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: err.Error(),
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(transformers.ToArticleResponse(*article))
+}
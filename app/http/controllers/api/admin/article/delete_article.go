@@ -36,6 +36,8 @@ func (h *DeleteArticleApi) Validate(c *core.Ctx) error {
 
 // Handle function allows users to delete an article
 // @Description Function allows users to delete an article
+// @Description The article is deleted permanently with its translations and statistics. To hide articles
+// @Description and keep them restorable, use the `delete` action of `POST /admin/articles/bulk`.
 // @Summary Delete an article
 // @Tags Articles
 // @Accept json
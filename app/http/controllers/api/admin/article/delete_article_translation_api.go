@@ -0,0 +1,69 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type DeleteArticleTranslationApi struct {
+	core.Api
+}
+
+func NewDeleteArticleTranslationApi() *DeleteArticleTranslationApi {
+	return &DeleteArticleTranslationApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *DeleteArticleTranslationApi) Validate(c *core.Ctx) error {
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function deletes the translation of an article in a locale
+// @Description Function deletes the translation of an article in a locale
+// @Summary Delete article translation
+// @Tags Articles
+// @Produce json
+// @Param id path int true "Article ID"
+// @Param locale path string true "Locale (e.g. en)"
+// @Success 204
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/articles/{id}/translations/{locale} [delete]
+func (h *DeleteArticleTranslationApi) Handle(c *core.Ctx) error {
+	articleID := c.GetData(constants.Data).(int)
+
+	if err := services.DeleteArticleTranslation(articleID, c.PathVal("locale")); err != nil {
+		log.Error(err)
+
+		if err.Error() == "Translation not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while deleting the article translation",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.NoContent()
+}
@@ -0,0 +1,68 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type DeleteArticleVariantsApi struct {
+	core.Api
+}
+
+func NewDeleteArticleVariantsApi() *DeleteArticleVariantsApi {
+	return &DeleteArticleVariantsApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *DeleteArticleVariantsApi) Validate(c *core.Ctx) error {
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function stops the A/B test of an article without a winner
+// @Description Function stops the A/B test of an article, the article keeps its title and cover image
+// @Summary Stop article A/B test
+// @Tags Articles
+// @Produce json
+// @Param id path int true "Article ID"
+// @Success 204
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/articles/{id}/variants [delete]
+func (h *DeleteArticleVariantsApi) Handle(c *core.Ctx) error {
+	articleID := c.GetData(constants.Data).(int)
+
+	if err := services.StopArticleExperiment(articleID); err != nil {
+		log.Error(err)
+
+		if err.Error() == "Article not found" || err.Error() == "Experiment not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while stopping the A/B test",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.NoContent()
+}
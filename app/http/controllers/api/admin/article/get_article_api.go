@@ -66,14 +66,12 @@ func (h *GetArticleByIdApi) Handle(c *core.Ctx) error {
 	// unless explicitly requested
 	countView := c.QueryStr("count_view") == "true"
 	if countView {
-		if err := services.IncrementArticleViewCount(articleID); err != nil {
-			log.Warnf("Failed to increment view count for article %d: %v", articleID, err)
-			// Continue even if view count update fails
-		}
+		http.TrackArticleView(c, articleID)
 	}
 
 	// Transform to response data
 	articleResponse := transformers.ToArticleResponse(*article)
+	articleResponse.CategoryIDs, _ = services.FindArticleCategoryIDs(article.ID)
 
 	return c.Success(articleResponse)
 }
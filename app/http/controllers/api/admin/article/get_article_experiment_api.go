@@ -0,0 +1,62 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type GetArticleExperimentApi struct {
+	core.Api
+}
+
+func NewGetArticleExperimentApi() *GetArticleExperimentApi {
+	return &GetArticleExperimentApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *GetArticleExperimentApi) Validate(c *core.Ctx) error {
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function gets the results of the A/B test of an article
+// @Description Function gets the impressions, clicks, click-through rate, lift and confidence of each variant of an article.
+// @Description The leader is `significant` when it differs from the control with `required_confidence`; declare it the winner then.
+// @Description Counts are written every minute (VIEW_FLUSH_SCHEDULE).
+// @Summary Get article A/B test results
+// @Tags Articles
+// @Produce json
+// @Param id path int true "Article ID"
+// @Success 200 {object} response.ArticleExperiment
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/articles/{id}/variants [get]
+func (h *GetArticleExperimentApi) Handle(c *core.Ctx) error {
+	articleID := c.GetData(constants.Data).(int)
+
+	experiment, err := services.ArticleExperimentStats(articleID)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusNotFound,
+			Message: err.Error(),
+		}, core.StatusNotFound)
+	}
+
+	return c.Success(transformers.ToArticleExperimentResponse(*experiment))
+}
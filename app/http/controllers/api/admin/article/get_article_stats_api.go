@@ -0,0 +1,100 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type GetArticleStatsApi struct {
+	core.Api
+}
+
+func NewGetArticleStatsApi() *GetArticleStatsApi {
+	return &GetArticleStatsApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *GetArticleStatsApi) Validate(c *core.Ctx) error {
+	articleID, errData := http.PathID(c)
+	if errData != nil {
+		return c.Error(errData)
+	}
+
+	filter := dto.ArticleStatsFilter{
+		ArticleID:   articleID,
+		From:        c.QueryStr("from"),
+		To:          c.QueryStr("to"),
+		Granularity: c.QueryStr("granularity"),
+	}
+
+	if errData := http.Validate(filter); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, filter)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function gets the analytics of an article
+// @Description Function gets the views over time, top referrers, devices, unique visitors and read-through rate of an article.
+// @Description The read-through rate is null when no reading progress was reported in the period.
+// @Summary Get article analytics
+// @Tags Articles
+// @Produce json
+// @Param id path int true "Article ID"
+// @Param from query string false "First day of the period (YYYY-MM-DD, default: 30 days ago)"
+// @Param to query string false "Last day of the period (YYYY-MM-DD, default: today)"
+// @Param granularity query string false "Bucket of the views over time: hour, day (default), week or month"
+// @Success 200 {object} response.ArticleStats
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/articles/{id}/stats [get]
+func (h *GetArticleStatsApi) Handle(c *core.Ctx) error {
+	filter := c.GetData(constants.Data).(dto.ArticleStatsFilter)
+
+	stats, err := services.ArticleStats(filter)
+	if err != nil {
+		switch err.Error() {
+		case "Article not found":
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		case "Error occurs while computing article stats":
+			log.Error(err)
+
+			return c.Error(response.Error{
+				Code:    core.StatusInternalServerError,
+				Message: err.Error(),
+			}, core.StatusInternalServerError)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	return c.Success(transformers.ToArticleStatsResponse(*stats))
+}
@@ -0,0 +1,47 @@
+package article
+
+import (
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type GetImportApi struct {
+	core.Api
+}
+
+func NewGetImportApi() *GetImportApi {
+	return &GetImportApi{}
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function returns the progress and report of an article import
+// @Description Function returns the progress and per-row error log of an article import
+// @Summary Get article import report
+// @Tags Articles
+// @Produce json
+// @Param id path string true "Import ID"
+// @Success 200 {object} dto.ImportReport
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/articles/imports/{id} [get]
+func (h *GetImportApi) Handle(c *core.Ctx) error {
+	report, err := services.GetImportReport(c.PathVal("id"))
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusNotFound,
+			Message: err.Error(),
+		}, core.StatusNotFound)
+	}
+
+	return c.Success(report)
+}
@@ -0,0 +1,156 @@
+package article
+
+import (
+	"gfly/app/console/queues"
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/services"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gflydev/console"
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ImportArticlesApi struct {
+	core.Api
+}
+
+func NewImportArticlesApi() *ImportArticlesApi {
+	return &ImportArticlesApi{}
+}
+
+// importUpload struct to describe a validated import upload.
+type importUpload struct {
+	Options dto.ImportOptions
+	Data    []byte
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *ImportArticlesApi) Validate(c *core.Ctx) error {
+	options := dto.ImportOptions{
+		Format: dto.ImportFormat(strings.ToLower(string(c.FormVal("format")))),
+	}
+	options.DryRun, _ = strconv.ParseBool(string(c.FormVal("dry_run")))
+
+	if authorID := string(c.FormVal("author_id")); authorID != "" {
+		options.AuthorID, _ = strconv.Atoi(authorID)
+	} else if user, ok := c.GetData(constants.User).(models.User); ok {
+		options.AuthorID = user.ID
+	}
+
+	if errData := http.Validate(options); errData != nil {
+		return c.Error(errData)
+	}
+
+	files, err := c.FormUpload("file")
+	if err != nil || len(files) == 0 {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: "Missing import file",
+		})
+	}
+
+	data, err := os.ReadFile(files[0].Path)
+	_ = os.Remove(files[0].Path)
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: "Unable to read import file",
+		})
+	}
+
+	// Store data into context.
+	c.SetData(constants.Data, importUpload{
+		Options: options,
+		Data:    data,
+	})
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function imports articles from a WordPress export (WXR), CSV or JSON file
+// @Description Function imports articles from a WordPress export (WXR), CSV or JSON file.
+// @Description Files up to `IMPORT_SYNC_LIMIT` rows are imported right away; larger files are queued and return 202 with the import ID.
+// @Description Categories in the file are matched by slug and created when missing (`created_categories`); tags are reported in `ignored_fields` and not stored.
+// @Description Imported stories keep their `published_at` and don't notify followers nor webhooks.
+// @Description `authors` tells how each author email was resolved; a dry run reports `user_id` 0 for the authors it would create.
+// @Summary Import articles
+// @Tags Articles
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Import file"
+// @Param format formData string true "File format (wxr, csv, json)"
+// @Param dry_run formData bool false "Validate and report without writing"
+// @Param author_id formData int false "Author of rows without author email (current user by default)"
+// @Success 200 {object} dto.ImportReport
+// @Success 202 {object} dto.ImportReport
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /admin/articles/import [post]
+func (h *ImportArticlesApi) Handle(c *core.Ctx) error {
+	upload := c.GetData(constants.Data).(importUpload)
+
+	rows, err := services.ParseImportFile(upload.Options.Format, upload.Data)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	report := services.NewImportReport()
+
+	// Small files are imported right away
+	if len(rows) <= utils.Getenv("IMPORT_SYNC_LIMIT", 200) {
+		services.ImportArticles(rows, upload.Options, &report, nil)
+
+		if err = services.SaveImportReport(report); err != nil {
+			log.Warnf("Failed to save report of import %s: %v", report.ID, err)
+		}
+
+		return c.Success(report)
+	}
+
+	// Large files are processed by the queue worker
+	file, err := services.StoreImportFile(report.ID, upload.Options.Format, upload.Data)
+	if err == nil {
+		report.Total = len(rows)
+		err = services.SaveImportReport(report)
+	}
+
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Unable to queue import",
+		}, core.StatusInternalServerError)
+	}
+
+	console.DispatchTask(queues.NewImportTask(report, upload.Options, file))
+
+	return c.
+		Status(core.StatusAccepted).
+		JSON(report)
+}
@@ -0,0 +1,70 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ListArticleTranslationsApi struct {
+	core.Api
+}
+
+func NewListArticleTranslationsApi() *ListArticleTranslationsApi {
+	return &ListArticleTranslationsApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *ListArticleTranslationsApi) Validate(c *core.Ctx) error {
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function lists the translations of an article
+// @Description Function lists the translations of an article. The article itself holds the default locale.
+// @Summary List article translations
+// @Tags Articles
+// @Produce json
+// @Param id path int true "Article ID"
+// @Success 200 {array} response.ArticleTranslation
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/articles/{id}/translations [get]
+func (h *ListArticleTranslationsApi) Handle(c *core.Ctx) error {
+	articleID := c.GetData(constants.Data).(int)
+
+	if _, err := services.GetArticleByID(articleID); err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusNotFound,
+			Message: err.Error(),
+		}, core.StatusNotFound)
+	}
+
+	translations, err := services.FindArticleTranslations(articleID)
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while fetching article translations",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(transformers.ToListResponse(translations, transformers.ToArticleTranslationResponse))
+}
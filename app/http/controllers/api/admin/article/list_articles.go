@@ -1,12 +1,12 @@
 package article
 
 import (
-	"gfly/app/domain/models/types"
 	"gfly/app/dto"
 	"gfly/app/http"
 	"gfly/app/http/response"
 	"gfly/app/http/transformers"
 	"gfly/app/services"
+	"strings"
 
 	"github.com/gflydev/core"
 	"github.com/gflydev/core/log"
@@ -30,35 +30,25 @@ func NewListArticlesApi() *ListArticlesApi {
 
 // Validate validates the query parameters for article listing
 func (h *ListArticlesApi) Validate(c *core.Ctx) error {
-	// Create filter from query parameters and validate it
-	filter := dto.ArticleFilter{}
-
-	// Get base filter parameters (page, per_page, keyword, order_by)
-	filter.Page, _ = c.QueryInt("page")
-	filter.PerPage, _ = c.QueryInt("per_page")
-	filter.Keyword = c.QueryStr("keyword")
-	filter.OrderBy = c.QueryStr("order_by")
-
-	// Get article-specific filter parameters
-	status := c.QueryStr("status")
-	if status != "" {
-		filter.Status = types.ArticleStatus(status)
-	}
-
-	// Set default values if not provided
-	if filter.Page < 1 {
-		filter.Page = 1
-	}
-
-	if filter.PerPage < 1 {
-		filter.PerPage = 10
-	}
+	// Create filter from query parameters (shared by the public and admin list APIs)
+	filter := http.ArticleFilterData(c)
+	filter.Deleted = c.QueryStr("deleted")
 
 	// Validate filter
 	if errData := http.Validate(filter); errData != nil {
 		return c.Error(errData)
 	}
 
+	// Resolve sparse fieldset (card fields by default)
+	fields, err := services.ArticleListFields(filter.Fields)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	filter.Fields = strings.Join(fields, ",")
+
 	// Store filter in context
 	c.SetData("filter", filter)
 
@@ -80,15 +70,35 @@ func (h *ListArticlesApi) Validate(c *core.Ctx) error {
 // @Param keyword query string false "Search keyword in title, slug, excerpt, and content"
 // @Param order_by query string false "Field to order by (prefix with '-' for descending, e.g. '-created_at')"
 // @Param status query string false "Filter by article status (draft, published, archived)"
+// @Param author_id query int false "Filter by author ID"
+// @Param category_id query int false "Filter by category ID"
+// @Param published_from query string false "Published on or after this date (YYYY-MM-DD)"
+// @Param published_to query string false "Published on or before this date (YYYY-MM-DD)"
+// @Param created_from query string false "Created on or after this date (YYYY-MM-DD)"
+// @Param created_to query string false "Created on or before this date (YYYY-MM-DD)"
+// @Param has_video query bool false "With (true) or without (false) a YouTube or TikTok video"
+// @Param has_cover query bool false "With (true) or without (false) a cover image"
+// @Param min_views query int false "Minimum view count"
+// @Param exclude_warnings query string false "Comma separated content warnings to exclude (violence, gore, suicide, self_harm, sexual_content, abuse, drugs)"
+// @Param access_level query string false "Filter by access level (public, members, premium)"
+// @Param deleted query string false "Soft deleted articles (e.g. by a bulk delete): only (only them) or with (included), left out by default"
+// @Param pagination query string false "Pagination mode: page (default) or cursor"
+// @Param cursor query string false "Cursor returned as next_cursor or prev_cursor (cursor mode)"
+// @Param fields query string false "Comma separated fields to return (default: card fields without content and SEO)"
 // @Success 200 {object} response.PaginatedResponse
 // @Failure 400 {object} response.Error
 // @Failure 401 {object} response.Unauthorized
 // @Security ApiKeyAuth
-// @Router /articles [get]
+// @Router /admin/articles [get]
 func (h *ListArticlesApi) Handle(c *core.Ctx) error {
 	// Get filter from context
 	filter := c.GetData("filter").(dto.ArticleFilter)
 
+	// Cursor mode for infinite scrolling
+	if filter.IsCursor() {
+		return h.handleCursor(c, filter)
+	}
+
 	// Get articles from service
 	articles, total, err := services.FindArticles(filter)
 	if err != nil {
@@ -101,7 +111,7 @@ func (h *ListArticlesApi) Handle(c *core.Ctx) error {
 	}
 
 	// Transform articles to response format
-	articlesResponse := transformers.ToArticleListResponse(articles)
+	articlesResponse := transformers.ToFieldsResponse(transformers.ToArticleListResponse(articles), strings.Split(filter.Fields, ","))
 
 	// Create paginated response
 	return c.Success(response.PaginatedResponse{
@@ -110,6 +120,37 @@ func (h *ListArticlesApi) Handle(c *core.Ctx) error {
 	})
 }
 
+// handleCursor gets a page of articles in cursor pagination mode
+func (h *ListArticlesApi) handleCursor(c *core.Ctx, filter dto.ArticleFilter) error {
+	articles, cursors, total, err := services.FindArticlesByCursor(filter)
+	if err != nil {
+		if err.Error() == "Invalid cursor" || strings.HasPrefix(err.Error(), "Cursor pagination") {
+			return c.Error(response.Error{
+				Code:    core.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+
+		log.Errorf("Error while fetching articles: %v", err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while fetching articles",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(response.PaginatedResponse{
+		Data: transformers.ToFieldsResponse(transformers.ToArticleListResponse(articles), strings.Split(filter.Fields, ",")),
+		Pagination: response.Pagination{
+			PerPage:    filter.PerPage,
+			Total:      total,
+			HasMore:    cursors.Next != "",
+			NextCursor: cursors.Next,
+			PrevCursor: cursors.Prev,
+		},
+	})
+}
+
 // Helper function to create pagination metadata
 func createPagination(page, perPage, total int) response.Pagination {
 	totalPages := (total + perPage - 1) / perPage // Ceiling division
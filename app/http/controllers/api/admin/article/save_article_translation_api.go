@@ -0,0 +1,97 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type SaveArticleTranslationApi struct {
+	core.Api
+}
+
+func NewSaveArticleTranslationApi() *SaveArticleTranslationApi {
+	return &SaveArticleTranslationApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *SaveArticleTranslationApi) Validate(c *core.Ctx) error {
+	articleID, errData := http.PathID(c)
+	if errData != nil {
+		return c.Error(errData)
+	}
+
+	var requestBody request.SaveArticleTranslation
+	if errData := http.Parse(c, &requestBody); errData != nil {
+		return c.Error(errData)
+	}
+
+	// Article and locale come from the path
+	requestDto := requestBody.ToDto()
+	requestDto.ArticleID = articleID
+	requestDto.Locale = c.PathVal("locale")
+
+	if errData := http.Validate(requestDto); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, requestDto)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function creates or replaces the translation of an article in a locale
+// @Description Function creates or replaces the translation of an article in a locale of SUPPORTED_LOCALES (except the default locale).
+// @Summary Save article translation
+// @Tags Articles
+// @Accept json
+// @Produce json
+// @Param id path int true "Article ID"
+// @Param locale path string true "Locale (e.g. en)"
+// @Param data body request.SaveArticleTranslation true "SaveArticleTranslation payload"
+// @Success 200 {object} response.ArticleTranslation
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/articles/{id}/translations/{locale} [put]
+func (h *SaveArticleTranslationApi) Handle(c *core.Ctx) error {
+	translationDto := c.GetData(constants.Data).(dto.SaveArticleTranslation)
+
+	translation, err := services.SaveArticleTranslation(translationDto)
+	if err != nil {
+		log.Error(err)
+
+		if err.Error() == "Article not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	return c.Success(transformers.ToArticleTranslationResponse(*translation))
+}
@@ -0,0 +1,106 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type SaveArticleVariantsApi struct {
+	core.Api
+}
+
+func NewSaveArticleVariantsApi() *SaveArticleVariantsApi {
+	return &SaveArticleVariantsApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *SaveArticleVariantsApi) Validate(c *core.Ctx) error {
+	articleID, errData := http.PathID(c)
+	if errData != nil {
+		return c.Error(errData)
+	}
+
+	var requestBody request.SaveArticleVariants
+	if errData := http.Parse(c, &requestBody); errData != nil {
+		return c.Error(errData)
+	}
+
+	// Article comes from the path
+	requestDto := requestBody.ToDto()
+	requestDto.ArticleID = articleID
+
+	if errData := http.Validate(requestDto); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, requestDto)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function starts the A/B test of the title and cover image of an article
+// @Description Function starts the A/B test of an article with 1 to 3 alternative titles and/or cover images, replacing the running test.
+// @Description Variant `a` is the control (the current title and cover image), the alternatives get the keys `b` to `d`.
+// @Description Readers are bucketed by user ID or by the `ab_visitor` cookie.
+// @Summary Start article A/B test
+// @Tags Articles
+// @Accept json
+// @Produce json
+// @Param id path int true "Article ID"
+// @Param data body request.SaveArticleVariants true "SaveArticleVariants payload"
+// @Success 200 {object} response.ArticleExperiment
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/articles/{id}/variants [put]
+func (h *SaveArticleVariantsApi) Handle(c *core.Ctx) error {
+	variantsDto := c.GetData(constants.Data).(dto.SaveArticleVariants)
+
+	if _, err := services.SaveArticleVariants(variantsDto); err != nil {
+		log.Error(err)
+
+		if err.Error() == "Article not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	experiment, err := services.ArticleExperimentStats(variantsDto.ArticleID)
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while loading the article variants",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(transformers.ToArticleExperimentResponse(*experiment))
+}
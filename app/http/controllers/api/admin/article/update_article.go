@@ -74,6 +74,7 @@ func (h *UpdateArticleApi) Handle(c *core.Ctx) error {
 
 	// Transform to response data
 	articleResponse := transformers.ToArticleResponse(*article)
+	articleResponse.CategoryIDs, _ = services.FindArticleCategoryIDs(article.ID)
 
 	return c.Success(articleResponse)
 }
@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ListAuditLogsApi struct {
+	core.Api
+}
+
+func NewListAuditLogsApi() *ListAuditLogsApi {
+	return &ListAuditLogsApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *ListAuditLogsApi) Validate(c *core.Ctx) error {
+	actorID, _ := c.QueryInt("actor_id")
+	targetID, _ := c.QueryInt("target_id")
+
+	filter := dto.AuditLogFilter{
+		Filter:     http.FilterData(c),
+		ActorID:    actorID,
+		Action:     c.QueryStr("action"),
+		TargetType: c.QueryStr("target_type"),
+		TargetID:   targetID,
+		From:       c.QueryStr("from"),
+		To:         c.QueryStr("to"),
+	}
+
+	if errData := http.Validate(filter); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Filter, filter)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function lists the audit log of the privileged actions
+// @Description Function lists the mutations made by administrators, latest first, with the fields they changed.
+// @Summary List audit logs
+// @Tags Audit
+// @Accept json
+// @Produce json
+// @Param actor_id query int false "Filter by administrator ID"
+// @Param action query string false "Filter by action (e.g. user.status, article.update)"
+// @Param target_type query string false "Filter by target type (e.g. user, article, category, webhook)"
+// @Param target_id query int false "Filter by target ID"
+// @Param from query string false "Recorded on or after this date (YYYY-MM-DD)"
+// @Param to query string false "Recorded on or before this date (YYYY-MM-DD)"
+// @Param page query int false "Page"
+// @Param per_page query int false "Items Per Page"
+// @Success 200 {object} response.ListAuditLog
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /admin/audit-logs [get]
+func (h *ListAuditLogsApi) Handle(c *core.Ctx) error {
+	filterDto := c.GetData(constants.Filter).(dto.AuditLogFilter)
+
+	auditLogs, total, err := services.FindAuditLogs(filterDto)
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while fetching audit logs",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(response.ListAuditLog{
+		Meta: dto.Meta{
+			Page:    filterDto.Page,
+			PerPage: filterDto.PerPage,
+			Total:   total,
+		},
+		Data: transformers.ToListResponse(auditLogs, transformers.ToAuditLogResponse),
+	})
+}
@@ -0,0 +1,65 @@
+package category
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type CreateCategoryApi struct {
+	core.Api
+}
+
+func NewCreateCategoryApi() *CreateCategoryApi {
+	return &CreateCategoryApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *CreateCategoryApi) Validate(c *core.Ctx) error {
+	return http.ProcessRequest[request.CreateCategory, dto.CreateCategory](c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function creates a story category
+// @Description Function creates a story category. Articles get their categories with `category_ids`.
+// @Summary Create a category
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param data body request.CreateCategory true "CreateCategory payload"
+// @Success 201 {object} response.Category
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /admin/categories [post]
+func (h *CreateCategoryApi) Handle(c *core.Ctx) error {
+	createCategoryDto := c.GetData(constants.Data).(dto.CreateCategory)
+
+	category, err := services.CreateCategory(createCategoryDto)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	return c.
+		Status(core.StatusCreated).
+		JSON(transformers.ToCategoryResponse(*category))
+}
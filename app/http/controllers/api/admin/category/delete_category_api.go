@@ -0,0 +1,69 @@
+package category
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type DeleteCategoryApi struct {
+	core.Api
+}
+
+func NewDeleteCategoryApi() *DeleteCategoryApi {
+	return &DeleteCategoryApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *DeleteCategoryApi) Validate(c *core.Ctx) error {
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function deletes a story category
+// @Description Function deletes a story category. Its articles are kept, its follows are removed.
+// @Summary Delete a category
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Success 204
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/categories/{id} [delete]
+func (h *DeleteCategoryApi) Handle(c *core.Ctx) error {
+	categoryID := c.GetData(constants.Data).(int)
+
+	if err := services.DeleteCategory(categoryID); err != nil {
+		log.Error(err)
+
+		if err.Error() == "Category not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while deleting the category",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.NoContent()
+}
@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type CreateWebhookApi struct {
+	core.Api
+}
+
+func NewCreateWebhookApi() *CreateWebhookApi {
+	return &CreateWebhookApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *CreateWebhookApi) Validate(c *core.Ctx) error {
+	return http.ProcessRequest[request.CreateWebhook, dto.CreateWebhook](c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function subscribes a URL to content lifecycle events
+// @Description Function subscribes a URL to content lifecycle events. Events are posted as JSON
+// @Description with an `X-Webhook-Signature: t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>">` header
+// @Description computed with the secret of the webhook (generated when missing). The secret is only returned here,
+// @Description set a new one with an update if it's lost.
+// @Summary Create a webhook
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param data body request.CreateWebhook true "CreateWebhook payload"
+// @Success 201 {object} response.Webhook
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /admin/webhooks [post]
+func (h *CreateWebhookApi) Handle(c *core.Ctx) error {
+	createWebhookDto := c.GetData(constants.Data).(dto.CreateWebhook)
+
+	webhook, err := services.CreateWebhook(createWebhookDto)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	return c.
+		Status(core.StatusCreated).
+		JSON(transformers.ToCreatedWebhookResponse(*webhook))
+}
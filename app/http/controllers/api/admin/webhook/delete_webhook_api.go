@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type DeleteWebhookApi struct {
+	core.Api
+}
+
+func NewDeleteWebhookApi() *DeleteWebhookApi {
+	return &DeleteWebhookApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *DeleteWebhookApi) Validate(c *core.Ctx) error {
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function deletes a webhook subscription
+// @Description Function deletes a webhook subscription and its delivery log.
+// @Summary Delete a webhook
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 204
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/webhooks/{id} [delete]
+func (h *DeleteWebhookApi) Handle(c *core.Ctx) error {
+	webhookID := c.GetData(constants.Data).(int)
+
+	if err := services.DeleteWebhook(webhookID); err != nil {
+		log.Error(err)
+
+		if err.Error() == "Webhook not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while deleting the webhook",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.NoContent()
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type GetWebhookApi struct {
+	core.Api
+}
+
+func NewGetWebhookApi() *GetWebhookApi {
+	return &GetWebhookApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *GetWebhookApi) Validate(c *core.Ctx) error {
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function gets a webhook subscription
+// @Description Function gets a webhook subscription. Its signing secret is only returned on creation.
+// @Summary Get a webhook
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} response.Webhook
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/webhooks/{id} [get]
+func (h *GetWebhookApi) Handle(c *core.Ctx) error {
+	webhookID := c.GetData(constants.Data).(int)
+
+	webhook, err := services.GetWebhookByID(webhookID)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusNotFound,
+			Message: err.Error(),
+		}, core.StatusNotFound)
+	}
+
+	return c.Success(transformers.ToWebhookResponse(*webhook))
+}
@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ListWebhookDeliveriesApi struct {
+	core.Api
+}
+
+func NewListWebhookDeliveriesApi() *ListWebhookDeliveriesApi {
+	return &ListWebhookDeliveriesApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *ListWebhookDeliveriesApi) Validate(c *core.Ctx) error {
+	webhookID, errData := http.PathID(c)
+	if errData != nil {
+		return c.Error(errData)
+	}
+
+	filter := dto.WebhookDeliveryFilter{
+		Filter:    http.FilterData(c),
+		WebhookID: webhookID,
+		Status:    types.WebhookDeliveryStatus(c.QueryStr("status")),
+		Event:     types.WebhookEvent(c.QueryStr("event")),
+	}
+
+	if errData = http.Validate(filter); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Filter, filter)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function lists the delivery log of a webhook
+// @Description Function lists the deliveries of a webhook, latest first, with the response code of their last attempt.
+// @Summary List webhook deliveries
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param status query string false "Filter by status (pending, delivered, retrying, failed)"
+// @Param event query string false "Filter by event (article.published, article.updated, article.deleted, user.created)"
+// @Param page query int false "Page"
+// @Param per_page query int false "Items Per Page"
+// @Success 200 {object} response.ListWebhookDelivery
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/webhooks/{id}/deliveries [get]
+func (h *ListWebhookDeliveriesApi) Handle(c *core.Ctx) error {
+	filterDto := c.GetData(constants.Filter).(dto.WebhookDeliveryFilter)
+
+	if _, err := services.GetWebhookByID(filterDto.WebhookID); err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusNotFound,
+			Message: err.Error(),
+		}, core.StatusNotFound)
+	}
+
+	deliveries, total, err := services.FindWebhookDeliveries(filterDto)
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while fetching webhook deliveries",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(response.ListWebhookDelivery{
+		Meta: dto.Meta{
+			Page:    filterDto.Page,
+			PerPage: filterDto.PerPage,
+			Total:   total,
+		},
+		Data: transformers.ToListResponse(deliveries, transformers.ToWebhookDeliveryResponse),
+	})
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ListWebhooksApi struct {
+	core.Api
+}
+
+func NewListWebhooksApi() *ListWebhooksApi {
+	return &ListWebhooksApi{}
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function lists the webhook subscriptions
+// @Description Function lists the webhook subscriptions, latest first.
+// @Summary List webhooks
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Success 200 {array} response.Webhook
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /admin/webhooks [get]
+func (h *ListWebhooksApi) Handle(c *core.Ctx) error {
+	webhooks, err := services.FindWebhooks()
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while fetching webhooks",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(transformers.ToListResponse(webhooks, transformers.ToWebhookResponse))
+}
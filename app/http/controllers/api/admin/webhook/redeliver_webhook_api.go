@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type RedeliverWebhookApi struct {
+	core.Api
+}
+
+func NewRedeliverWebhookApi() *RedeliverWebhookApi {
+	return &RedeliverWebhookApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *RedeliverWebhookApi) Validate(c *core.Ctx) error {
+	if _, errData := http.PathID(c, "delivery_id"); errData != nil {
+		return c.Error(errData)
+	}
+
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function sends a past delivery of a webhook again
+// @Description Function queues the payload of a past delivery again. The attempt is logged as a new delivery.
+// @Summary Redeliver a webhook
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param delivery_id path int true "Delivery ID"
+// @Success 202 {object} response.WebhookDelivery
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func (h *RedeliverWebhookApi) Handle(c *core.Ctx) error {
+	webhookID := c.GetData(constants.Data).(int)
+	deliveryID, _ := http.PathID(c, "delivery_id")
+
+	delivery, err := services.RedeliverWebhook(webhookID, deliveryID)
+	if err != nil {
+		switch err.Error() {
+		case "Webhook not found", "Delivery not found":
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while redelivering the webhook",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.
+		Status(core.StatusAccepted).
+		JSON(transformers.ToWebhookDeliveryResponse(*delivery))
+}
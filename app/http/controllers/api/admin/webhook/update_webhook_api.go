@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type UpdateWebhookApi struct {
+	core.Api
+}
+
+func NewUpdateWebhookApi() *UpdateWebhookApi {
+	return &UpdateWebhookApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *UpdateWebhookApi) Validate(c *core.Ctx) error {
+	webhookID, errData := http.PathID(c)
+	if errData != nil {
+		return c.Error(errData)
+	}
+
+	var requestBody request.UpdateWebhook
+	if errData = http.Parse(c, &requestBody); errData != nil {
+		return c.Error(errData)
+	}
+
+	updateWebhookDto := requestBody.ToDto()
+	updateWebhookDto.ID = webhookID
+
+	if errData = http.Validate(updateWebhookDto); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, updateWebhookDto)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function updates a webhook subscription
+// @Description Function updates the URL, secret, events, description or state of a webhook. Missing fields are kept.
+// @Summary Update a webhook
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param data body request.UpdateWebhook true "UpdateWebhook payload"
+// @Success 200 {object} response.Webhook
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/webhooks/{id} [put]
+func (h *UpdateWebhookApi) Handle(c *core.Ctx) error {
+	updateWebhookDto := c.GetData(constants.Data).(dto.UpdateWebhook)
+
+	webhook, err := services.UpdateWebhook(updateWebhookDto)
+	if err != nil {
+		if err.Error() == "Webhook not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	return c.Success(transformers.ToWebhookResponse(*webhook))
+}
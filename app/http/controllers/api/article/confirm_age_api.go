@@ -0,0 +1,86 @@
+package article
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+	"time"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ConfirmAgeApi struct {
+	core.Api
+}
+
+func NewConfirmAgeApi() *ConfirmAgeApi {
+	return &ConfirmAgeApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *ConfirmAgeApi) Validate(c *core.Ctx) error {
+	return http.ProcessRequest[request.ConfirmAge, dto.ConfirmAge](c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function confirms the age of a reader before opening age-gated stories.
+// @Description Function signs an age confirmation token to send in the `X-Age-Token` header of the article detail API.
+// @Description The birthdate of a signed-in user who has none yet is saved to the profile.
+// @Summary Confirm the age of a reader
+// @Tags Articles
+// @Accept json
+// @Produce json
+// @Param data body request.ConfirmAge true "ConfirmAge payload"
+// @Success 200 {object} response.AgeToken
+// @Failure 400 {object} response.Error
+// @Failure 503 {object} response.Error
+// @Router /articles/age-confirmation [post]
+func (h *ConfirmAgeApi) Handle(c *core.Ctx) error {
+	confirmAgeDto := c.GetData(constants.Data).(dto.ConfirmAge)
+
+	// Validated by `datetime=2006-01-02`
+	birthdate, _ := time.Parse(time.DateOnly, confirmAgeDto.Birthdate)
+
+	ageToken, err := services.IssueAgeToken(birthdate)
+	if err != nil {
+		// No signing key, a server misconfiguration
+		if err.Error() == "Age confirmation is not configured" {
+			return c.Error(response.Error{
+				Code:    core.StatusServiceUnavailable,
+				Message: err.Error(),
+			}, core.StatusServiceUnavailable)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	if user, ok := c.GetData(constants.User).(models.User); ok {
+		if err = services.ConfirmUserBirthdate(user, birthdate); err != nil {
+			log.Warnf("Failed to confirm birthdate of user %d: %v", user.ID, err)
+		}
+	}
+
+	// The token is personal
+	c.SetHeader(core.HeaderCacheControl, "private, no-store")
+
+	return c.JSON(transformers.ToAgeTokenResponse(*ageToken))
+}
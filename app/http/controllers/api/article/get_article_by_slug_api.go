@@ -1,7 +1,11 @@
 package article
 
 import (
+	"encoding/json"
+	"fmt"
 	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
 	"gfly/app/http"
 	"gfly/app/http/response"
 	"gfly/app/http/transformers"
@@ -37,32 +41,154 @@ func (h *GetArticleBySlugApi) Validate(c *core.Ctx) error {
 // ====================================================================
 
 // Handle function gets article by slug. If article doesn't exist, returns not found status.
-// @Description Function gets article by slug. If article doesn't exist, returns not found status.
+// @Description Function gets a published article by slug. Drafts return not found, archived and deleted articles return gone.
+// @Description The content and videos of an age-rated article are withheld (`age_gated: true`) unless the reader
+// @Description is signed in with a confirmed birthdate or sends an age confirmation token old enough for the rating.
+// @Description Members-only and premium articles return a teaser (`locked: true`) unless the reader is signed in with the plan.
+// @Description Articles in an A/B test return the title and cover image of the reader's variant (`variant`).
 // @Summary Get article by slug
 // @Tags Articles
 // @Accept json
 // @Produce json
-// @Param slug path string true "Article Slug"
+// @Param slug path string true "Article slug (base or translated slug)"
+// @Param lang query string false "Locale (default: Accept-Language, then the default locale)"
+// @Param Accept-Language header string false "Preferred locales"
+// @Param X-Age-Token header string false "Age confirmation token (POST /articles/age-confirmation)"
+// @Param age_token query string false "Age confirmation token, for links where the header can't be set"
+// @Param variant query string false "A/B test variant of the list item the reader clicked (counts the click)"
 // @Success 200 {object} response.Article
+// @Success 304
 // @Failure 401 {object} response.Unauthorized
 // @Failure 404 {object} response.Error
+// @Failure 410 {object} response.Error
 // @Security ApiKeyAuth
 // @Router /articles/slug/{slug} [get]
 func (h *GetArticleBySlugApi) Handle(c *core.Ctx) error {
 	slug := c.GetData(constants.Data).(string)
+	locale := http.NegotiateLocale(c)
+	ageRating := http.ViewerAgeRating(c)
+	accessRank := http.ViewerAccessRank(c)
+	visitorID := http.ExperimentVisitor(c)
+	bucket := http.ExperimentBucket(visitorID)
+
+	// Serve the cached article (the view and the A/B test click are still counted)
+	cacheKey := http.ResponseCacheKey(c, locale,
+		fmt.Sprintf("age:%d", ageRating), fmt.Sprintf("access:%d", accessRank), fmt.Sprintf("ab:%d", bucket))
+	if cached, ok := services.GetCachedResponse(cacheKey); ok {
+		if articleID := services.TaggedArticleID(cached.Tags); articleID > 0 {
+			var cachedArticle response.Article
+			if err := json.Unmarshal([]byte(cached.Body), &cachedArticle); err == nil {
+				if cachedArticle.Locale != "" {
+					c.SetHeader(core.HeaderContentLanguage, cachedArticle.Locale)
+				}
+
+				h.setPrivateHeaders(c, cachedArticle.AgeRating > 0 || cachedArticle.AccessLevel != string(types.AccessLevelPublic) || cachedArticle.Variant != "")
+
+				if !cachedArticle.AgeGated {
+					http.TrackArticleView(c, articleID)
+				}
+
+				if variantIDs := services.TaggedVariantIDs(cached.Tags); len(variantIDs) > 0 {
+					http.KeepExperimentVisitor(c)
+					h.recordClick(c, visitorID, cachedArticle.Variant, variantIDs[0])
+				}
+			}
+
+			return http.ConditionalJSON(c, []byte(cached.Body), cached.LastModified)
+		}
+	}
+
+	// Only published articles are public, drafts are missing
+	article, err := services.GetPublishedArticleBySlug(slug)
+	if err != nil && article == nil {
+		// Per-locale slug: serve the translation unless another locale is requested
+		if baseSlug, slugLocale, ok := services.TranslatedArticleSlug(slug); ok {
+			article, err = services.GetPublishedArticleBySlug(baseSlug)
+			if c.QueryStr("lang") == "" {
+				locale = slugLocale
+			}
+		}
+	}
 
-	article, err := services.GetArticleBySlug(slug)
 	if err != nil {
 		log.Error(err)
 
+		// Archived and deleted articles can be restored, they are gone rather than missing
+		if article != nil {
+			return c.Error(response.Error{
+				Code:    core.StatusGone,
+				Message: err.Error(),
+			}, core.StatusGone)
+		}
+
 		return c.Error(response.Error{
 			Code:    core.StatusNotFound,
 			Message: "Article not found",
 		}, core.StatusNotFound)
 	}
 
+	translations, err := services.FindArticleTranslations(article.ID)
+	if err != nil {
+		log.Warnf("Failed to load translations of article %d: %v", article.ID, err)
+	}
+
+	localized, contentLocale := services.LocalizeArticle(*article, translations, locale)
+	tags := []string{services.ArticleTag(article.ID)}
+
+	// Title and cover image of the A/B test variant of the visitor (translations are served as is)
+	variant, hasVariant := models.ArticleVariant{}, false
+	if contentLocale == services.DefaultLocale() {
+		variants, err := services.FindArticleVariants(article.ID)
+		if err != nil {
+			log.Warnf("Failed to load variants of article %d: %v", article.ID, err)
+		}
+
+		if variant, hasVariant = services.AssignVariant(article.ID, variants, bucket); hasVariant {
+			localized = services.VariantArticle(localized, variant)
+			tags = append(tags, services.VariantTag(variant.ID))
+		}
+	}
+
 	// Transform to response data
-	articleResponse := transformers.ToArticleForGuestResponse(*article)
+	articleResponse := transformers.ToArticleForGuestResponse(localized)
+	articleResponse.Locale = contentLocale
+	articleResponse.Variant = variant.Key
+	articleResponse.Alternates = transformers.ToAlternatesResponse(*article, services.DefaultLocale(), translations)
+	c.SetHeader(core.HeaderContentLanguage, contentLocale)
+	h.setPrivateHeaders(c, article.AgeRating > 0 || article.AccessLevel != types.AccessLevelPublic || hasVariant)
+
+	if hasVariant {
+		http.KeepExperimentVisitor(c)
+		h.recordClick(c, visitorID, variant.Key, variant.ID)
+	}
+
+	// Only metadata until the reader confirms their age (the view isn't counted),
+	// only the teaser for readers without the plan of the article
+	if services.IsAgeGated(*article, ageRating) {
+		articleResponse = transformers.ToAgeGatedResponse(articleResponse)
+	} else {
+		if services.IsLocked(*article, accessRank) {
+			articleResponse = transformers.ToLockedResponse(articleResponse, services.ArticleTeaser(localized))
+		}
+
+		http.TrackArticleView(c, article.ID)
+	}
 
-	return c.Success(articleResponse)
+	return http.CachedSuccess(c, cacheKey, articleResponse, services.ArticlesLastModified(localized), tags...)
+}
+
+// setPrivateHeaders keeps the response of an age-rated, members-only or A/B tested article out of shared caches,
+// it depends on the reader
+func (h *GetArticleBySlugApi) setPrivateHeaders(c *core.Ctx, private bool) {
+	if private {
+		c.SetHeader(core.HeaderCacheControl, "private, no-cache")
+		c.SetHeader(core.HeaderVary, core.HeaderAcceptLanguage+", "+core.HeaderAuthorization+", "+http.AgeTokenHeader+", "+core.HeaderCookie)
+	}
+}
+
+// recordClick counts the click of the A/B test variant the reader opened from a list (`?variant=` of the list item)
+func (h *GetArticleBySlugApi) recordClick(c *core.Ctx, visitorID, variantKey string, variantID int) {
+	if variantKey != "" && c.QueryStr("variant") == variantKey {
+		services.RecordVariantClick(visitorID, variantID)
+	}
 }
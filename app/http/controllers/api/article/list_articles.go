@@ -1,12 +1,15 @@
 package article
 
 import (
+	"fmt"
+	"gfly/app/domain/models"
 	"gfly/app/domain/models/types"
 	"gfly/app/dto"
 	"gfly/app/http"
 	"gfly/app/http/response"
 	"gfly/app/http/transformers"
 	"gfly/app/services"
+	"strings"
 
 	"github.com/gflydev/core"
 	"github.com/gflydev/core/log"
@@ -30,35 +33,26 @@ func NewListArticlesApi() *ListArticlesApi {
 
 // Validate validates the query parameters for article listing
 func (h *ListArticlesApi) Validate(c *core.Ctx) error {
-	// Create filter from query parameters and validate it
-	filter := dto.ArticleFilter{}
-
-	// Get base filter parameters (page, per_page, keyword, order_by)
-	filter.Page, _ = c.QueryInt("page")
-	filter.PerPage, _ = c.QueryInt("per_page")
-	filter.Keyword = c.QueryStr("keyword")
-	filter.OrderBy = c.QueryStr("order_by")
-
-	// Get article-specific filter parameters
-	status := c.QueryStr("status")
-	if status != "" {
-		filter.Status = types.ArticleStatus(status)
-	}
-
-	// Set default values if not provided
-	if filter.Page < 1 {
-		filter.Page = 1
-	}
-
-	if filter.PerPage < 1 {
-		filter.PerPage = 10
-	}
+	// Create filter from query parameters (shared by the public and admin list APIs),
+	// only published articles are public
+	filter := http.ArticleFilterData(c)
+	filter.Status = types.ArticleStatusPublished
 
 	// Validate filter
 	if errData := http.Validate(filter); errData != nil {
 		return c.Error(errData)
 	}
 
+	// Resolve sparse fieldset (card fields by default)
+	fields, err := services.ArticleListFields(filter.Fields)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	filter.Fields = strings.Join(fields, ",")
+
 	// Store filter in context
 	c.SetData("filter", filter)
 
@@ -70,7 +64,9 @@ func (h *ListArticlesApi) Validate(c *core.Ctx) error {
 // ====================================================================
 
 // Handle function gets a list of articles based on filter criteria
-// @Description Returns a paginated list of articles that can be filtered and sorted
+// @Description Returns a paginated list of published articles that can be filtered and sorted
+// @Description Articles in an A/B test return the title and cover image of the reader's variant (`variant`),
+// @Description send it as `?variant=` when opening the article to count the click.
 // @Summary List articles with pagination and filtering
 // @Tags Articles
 // @Accept json
@@ -78,9 +74,24 @@ func (h *ListArticlesApi) Validate(c *core.Ctx) error {
 // @Param page query int false "Page number (default: 1)"
 // @Param per_page query int false "Items per page (default: 10)"
 // @Param keyword query string false "Search keyword in title, slug, excerpt, and content"
-// @Param order_by query string false "Field to order by (prefix with '-' for descending, e.g. '-created_at')"
-// @Param status query string false "Filter by article status (draft, published, archived)"
+// @Param order_by query string false "Field to order by (prefix with '-' for descending, e.g. '-created_at', or 'trending')"
+// @Param author_id query int false "Filter by author ID"
+// @Param category_id query int false "Filter by category ID"
+// @Param published_from query string false "Published on or after this date (YYYY-MM-DD)"
+// @Param published_to query string false "Published on or before this date (YYYY-MM-DD)"
+// @Param created_from query string false "Created on or after this date (YYYY-MM-DD)"
+// @Param created_to query string false "Created on or before this date (YYYY-MM-DD)"
+// @Param has_video query bool false "With (true) or without (false) a YouTube or TikTok video"
+// @Param has_cover query bool false "With (true) or without (false) a cover image"
+// @Param min_views query int false "Minimum view count"
+// @Param exclude_warnings query string false "Comma separated content warnings to exclude (violence, gore, suicide, self_harm, sexual_content, abuse, drugs)"
+// @Param access_level query string false "Filter by access level (public, members, premium)"
+// @Param pagination query string false "Pagination mode: page (default) or cursor"
+// @Param cursor query string false "Cursor returned as next_cursor or prev_cursor (cursor mode)"
+// @Param lang query string false "Locale of translated articles (default: Accept-Language, then the default locale)"
+// @Param fields query string false "Comma separated fields to return (default: card fields without content and SEO)"
 // @Success 200 {object} response.PaginatedResponse
+// @Success 304
 // @Failure 400 {object} response.Error
 // @Failure 401 {object} response.Unauthorized
 // @Security ApiKeyAuth
@@ -89,6 +100,23 @@ func (h *ListArticlesApi) Handle(c *core.Ctx) error {
 	// Get filter from context
 	filter := c.GetData("filter").(dto.ArticleFilter)
 
+	locale := http.NegotiateLocale(c)
+	visitorID := http.ExperimentVisitor(c)
+	bucket := http.ExperimentBucket(visitorID)
+
+	// Serve the cached response of the same query (A/B test impressions are still counted)
+	cacheKey := http.ResponseCacheKey(c, locale, fmt.Sprintf("ab:%d", bucket))
+	if cached, ok := services.GetCachedResponse(cacheKey); ok {
+		recordImpressions(c, visitorID, services.TaggedVariantIDs(cached.Tags))
+
+		return http.ConditionalJSON(c, []byte(cached.Body), cached.LastModified)
+	}
+
+	// Cursor mode for infinite scrolling
+	if filter.IsCursor() {
+		return h.handleCursor(c, filter, cacheKey, locale, visitorID, bucket)
+	}
+
 	// Get articles from service
 	articles, total, err := services.FindArticles(filter)
 	if err != nil {
@@ -101,13 +129,114 @@ func (h *ListArticlesApi) Handle(c *core.Ctx) error {
 	}
 
 	// Transform articles to response format
-	articlesResponse := transformers.ToArticleListForGuestResponse(articles)
+	articles, locales := services.LocalizeArticles(articles, locale)
+	articlesResponse, variantIDs := guestListResponse(articles, locales, filter.Fields, bucket)
+	recordImpressions(c, visitorID, variantIDs)
 
-	// Create paginated response
-	return c.Success(response.PaginatedResponse{
+	// Create paginated response (304 when the client copy is still fresh)
+	return http.CachedSuccess(c, cacheKey, response.PaginatedResponse{
 		Data:       articlesResponse,
 		Pagination: createPagination(filter.Page, filter.PerPage, total),
-	})
+	}, services.ArticlesLastModified(articles...), listTags(filter, variantIDs)...)
+}
+
+// handleCursor gets a page of articles in cursor pagination mode
+func (h *ListArticlesApi) handleCursor(c *core.Ctx, filter dto.ArticleFilter, cacheKey, locale, visitorID string, bucket int) error {
+	articles, cursors, total, err := services.FindArticlesByCursor(filter)
+	if err != nil {
+		if err.Error() == "Invalid cursor" || strings.HasPrefix(err.Error(), "Cursor pagination") {
+			return c.Error(response.Error{
+				Code:    core.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+
+		log.Errorf("Error while fetching articles: %v", err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while fetching articles",
+		}, core.StatusInternalServerError)
+	}
+
+	articles, locales := services.LocalizeArticles(articles, locale)
+	articlesResponse, variantIDs := guestListResponse(articles, locales, filter.Fields, bucket)
+	recordImpressions(c, visitorID, variantIDs)
+
+	return http.CachedSuccess(c, cacheKey, response.PaginatedResponse{
+		Data: articlesResponse,
+		Pagination: response.Pagination{
+			PerPage:    filter.PerPage,
+			Total:      total,
+			HasMore:    cursors.Next != "",
+			NextCursor: cursors.Next,
+			PrevCursor: cursors.Prev,
+		},
+	}, services.ArticlesLastModified(articles...), listTags(filter, variantIDs)...)
+}
+
+// guestListResponse transforms localized articles to list items limited to the requested fields.
+// Lists are shared by all readers, so the full content of age-rated and members-only articles
+// is only served by the detail API.
+//
+// Articles in an A/B test get the title and cover image of the variant of the visitor bucket
+// (translations are served as is). Returns the items and the served variant IDs.
+func guestListResponse(articles []models.Article, locales []string, fields string, bucket int) ([]core.Data, []int) {
+	var variantIDs []int
+	variantKeys := make([]string, len(articles))
+	variantsByArticle := services.FindVariantsOfArticles(articles)
+
+	for i := range articles {
+		if locales[i] != services.DefaultLocale() {
+			continue
+		}
+
+		if variant, ok := services.AssignVariant(articles[i].ID, variantsByArticle[articles[i].ID], bucket); ok {
+			articles[i] = services.VariantArticle(articles[i], variant)
+			variantKeys[i] = variant.Key
+			variantIDs = append(variantIDs, variant.ID)
+		}
+	}
+
+	articlesResponse := transformers.ToArticleListForGuestResponse(articles)
+	for i := range articlesResponse {
+		articlesResponse[i].Locale = locales[i]
+		articlesResponse[i].Variant = variantKeys[i]
+
+		if services.IsAgeGated(articles[i], 0) {
+			articlesResponse[i] = transformers.ToAgeGatedResponse(articlesResponse[i])
+		} else if services.IsLocked(articles[i], 0) {
+			articlesResponse[i] = transformers.ToLockedResponse(articlesResponse[i], services.ArticleTeaser(articles[i]))
+		}
+	}
+
+	return transformers.ToFieldsResponse(articlesResponse, strings.Split(fields, ",")), variantIDs
+}
+
+// recordImpressions counts the A/B test variants listed to the visitor. Lists with variants depend
+// on the reader, they are kept out of shared caches.
+func recordImpressions(c *core.Ctx, visitorID string, variantIDs []int) {
+	if len(variantIDs) == 0 {
+		return
+	}
+
+	c.SetHeader(core.HeaderCacheControl, "private, no-cache")
+	c.SetHeader(core.HeaderVary, core.HeaderAcceptLanguage+", "+core.HeaderAuthorization+", "+core.HeaderCookie)
+	http.KeepExperimentVisitor(c)
+
+	services.RecordVariantImpressions(visitorID, variantIDs...)
+}
+
+// listTags returns the response cache tags of an article list, its category filter and its served A/B test variants
+func listTags(filter dto.ArticleFilter, variantIDs []int) []string {
+	categorySlug := ""
+	if filter.CategoryID > 0 {
+		if category, err := services.GetCategoryByID(filter.CategoryID); err == nil {
+			categorySlug = category.Slug
+		}
+	}
+
+	return services.ArticleListTags(filter, categorySlug, variantIDs)
 }
 
 // Helper function to create pagination metadata
@@ -0,0 +1,117 @@
+package article
+
+import (
+	"fmt"
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/services"
+	"strings"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ListTrendingArticlesApi struct {
+	core.Api
+}
+
+func NewListTrendingArticlesApi() *ListTrendingArticlesApi {
+	return &ListTrendingArticlesApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+// Validate validates the limit query parameter (1..50, 10 by default)
+func (h *ListTrendingArticlesApi) Validate(c *core.Ctx) error {
+	limit, err := c.QueryInt("limit")
+	if err != nil || limit < 1 {
+		limit = 10
+	}
+
+	if limit > 50 {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: "limit must be less than or equal 50",
+		})
+	}
+
+	// Resolve sparse fieldset (card fields by default)
+	fields, err := services.ArticleListFields(c.QueryStr("fields"))
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	c.SetData(constants.Data, limit)
+	c.SetData("fields", fields)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function lists the trending articles ranked by recent views with time decay.
+// @Description Function lists the trending articles ranked by recent views with time decay.
+// @Summary List trending articles
+// @Tags Articles
+// @Accept json
+// @Produce json
+// @Param limit query int false "Number of articles (1..50, default 10)"
+// @Param lang query string false "Locale of translated articles (default: Accept-Language, then the default locale)"
+// @Param fields query string false "Comma separated fields to return (default: card fields without content and SEO)"
+// @Success 200 {array} response.Article
+// @Success 304
+// @Failure 400 {object} response.Error
+// @Router /articles/trending [get]
+func (h *ListTrendingArticlesApi) Handle(c *core.Ctx) error {
+	limit := c.GetData(constants.Data).(int)
+	fields := c.GetData("fields").([]string)
+
+	locale := http.NegotiateLocale(c)
+	visitorID := http.ExperimentVisitor(c)
+	bucket := http.ExperimentBucket(visitorID)
+
+	// Serve the cached ranking (A/B test impressions are still counted)
+	cacheKey := http.ResponseCacheKey(c, locale, fmt.Sprintf("ab:%d", bucket))
+	if cached, ok := services.GetCachedResponse(cacheKey); ok {
+		recordImpressions(c, visitorID, services.TaggedVariantIDs(cached.Tags))
+
+		return http.ConditionalJSON(c, []byte(cached.Body), cached.LastModified)
+	}
+
+	articles, err := services.FindTrendingArticles(limit)
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Failed to load trending articles",
+		}, core.StatusInternalServerError)
+	}
+
+	articles, locales := services.LocalizeArticles(articles, locale)
+	articlesResponse, variantIDs := guestListResponse(articles, locales, strings.Join(fields, ","), bucket)
+	recordImpressions(c, visitorID, variantIDs)
+
+	tags := []string{services.TagTrendingList}
+	for _, variantID := range variantIDs {
+		tags = append(tags, services.VariantTag(variantID))
+	}
+
+	return http.CachedSuccess(c, cacheKey,
+		articlesResponse,
+		services.ArticlesLastModified(articles...),
+		tags...,
+	)
+}
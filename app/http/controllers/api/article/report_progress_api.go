@@ -0,0 +1,111 @@
+package article
+
+import (
+	"gfly/app/console/queues"
+	"gfly/app/constants"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/console"
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ReportProgressApi struct {
+	core.Api
+}
+
+func NewReportProgressApi() *ReportProgressApi {
+	return &ReportProgressApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *ReportProgressApi) Validate(c *core.Ctx) error {
+	if c.PathVal("slug") == "" {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: "slug parameter is required",
+		})
+	}
+
+	var requestBody request.ReadingProgress
+	if errData := http.Parse(c, &requestBody); errData != nil {
+		return c.Error(errData)
+	}
+
+	requestDto := requestBody.ToDto()
+	if errData := http.Validate(requestDto); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, requestDto)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function collects the reading progress of an article (read-through rate of the analytics).
+// @Description Function collects how far a reader scrolled an article. Reports are accepted once per visitor and 10% step,
+// @Description the event is saved by the queue worker. Progress of age-gated stories and teasers isn't collected.
+// @Summary Report the reading progress of an article
+// @Tags Articles
+// @Accept json
+// @Param slug path string true "Article slug (base or translated slug)"
+// @Param data body request.ReadingProgress true "ReadingProgress payload"
+// @Success 204
+// @Failure 400 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Router /articles/{slug}/progress [post]
+func (h *ReportProgressApi) Handle(c *core.Ctx) error {
+	progressDto := c.GetData(constants.Data).(dto.ReadingProgress)
+	slug := c.PathVal("slug")
+
+	article, err := services.GetPublishedArticleBySlug(slug)
+	if err != nil {
+		if baseSlug, _, ok := services.TranslatedArticleSlug(slug); ok {
+			article, err = services.GetPublishedArticleBySlug(baseSlug)
+		}
+	}
+
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusNotFound,
+			Message: "Article not found",
+		}, core.StatusNotFound)
+	}
+
+	// Only the full story counts towards the read-through rate
+	if services.IsAgeGated(*article, http.ViewerAgeRating(c)) || services.IsLocked(*article, http.ViewerAccessRank(c)) {
+		return c.NoContent()
+	}
+
+	event := http.ViewEventData(c, article.ID, types.ViewEventProgress)
+	event.Progress = progressDto.Progress
+
+	isNew, err := services.RecordReadingProgress(article.ID, event.VisitorID, event.Progress)
+	if err != nil {
+		log.Warnf("Failed to record progress for article %d: %v", article.ID, err)
+	}
+
+	if isNew {
+		console.DispatchTask(queues.NewViewEventTask(event))
+	}
+
+	c.SetHeader(core.HeaderCacheControl, "no-store")
+
+	return c.NoContent()
+}
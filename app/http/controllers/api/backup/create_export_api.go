@@ -0,0 +1,66 @@
+package backup
+
+import (
+	"gfly/app/console/queues"
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+
+	"github.com/gflydev/console"
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type CreateExportApi struct {
+	core.Api
+}
+
+func NewCreateExportApi() *CreateExportApi {
+	return &CreateExportApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *CreateExportApi) Validate(c *core.Ctx) error {
+	return http.ProcessRequest[request.CreateExport, dto.CreateExport](c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function queues a full content export
+// @Description Function queues an export of all articles and their authors (JSON Lines or zip bundle with media).
+// @Description A download link is emailed to the current user when the export is ready.
+// @Summary Export content
+// @Tags Backups
+// @Accept json
+// @Produce json
+// @Param data body request.CreateExport true "CreateExport payload"
+// @Success 202 {object} response.ExportQueued
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /admin/exports [post]
+func (h *CreateExportApi) Handle(c *core.Ctx) error {
+	createExportDto := c.GetData(constants.Data).(dto.CreateExport)
+	user := c.GetData(constants.User).(models.User)
+
+	console.DispatchTask(queues.NewExportTask(createExportDto.Format, user.Email))
+
+	return c.
+		Status(core.StatusAccepted).
+		JSON(response.ExportQueued{
+			Format: createExportDto.Format,
+			Email:  user.Email,
+			Status: "queued",
+		})
+}
@@ -0,0 +1,81 @@
+package backup
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http/response"
+	"gfly/app/services"
+	"strings"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type DownloadExportApi struct {
+	core.Api
+}
+
+// NewDownloadExportApi As a constructor to serve content exports through signed links.
+func NewDownloadExportApi() *DownloadExportApi {
+	return &DownloadExportApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+// Validate checks the signature and expiry of the download link.
+func (h *DownloadExportApi) Validate(c *core.Ctx) error {
+	name := c.PathVal("name")
+
+	if !services.VerifyBackupSignature(name, c.QueryStr("expires"), c.QueryStr("signature")) {
+		return c.Error(response.Error{
+			Code:    core.StatusForbidden,
+			Message: "Invalid or expired download link",
+		}, core.StatusForbidden)
+	}
+
+	c.SetData(constants.Data, name)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function downloads a content export from the link emailed by `content:export`.
+// @Description Function downloads a content export from the signed link emailed when the export is ready.
+// @Summary Download content export
+// @Tags Backups
+// @Produce octet-stream
+// @Param name path string true "Export file name"
+// @Param expires query int true "Link expiry (unix seconds)"
+// @Param signature query string true "Link signature"
+// @Success 200
+// @Failure 403 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Router /exports/{name} [get]
+func (h *DownloadExportApi) Handle(c *core.Ctx) error {
+	name := c.GetData(constants.Data).(string)
+
+	content, err := services.GetBackupFile(name)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusNotFound,
+			Message: "Export not found",
+		}, core.StatusNotFound)
+	}
+
+	contentType := core.MIMEOctetStream
+	if strings.HasSuffix(name, ".zip") {
+		contentType = "application/zip"
+	}
+
+	return c.
+		SetHeader(core.HeaderContentType, contentType).
+		SetHeader(core.HeaderContentDisposition, `attachment; filename="`+name+`"`).
+		Raw(content)
+}
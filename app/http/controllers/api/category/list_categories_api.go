@@ -0,0 +1,49 @@
+package category
+
+import (
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ListCategoriesApi struct {
+	core.Api
+}
+
+func NewListCategoriesApi() *ListCategoriesApi {
+	return &ListCategoriesApi{}
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function lists the story categories.
+// @Description Function lists the story categories ordered by name. Members can follow them (`/me/follows`).
+// @Summary List categories
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Success 200 {array} response.Category
+// @Failure 500 {object} response.Error
+// @Router /categories [get]
+func (h *ListCategoriesApi) Handle(c *core.Ctx) error {
+	categories, err := services.FindCategories()
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while fetching categories",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(transformers.ToListResponse(categories, transformers.ToCategoryResponse))
+}
@@ -0,0 +1,209 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+	"strings"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ArticleFeedApi struct {
+	core.Api
+	format dto.FeedFormat
+}
+
+// NewArticleFeedApi As a constructor to create a feed of published articles in the given format.
+func NewArticleFeedApi(format dto.FeedFormat) *ArticleFeedApi {
+	return &ArticleFeedApi{
+		format: format,
+	}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+// Validate validates the optional author ID or category slug parameter
+func (h *ArticleFeedApi) Validate(c *core.Ctx) error {
+	// Category feed
+	if slug := c.PathVal("slug"); slug != "" {
+		category, err := services.GetCategoryBySlug(slug)
+		if err != nil {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		c.SetData(constants.Data, dto.FeedScope{Category: category})
+
+		return nil
+	}
+
+	// Site-wide feed
+	if c.PathVal("id") == "" {
+		c.SetData(constants.Data, dto.FeedScope{})
+
+		return nil
+	}
+
+	authorID, errData := http.PathID(c)
+	if errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, dto.FeedScope{AuthorID: authorID})
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function renders the latest published articles as an RSS 2.0 or Atom 1.0 feed.
+// Rendered feeds are cached and supports conditional requests (`ETag`/`Last-Modified`).
+// @Description Function renders the latest published articles as an RSS 2.0 or Atom 1.0 feed.
+// @Summary Feed of published articles
+// @Tags Feeds
+// @Produce xml
+// @Param id path int false "Author ID"
+// @Param slug path string false "Category slug"
+// @Success 200 {object} response.RSS
+// @Success 304
+// @Failure 400 {object} response.Error
+// @Failure 404 {object} response.Error
+// @Router /feeds/articles.rss [get]
+// @Router /feeds/articles.atom [get]
+// @Router /feeds/authors/{id}/articles.rss [get]
+// @Router /feeds/authors/{id}/articles.atom [get]
+// @Router /feeds/categories/{slug}/articles.rss [get]
+// @Router /feeds/categories/{slug}/articles.atom [get]
+func (h *ArticleFeedApi) Handle(c *core.Ctx) error {
+	scope := c.GetData(constants.Data).(dto.FeedScope)
+	cacheKey := services.FeedCacheKey(h.format, scope)
+
+	feed, found := services.GetCachedFeed(cacheKey)
+	if !found {
+		channel, err := h.channel(c, scope)
+		if err != nil {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		articles, err := services.FindFeedArticles(scope)
+		if err != nil {
+			log.Error(err)
+
+			return c.Error(response.Error{
+				Code:    core.StatusInternalServerError,
+				Message: "Failed to load feed",
+			}, core.StatusInternalServerError)
+		}
+
+		feed, err = h.render(channel, articles)
+		if err != nil {
+			log.Error(err)
+
+			return c.Error(response.Error{
+				Code:    core.StatusInternalServerError,
+				Message: "Failed to render feed",
+			}, core.StatusInternalServerError)
+		}
+
+		if err = services.CacheFeed(cacheKey, *feed); err != nil {
+			log.Warnf("Failed to cache feed `%s`: %v", cacheKey, err)
+		}
+	}
+
+	if http.NotModified(c, feed.ETag, feed.LastModified) {
+		return nil
+	}
+
+	contentType := "application/rss+xml; charset=utf-8"
+	if feed.Format == dto.FeedFormatAtom {
+		contentType = "application/atom+xml; charset=utf-8"
+	}
+
+	return c.
+		SetHeader(core.HeaderContentType, contentType).
+		Raw([]byte(feed.Body))
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// channel builds the metadata of the requested feed.
+func (h *ArticleFeedApi) channel(c *core.Ctx, scope dto.FeedScope) (dto.FeedChannel, error) {
+	appURL := strings.TrimSuffix(core.AppURL, "/")
+	title := utils.Getenv("FEED_TITLE", utils.Getenv("API_NAME", "gFly API"))
+
+	channel := dto.FeedChannel{
+		Title:       title,
+		Description: fmt.Sprintf("Latest stories from %s", title),
+		Link:        appURL,
+		SelfURL:     appURL + string(c.Root().Path()),
+		Language:    utils.Getenv("FEED_LANGUAGE", "vi"),
+		FullContent: utils.Getenv("FEED_CONTENT", "excerpt") == "full",
+	}
+
+	if scope.Category != nil {
+		channel.Title = fmt.Sprintf("%s - %s", scope.Category.Name, title)
+		channel.Description = fmt.Sprintf("Latest %s stories", scope.Category.Name)
+	}
+
+	if scope.AuthorID > 0 {
+		author, err := mb.GetModelByID[models.User](scope.AuthorID)
+		if err != nil || author == nil {
+			return channel, errors.New("Author not found")
+		}
+
+		channel.Title = fmt.Sprintf("%s - %s", author.Fullname, title)
+		channel.Description = fmt.Sprintf("Latest stories by %s", author.Fullname)
+	}
+
+	return channel, nil
+}
+
+// render marshals articles into the feed format of the controller.
+func (h *ArticleFeedApi) render(channel dto.FeedChannel, articles []models.Article) (*dto.Feed, error) {
+	items := services.FeedItems(articles, channel.FullContent)
+
+	var document any = transformers.ToRSSResponse(channel, items)
+	if h.format == dto.FeedFormatAtom {
+		document = transformers.ToAtomResponse(channel, items)
+	}
+
+	body, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	xmlBody := xml.Header + string(body)
+
+	return &dto.Feed{
+		Format:       h.format,
+		Body:         xmlBody,
+		ETag:         http.ETag(xmlBody),
+		LastModified: transformers.FeedUpdatedAt(articles),
+	}, nil
+}
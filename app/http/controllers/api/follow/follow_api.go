@@ -0,0 +1,90 @@
+package follow
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type FollowApi struct {
+	core.Api
+}
+
+func NewFollowApi() *FollowApi {
+	return &FollowApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *FollowApi) Validate(c *core.Ctx) error {
+	var requestBody request.Follow
+	if errData := http.Parse(c, &requestBody); errData != nil {
+		return c.Error(errData)
+	}
+
+	// Follower is the current user
+	requestDto := requestBody.ToDto()
+	requestDto.UserID = c.GetData(constants.User).(models.User).ID
+
+	if errData := http.Validate(requestDto); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, requestDto)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function makes the current user follow a category or an author.
+// @Description Function makes the current user follow a category or an author.
+// @Description Followers are notified of new stories by email and/or in the app (`/me/notification-preferences`).
+// @Summary Follow a category or an author
+// @Tags Follows
+// @Accept json
+// @Produce json
+// @Param data body request.Follow true "Follow payload"
+// @Success 201 {object} response.Follow
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /me/follows [post]
+func (h *FollowApi) Handle(c *core.Ctx) error {
+	followDto := c.GetData(constants.Data).(dto.Follow)
+
+	follow, err := services.FollowTarget(followDto)
+	if err != nil {
+		if err.Error() == "Category not found" || err.Error() == "Author not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	return c.
+		Status(core.StatusCreated).
+		JSON(transformers.ToFollowResponse(*follow))
+}
@@ -0,0 +1,54 @@
+package follow
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ListFollowsApi struct {
+	core.Api
+}
+
+func NewListFollowsApi() *ListFollowsApi {
+	return &ListFollowsApi{}
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function lists the categories and authors followed by the current user.
+// @Description Function lists the categories and authors followed by the current user, latest first.
+// @Summary List follows
+// @Tags Follows
+// @Accept json
+// @Produce json
+// @Success 200 {array} response.Follow
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /me/follows [get]
+func (h *ListFollowsApi) Handle(c *core.Ctx) error {
+	user := c.GetData(constants.User).(models.User)
+
+	follows, err := services.FindFollows(user.ID)
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while fetching follows",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(transformers.ToListResponse(follows, transformers.ToFollowResponse))
+}
@@ -0,0 +1,91 @@
+package follow
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/services"
+	"slices"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type UnfollowApi struct {
+	core.Api
+}
+
+func NewUnfollowApi() *UnfollowApi {
+	return &UnfollowApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *UnfollowApi) Validate(c *core.Ctx) error {
+	targetType := types.FollowType(c.PathVal("type"))
+	if !slices.Contains(types.FollowTypeList, targetType) {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: "type must be one of: category, author",
+		})
+	}
+
+	targetID, errData := http.PathID(c)
+	if errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, dto.Follow{
+		UserID:     c.GetData(constants.User).(models.User).ID,
+		TargetType: targetType,
+		TargetID:   targetID,
+	})
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function stops following a category or an author.
+// @Description Function stops following a category or an author.
+// @Summary Unfollow a category or an author
+// @Tags Follows
+// @Accept json
+// @Produce json
+// @Param type path string true "Followed target (category or author)"
+// @Param id path int true "Category or author ID"
+// @Success 204
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /me/follows/{type}/{id} [delete]
+func (h *UnfollowApi) Handle(c *core.Ctx) error {
+	followDto := c.GetData(constants.Data).(dto.Follow)
+
+	if err := services.Unfollow(followDto.UserID, followDto.TargetType, followDto.TargetID); err != nil {
+		if err.Error() == "Follow not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: err.Error(),
+		}, core.StatusInternalServerError)
+	}
+
+	return c.NoContent()
+}
@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	appGraphQL "gfly/app/graphql"
+	"gfly/app/http"
+	"gfly/app/http/request"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type GraphQLApi struct {
+	core.Api
+}
+
+func NewGraphQLApi() *GraphQLApi {
+	return &GraphQLApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+// Validate validates the query document and its variables
+func (h *GraphQLApi) Validate(c *core.Ctx) error {
+	return http.ProcessRequest[request.GraphQLQuery, dto.GraphQLQuery](c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function runs a GraphQL query on the story catalogue.
+// @Description Read-only GraphQL API of the published stories, their authors and categories.
+// @Description Article lists accept the filters of `/articles` and return card fields, the full content is served by `/articles/{slug}`.
+// @Description Queries deeper than GRAPHQL_MAX_DEPTH or more complex than GRAPHQL_MAX_COMPLEXITY (fields, multiplied by the page size under lists) are rejected.
+// @Description A GraphiQL page is served by GET on the same path outside production.
+// @Summary Run a GraphQL query
+// @Tags GraphQL
+// @Accept json
+// @Produce json
+// @Param data body dto.GraphQLQuery true "GraphQL query"
+// @Param lang query string false "Locale of translated articles (default: Accept-Language, then the default locale)"
+// @Success 200 {object} object "data and errors of the query"
+// @Failure 400 {object} object "errors of a rejected query"
+// @Router /graphql [post]
+func (h *GraphQLApi) Handle(c *core.Ctx) error {
+	query := c.GetData(constants.Data).(dto.GraphQLQuery)
+
+	result := appGraphQL.Execute(query, http.NegotiateLocale(c))
+
+	// No data at all: syntax errors, invalid or rejected queries, failed required fields
+	if result.Data == nil {
+		return c.Status(core.StatusBadRequest).JSON(result)
+	}
+
+	return c.Success(result)
+}
@@ -0,0 +1,94 @@
+package newsletter
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/services"
+	"strings"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type SubscribeApi struct {
+	core.Api
+}
+
+func NewSubscribeApi() *SubscribeApi {
+	return &SubscribeApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *SubscribeApi) Validate(c *core.Ctx) error {
+	var requestBody request.Subscribe
+	if errData := http.Parse(c, &requestBody); errData != nil {
+		return c.Error(errData)
+	}
+
+	requestDto := requestBody.ToDto()
+
+	// Signed-in readers subscribe with their account email by default
+	if user, ok := c.GetData(constants.User).(models.User); ok {
+		if requestDto.Email == "" || strings.EqualFold(requestDto.Email, user.Email) {
+			requestDto.Email = user.Email
+			requestDto.UserID = user.ID
+		}
+	}
+
+	if requestDto.Email == "" {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: "email is required",
+		})
+	}
+
+	if errData := http.Validate(requestDto); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, requestDto)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function subscribes an email address to the weekly digest.
+// @Description Function subscribes an email address to the "Top ghost stories" weekly digest, with or without an account.
+// @Description A confirmation link is emailed first (double opt-in). The response doesn't tell whether the address was already subscribed.
+// @Summary Subscribe to the weekly digest
+// @Tags Newsletter
+// @Accept json
+// @Produce json
+// @Param data body request.Subscribe true "Subscribe payload"
+// @Success 204
+// @Failure 400 {object} response.Error
+// @Failure 500 {object} response.Error
+// @Router /newsletter/subscriptions [post]
+func (h *SubscribeApi) Handle(c *core.Ctx) error {
+	subscribeDto := c.GetData(constants.Data).(dto.Subscribe)
+
+	if err := services.Subscribe(subscribeDto); err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Unable to subscribe",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.NoContent()
+}
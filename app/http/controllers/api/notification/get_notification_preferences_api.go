@@ -0,0 +1,42 @@
+package notification
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type GetNotificationPreferencesApi struct {
+	core.Api
+}
+
+func NewGetNotificationPreferencesApi() *GetNotificationPreferencesApi {
+	return &GetNotificationPreferencesApi{}
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function gets the notification channels of the current user.
+// @Description Function gets how the current user is notified of new stories of followed categories and authors.
+// @Summary Get notification preferences
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.NotificationPreferences
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /me/notification-preferences [get]
+func (h *GetNotificationPreferencesApi) Handle(c *core.Ctx) error {
+	user := c.GetData(constants.User).(models.User)
+
+	return c.Success(transformers.ToNotificationPreferencesResponse(services.GetNotificationPreferences(user.ID)))
+}
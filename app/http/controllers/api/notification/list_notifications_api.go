@@ -0,0 +1,68 @@
+package notification
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http/controllers/api"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type ListNotificationsApi struct {
+	api.ListApi
+}
+
+func NewListNotificationsApi() *ListNotificationsApi {
+	return &ListNotificationsApi{}
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function lists the in-app notifications of the current user.
+// @Description Function lists the in-app notifications of the current user, latest first
+// @Description (new stories of followed categories and authors).
+// @Summary List notifications
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param page query int false "Page"
+// @Param per_page query int false "Items Per Page"
+// @Success 200 {object} response.ListNotification
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /me/notifications [get]
+func (h *ListNotificationsApi) Handle(c *core.Ctx) error {
+	filterDto := c.GetData(constants.Filter).(dto.Filter)
+	user := c.GetData(constants.User).(models.User)
+
+	items, total, err := services.FindNotifications(user.ID, filterDto)
+	if err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while fetching notifications",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(response.ListNotification{
+		Meta: dto.Meta{
+			Page:    filterDto.Page,
+			PerPage: filterDto.PerPage,
+			Total:   total,
+		},
+		Data: transformers.ToListResponse(items, transformers.ToNotificationResponse),
+	})
+}
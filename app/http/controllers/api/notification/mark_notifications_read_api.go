@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type MarkNotificationsReadApi struct {
+	core.Api
+}
+
+func NewMarkNotificationsReadApi() *MarkNotificationsReadApi {
+	return &MarkNotificationsReadApi{}
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function marks all in-app notifications of the current user as read.
+// @Description Function marks all in-app notifications of the current user as read.
+// @Summary Mark notifications as read
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Success 204
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /me/notifications/read [put]
+func (h *MarkNotificationsReadApi) Handle(c *core.Ctx) error {
+	user := c.GetData(constants.User).(models.User)
+
+	if err := services.MarkNotificationsRead(user.ID); err != nil {
+		log.Error(err)
+
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: "Error occurred while updating notifications",
+		}, core.StatusInternalServerError)
+	}
+
+	return c.NoContent()
+}
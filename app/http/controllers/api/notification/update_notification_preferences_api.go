@@ -0,0 +1,79 @@
+package notification
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type UpdateNotificationPreferencesApi struct {
+	core.Api
+}
+
+func NewUpdateNotificationPreferencesApi() *UpdateNotificationPreferencesApi {
+	return &UpdateNotificationPreferencesApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *UpdateNotificationPreferencesApi) Validate(c *core.Ctx) error {
+	var requestBody request.NotificationPreferences
+	if errData := http.Parse(c, &requestBody); errData != nil {
+		return c.Error(errData)
+	}
+
+	requestDto := requestBody.ToDto()
+	requestDto.UserID = c.GetData(constants.User).(models.User).ID
+
+	if errData := http.Validate(requestDto); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, requestDto)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function updates the notification channels of the current user.
+// @Description Function chooses how the current user is notified of new stories of followed categories and authors:
+// @Description by email and/or in the app. Missing channels are kept.
+// @Summary Update notification preferences
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param data body request.NotificationPreferences true "NotificationPreferences payload"
+// @Success 200 {object} response.NotificationPreferences
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Security ApiKeyAuth
+// @Router /me/notification-preferences [put]
+func (h *UpdateNotificationPreferencesApi) Handle(c *core.Ctx) error {
+	preferencesDto := c.GetData(constants.Data).(dto.NotificationPreferences)
+
+	preference, err := services.SaveNotificationPreferences(preferencesDto)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusInternalServerError,
+			Message: err.Error(),
+		}, core.StatusInternalServerError)
+	}
+
+	return c.Success(transformers.ToNotificationPreferencesResponse(*preference))
+}
@@ -0,0 +1,84 @@
+package sitemap
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type GetSitemapApi struct {
+	core.Api
+}
+
+// NewGetSitemapApi As a constructor to serve the generated sitemap files.
+func NewGetSitemapApi() *GetSitemapApi {
+	return &GetSitemapApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+// Validate validates the optional sitemap file name. The sitemap index is served when there is no name.
+func (h *GetSitemapApi) Validate(c *core.Ctx) error {
+	name := c.PathVal("name")
+	if name == "" {
+		name = services.SitemapIndexFile
+	}
+
+	c.SetData(constants.Data, name)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function serves a sitemap file generated by `sitemap:generate`.
+// The sitemap index is generated on the fly when it doesn't exist yet.
+// @Description Function serves a sitemap file generated by `sitemap:generate`.
+// @Summary Get sitemap
+// @Tags Sitemaps
+// @Produce xml
+// @Param name path string false "Sitemap file name (e.g. articles-1.xml)"
+// @Success 200
+// @Success 304
+// @Failure 404 {object} response.Error
+// @Router /sitemap.xml [get]
+// @Router /sitemaps/{name} [get]
+func (h *GetSitemapApi) Handle(c *core.Ctx) error {
+	name := c.GetData(constants.Data).(string)
+
+	content, lastModified, err := services.GetSitemapFile(name)
+	if err != nil && name == services.SitemapIndexFile {
+		if _, err = services.GenerateSitemaps(); err != nil {
+			log.Error(err)
+		}
+
+		content, lastModified, err = services.GetSitemapFile(name)
+	}
+
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusNotFound,
+			Message: "Sitemap not found",
+		}, core.StatusNotFound)
+	}
+
+	if http.NotModified(c, http.ETag(string(content)), lastModified) {
+		return nil
+	}
+
+	return c.
+		SetHeader(core.HeaderContentType, core.MIMEApplicationXMLCharsetUTF8).
+		Raw(content)
+}
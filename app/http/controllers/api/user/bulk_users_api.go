@@ -0,0 +1,57 @@
+package user
+
+import (
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/services"
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type BulkUsersApi struct {
+	core.Api
+}
+
+func NewBulkUsersApi() *BulkUsersApi {
+	return &BulkUsersApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h BulkUsersApi) Validate(c *core.Ctx) error {
+	return http.ProcessRequest[request.BulkUsers, dto.BulkUsers](c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle Process main logic for API.
+// @Summary Bulk update users
+// @Description Apply an action to up to 100 users: activate, block, delete or set_roles. <b>Administrator privilege required</b>
+// @Description Users are changed one by one, the result of each one is in `results`. Your own account is never changed (failed item).
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param request body request.BulkUsers true "User IDs and action"
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Success 200 {object} dto.BulkResult
+// @Security ApiKeyAuth
+// @Router /users/bulk [post]
+func (h BulkUsersApi) Handle(c *core.Ctx) error {
+	bulkDto := c.GetData(constants.Data).(dto.BulkUsers)
+	actor := c.GetData(constants.User).(models.User)
+
+	result := services.BulkUpdateUsers(bulkDto, actor.ID)
+
+	return c.Success(result)
+}
@@ -0,0 +1,92 @@
+package user
+
+import (
+	"gfly/app/constants"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/request"
+	"gfly/app/http/response"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type GrantEntitlementApi struct {
+	core.Api
+}
+
+func NewGrantEntitlementApi() *GrantEntitlementApi {
+	return &GrantEntitlementApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *GrantEntitlementApi) Validate(c *core.Ctx) error {
+	userID, errData := http.PathID(c)
+	if errData != nil {
+		return c.Error(errData)
+	}
+
+	var requestBody request.GrantEntitlement
+	if errData := http.Parse(c, &requestBody); errData != nil {
+		return c.Error(errData)
+	}
+
+	// User comes from the path
+	requestDto := requestBody.ToDto()
+	requestDto.UserID = userID
+
+	if errData := http.Validate(requestDto); errData != nil {
+		return c.Error(errData)
+	}
+
+	c.SetData(constants.Data, requestDto)
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function grants a plan to a user, replacing the current plan.
+// @Description Function grants a members or premium plan to a user, replacing the current plan.
+// @Summary Grant a plan to a user
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param data body request.GrantEntitlement true "GrantEntitlement payload"
+// @Success 200 {object} response.Entitlement
+// @Failure 400 {object} response.Error
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/users/{id}/entitlement [put]
+func (h *GrantEntitlementApi) Handle(c *core.Ctx) error {
+	grantDto := c.GetData(constants.Data).(dto.GrantEntitlement)
+
+	entitlement, err := services.GrantEntitlement(grantDto)
+	if err != nil {
+		if err.Error() == "User not found" {
+			return c.Error(response.Error{
+				Code:    core.StatusNotFound,
+				Message: err.Error(),
+			}, core.StatusNotFound)
+		}
+
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	return c.Success(transformers.ToEntitlementResponse(*entitlement))
+}
@@ -2,11 +2,15 @@ package user
 
 import (
 	"gfly/app/constants"
+	"gfly/app/domain/models"
 	"gfly/app/dto"
 	"gfly/app/http/controllers/api"
 	"gfly/app/http/response"
 	"gfly/app/http/transformers"
 	"gfly/app/services"
+	"slices"
+	"strings"
+
 	"github.com/gflydev/core"
 )
 
@@ -22,6 +26,32 @@ func NewListUsersApi() *ListUsersApi {
 	return &ListUsersApi{}
 }
 
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+// Validate validates the filter and resolves the requested fields (card fields by default)
+func (h *ListUsersApi) Validate(c *core.Ctx) error {
+	if err := h.ListApi.Validate(c); err != nil {
+		return err
+	}
+
+	filterDto := c.GetData(constants.Filter).(dto.Filter)
+
+	fields, err := services.UserListFields(filterDto.Fields)
+	if err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	filterDto.Fields = strings.Join(fields, ",")
+
+	c.SetData(constants.Filter, filterDto)
+
+	return nil
+}
+
 // ====================================================================
 // ========================= Request Handling =========================
 // ====================================================================
@@ -38,6 +68,9 @@ func NewListUsersApi() *ListUsersApi {
 // @Param order_by query string false "Order By"
 // @Param page query int false "Page"
 // @Param per_page query int false "Items Per Page"
+// @Param pagination query string false "Pagination mode: page (default) or cursor"
+// @Param cursor query string false "Cursor returned as next_cursor or prev_cursor (cursor mode)"
+// @Param fields query string false "Comma separated fields to return (default: id, email, fullname, phone, status, avatar, roles, created_at, last_access_at)"
 // @Failure 400 {object} response.Error
 // @Failure 401 {object} response.Unauthorized
 // @Success 200 {object} response.ListUser
@@ -45,23 +78,53 @@ func NewListUsersApi() *ListUsersApi {
 // @Router /users [get]
 func (h *ListUsersApi) Handle(c *core.Ctx) error {
 	filterDto := c.GetData(constants.Filter).(dto.Filter)
-	users, total, err := services.FindUsers(filterDto)
+
+	var users []models.User
+	var cursors dto.Cursors
+	var total int
+	var err error
+
+	if filterDto.IsCursor() {
+		users, cursors, total, err = services.FindUsersByCursor(filterDto)
+		if err != nil && (err.Error() == "Invalid cursor" || strings.HasPrefix(err.Error(), "Cursor pagination")) {
+			return c.Error(response.Error{
+				Code:    core.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+	} else {
+		users, total, err = services.FindUsers(filterDto)
+	}
+
 	if err != nil {
 		return err
 	}
 
 	// Pagination metadata
 	metadata := dto.Meta{
-		Page:    filterDto.Page,
-		PerPage: filterDto.PerPage,
-		Total:   total,
+		Page:       filterDto.Page,
+		PerPage:    filterDto.PerPage,
+		Total:      total,
+		NextCursor: cursors.Next,
+		PrevCursor: cursors.Prev,
+	}
+
+	if filterDto.IsCursor() {
+		metadata.Page = 0
+	}
+
+	// Transform to response data (roles are loaded only when requested)
+	fields := strings.Split(filterDto.Fields, ",")
+
+	transformer := transformers.ToUserSummaryResponse
+	if slices.Contains(fields, "roles") {
+		transformer = transformers.ToUserResponse
 	}
 
-	// Transform to response data
-	data := transformers.ToListResponse(users, transformers.ToUserResponse)
+	data := transformers.ToListResponse(users, transformer)
 
-	return c.Success(response.ListUser{
-		Meta: metadata,
-		Data: data,
+	return c.Success(core.Data{
+		"meta": metadata,
+		"data": transformers.ToFieldsResponse(data, fields),
 	})
 }
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"gfly/app/constants"
+	"gfly/app/http"
+	"gfly/app/http/response"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+type RevokeEntitlementApi struct {
+	core.Api
+}
+
+func NewRevokeEntitlementApi() *RevokeEntitlementApi {
+	return &RevokeEntitlementApi{}
+}
+
+// ====================================================================
+// ======================== Request Validation ========================
+// ====================================================================
+
+func (h *RevokeEntitlementApi) Validate(c *core.Ctx) error {
+	return http.ProcessPathID(c)
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle function revokes the plan of a user.
+// @Description Function revokes the plan of a user, members-only and premium stories are locked again.
+// @Summary Revoke the plan of a user
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 204
+// @Failure 401 {object} response.Unauthorized
+// @Failure 404 {object} response.Error
+// @Security ApiKeyAuth
+// @Router /admin/users/{id}/entitlement [delete]
+func (h *RevokeEntitlementApi) Handle(c *core.Ctx) error {
+	userID := c.GetData(constants.Data).(int)
+
+	if err := services.RevokeEntitlement(userID); err != nil {
+		return c.Error(response.Error{
+			Code:    core.StatusNotFound,
+			Message: err.Error(),
+		}, core.StatusNotFound)
+	}
+
+	return c.NoContent()
+}
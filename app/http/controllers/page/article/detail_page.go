@@ -0,0 +1,124 @@
+package article
+
+import (
+	"encoding/json"
+	"gfly/app/domain/models"
+	"gfly/app/http"
+	"gfly/app/http/controllers/page"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+	mb "github.com/gflydev/db"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+// NewDetailPage As a constructor to create a public story Page.
+func NewDetailPage() *DetailPage {
+	return &DetailPage{}
+}
+
+type DetailPage struct {
+	page.BasePage
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+func (m *DetailPage) Handle(c *core.Ctx) error {
+	slug := c.PathVal("slug")
+	locale := http.NegotiateLocale(c)
+
+	article, err := services.GetPublishedArticleBySlug(slug)
+	if err != nil && article == nil {
+		// Per-locale slug: show the translation unless another locale is requested
+		if baseSlug, slugLocale, ok := services.TranslatedArticleSlug(slug); ok {
+			article, err = services.GetPublishedArticleBySlug(baseSlug)
+			if c.QueryStr("lang") == "" {
+				locale = slugLocale
+			}
+		}
+	}
+
+	if err != nil {
+		if article != nil {
+			return m.ErrorView(c, core.StatusGone, "This story is no longer available.")
+		}
+
+		return m.ErrorView(c, core.StatusNotFound, "Story not found.")
+	}
+
+	// Age-rated and members-only stories depend on the reader, keep them out of shared caches
+	ageGated := services.IsAgeGated(*article, http.ViewerAgeRating(c))
+	locked := services.IsLocked(*article, http.ViewerAccessRank(c))
+	if article.AgeRating > 0 || locked {
+		c.SetHeader(core.HeaderCacheControl, "private, no-cache")
+	}
+
+	// Count the view (deduplicated per visitor) unless the story is hidden
+	if !ageGated {
+		http.TrackArticleView(c, article.ID)
+	}
+
+	author, err := mb.GetModelByID[models.User](article.AuthorID)
+	if err != nil {
+		log.Warnf("Author %d of article %d not found: %v", article.AuthorID, article.ID, err)
+		author = nil
+	}
+
+	translations, err := services.FindArticleTranslations(article.ID)
+	if err != nil {
+		log.Warnf("Failed to load translations of article %d: %v", article.ID, err)
+	}
+
+	localized, contentLocale := services.LocalizeArticle(*article, translations, locale)
+	c.SetHeader(core.HeaderContentLanguage, contentLocale)
+
+	if ageGated {
+		localized.Content = ""
+	} else if locked {
+		localized.Content = services.ArticleTeaser(localized)
+	}
+
+	jsonLD, err := json.Marshal(transformers.ToArticleJSONLD(localized, author))
+	if err != nil {
+		log.Error(err)
+	}
+
+	// Breadcrumbs through the first category of the story
+	var category *models.Category
+	if categories, err := services.FindCategoriesOfArticles([]int{article.ID}); err != nil {
+		log.Warnf("Failed to load categories of article %d: %v", article.ID, err)
+	} else if len(categories[article.ID]) > 0 {
+		category = &categories[article.ID][0]
+	}
+
+	breadcrumbs := transformers.ToBreadcrumbs(category, &localized)
+
+	breadcrumbJSONLD, err := json.Marshal(transformers.ToBreadcrumbJSONLD(breadcrumbs))
+	if err != nil {
+		log.Error(err)
+	}
+
+	return m.View(c, "article/detail", core.Data{
+		"title_page":         localized.Title,
+		"meta_description":   transformers.ArticleDescription(localized),
+		"canonical_url":      transformers.ArticleURL(localized.Slug),
+		"og_image":           transformers.ArticleImageURL(localized),
+		"json_ld":            string(jsonLD),
+		"breadcrumb_json_ld": string(breadcrumbJSONLD),
+		"breadcrumbs":        breadcrumbs,
+		"page_locale":        contentLocale,
+		"alternates":         transformers.ToAlternatesResponse(*article, services.DefaultLocale(), translations),
+		"article":            localized,
+		"author":             author,
+		"content_warnings":   services.ContentWarnings(localized),
+		"age_gated":          ageGated,
+		"locked":             locked,
+	})
+}
@@ -0,0 +1,108 @@
+package category
+
+import (
+	"encoding/json"
+	"fmt"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/http/controllers/page"
+	"gfly/app/http/transformers"
+	"gfly/app/services"
+	"strconv"
+	"strings"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// pageSize number of stories of a category page.
+const pageSize = 20
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+// NewDetailPage As a constructor to create a public category Page.
+func NewDetailPage() *DetailPage {
+	return &DetailPage{}
+}
+
+type DetailPage struct {
+	page.BasePage
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+func (m *DetailPage) Handle(c *core.Ctx) error {
+	category, err := services.GetCategoryBySlug(c.PathVal("slug"))
+	if err != nil {
+		return m.ErrorView(c, core.StatusNotFound, "Category not found.")
+	}
+
+	pageNumber, _ := strconv.Atoi(c.QueryStr("page"))
+	pageNumber = max(pageNumber, 1)
+
+	// Latest published stories of the category
+	articles, total, err := services.FindArticles(dto.ArticleFilter{
+		Filter: dto.Filter{
+			Page:    pageNumber,
+			PerPage: pageSize,
+			OrderBy: "-published_at",
+		},
+		Status:     types.ArticleStatusPublished,
+		CategoryID: category.ID,
+	})
+	if err != nil {
+		log.Error(err)
+
+		return m.ErrorView(c, core.StatusInternalServerError, "Unable to load the stories.")
+	}
+
+	if len(articles) == 0 && pageNumber > 1 {
+		return m.ErrorView(c, core.StatusNotFound, "Page not found.")
+	}
+
+	articles, _ = services.LocalizeArticles(articles, http.NegotiateLocale(c))
+
+	canonicalURL := transformers.CategoryURL(category.Slug)
+	if pageNumber > 1 {
+		canonicalURL = fmt.Sprintf("%s?page=%d", canonicalURL, pageNumber)
+	}
+
+	breadcrumbs := transformers.ToBreadcrumbs(category, nil)
+
+	jsonLD, err := json.Marshal(transformers.ToCategoryJSONLD(*category, articles, canonicalURL))
+	if err != nil {
+		log.Error(err)
+	}
+
+	breadcrumbJSONLD, err := json.Marshal(transformers.ToBreadcrumbJSONLD(breadcrumbs))
+	if err != nil {
+		log.Error(err)
+	}
+
+	prevPage, nextPage := 0, 0
+	if pageNumber > 1 {
+		prevPage = pageNumber - 1
+	}
+	if pageNumber*pageSize < total {
+		nextPage = pageNumber + 1
+	}
+
+	return m.View(c, "category/detail", core.Data{
+		"title_page":         fmt.Sprintf("%s | %s", category.Name, core.AppName),
+		"meta_description":   fmt.Sprintf("Latest %s stories on %s", category.Name, core.AppName),
+		"canonical_url":      canonicalURL,
+		"json_ld":            string(jsonLD),
+		"breadcrumb_json_ld": string(breadcrumbJSONLD),
+		"breadcrumbs":        breadcrumbs,
+		"feed_url":           strings.TrimSuffix(core.AppURL, "/") + "/feeds/categories/" + category.Slug + "/articles.rss",
+		"category":           category,
+		"articles":           articles,
+		"prev_page":          prevPage,
+		"next_page":          nextPage,
+	})
+}
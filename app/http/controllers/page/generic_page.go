@@ -2,6 +2,7 @@ package page
 
 import (
 	"database/sql"
+	"fmt"
 	"gfly/app/constants"
 	"gfly/app/domain/models"
 	"github.com/gflydev/core"
@@ -48,3 +49,14 @@ func (m *BasePage) View(c *core.Ctx, template string, data core.Data) error {
 
 	return c.View(template, data)
 }
+
+// ErrorView renders the error page with the given HTTP status.
+func (m *BasePage) ErrorView(c *core.Ctx, status int, message string) error {
+	c.Status(status)
+
+	return m.View(c, "error", core.Data{
+		"title_page": fmt.Sprintf("%d | %s", status, core.AppName),
+		"status":     status,
+		"message":    message,
+	})
+}
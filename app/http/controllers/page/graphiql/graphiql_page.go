@@ -0,0 +1,34 @@
+package graphiql
+
+import (
+	"gfly/app/http/controllers/page"
+
+	"github.com/gflydev/core"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+// NewGraphiQLPage As a constructor to create the GraphiQL Page (registered outside production only).
+func NewGraphiQLPage() *GraphiQLPage {
+	return &GraphiQLPage{}
+}
+
+type GraphiQLPage struct {
+	page.BasePage
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle renders the GraphiQL IDE. Its queries are posted to the path of the page.
+func (m *GraphiQLPage) Handle(c *core.Ctx) error {
+	c.SetHeader(core.HeaderCacheControl, "private, no-store")
+
+	return m.View(c, "graphiql", core.Data{
+		"title_page": "GraphiQL | " + core.AppName,
+		"endpoint":   c.Path(),
+	})
+}
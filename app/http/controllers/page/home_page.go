@@ -1,6 +1,14 @@
 package page
 
-import "github.com/gflydev/core"
+import (
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"strings"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
 
 // ====================================================================
 // ======================== Controller Creation =======================
@@ -20,7 +28,22 @@ type HomePage struct {
 // ====================================================================
 
 func (m *HomePage) Handle(c *core.Ctx) error {
+	// Latest published stories
+	articles, _, err := services.FindArticles(dto.ArticleFilter{
+		Filter: dto.Filter{
+			Page:    1,
+			PerPage: 12,
+			OrderBy: "-published_at",
+		},
+		Status: types.ArticleStatusPublished,
+	})
+	if err != nil {
+		log.Error(err)
+	}
+
 	return m.View(c, "home", core.Data{
-		"hero_text": "gFly - Laravel inspired web framework written in Go",
+		"hero_text":     "gFly - Laravel inspired web framework written in Go",
+		"canonical_url": strings.TrimSuffix(core.AppURL, "/") + "/",
+		"articles":      articles,
 	})
 }
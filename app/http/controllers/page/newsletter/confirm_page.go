@@ -0,0 +1,43 @@
+package newsletter
+
+import (
+	"gfly/app/http/controllers/page"
+	"gfly/app/services"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+// NewConfirmPage As a constructor to create the subscription confirmation Page.
+func NewConfirmPage() *ConfirmPage {
+	return &ConfirmPage{}
+}
+
+type ConfirmPage struct {
+	page.BasePage
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle confirms a newsletter subscription from the link of the confirmation email (double opt-in)
+func (m *ConfirmPage) Handle(c *core.Ctx) error {
+	c.SetHeader(core.HeaderCacheControl, "private, no-store")
+
+	if _, err := services.ConfirmSubscription(c.QueryStr("token")); err != nil {
+		log.Warn(err)
+
+		return m.ErrorView(c, core.StatusBadRequest, err.Error()+". Please subscribe again.")
+	}
+
+	return m.View(c, "newsletter", core.Data{
+		"title_page": "Subscription confirmed | " + core.AppName,
+		"heading":    "You're subscribed",
+		"message":    "Top ghost stories will be in your inbox every week.",
+	})
+}
@@ -0,0 +1,64 @@
+package newsletter
+
+import (
+	"gfly/app/http/controllers/page"
+	"gfly/app/services"
+	"net/url"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+)
+
+// ====================================================================
+// ======================== Controller Creation =======================
+// ====================================================================
+
+// NewUnsubscribePage As a constructor to create the one-click unsubscribe Page.
+func NewUnsubscribePage() *UnsubscribePage {
+	return &UnsubscribePage{}
+}
+
+type UnsubscribePage struct {
+	page.BasePage
+}
+
+// ====================================================================
+// ========================= Request Handling =========================
+// ====================================================================
+
+// Handle asks to confirm the unsubscription of the link of every digest, without signing in: link scanners
+// and prefetching mail clients follow GET links. The POST of the confirm form, or of mail clients
+// (RFC 8058 one-click unsubscribe), unsubscribes.
+func (m *UnsubscribePage) Handle(c *core.Ctx) error {
+	c.SetHeader(core.HeaderCacheControl, "private, no-store")
+
+	token := c.QueryStr("token")
+
+	if string(c.Root().Method()) != core.MethodPost {
+		if _, err := services.GetSubscriberByUnsubscribeToken(token); err != nil {
+			log.Warn(err)
+
+			return m.ErrorView(c, core.StatusNotFound, err.Error()+".")
+		}
+
+		return m.View(c, "newsletter", core.Data{
+			"title_page":   "Unsubscribe | " + core.AppName,
+			"heading":      "Unsubscribe",
+			"message":      "Stop receiving Top ghost stories every week?",
+			"confirm_url":  "/newsletter/unsubscribe?token=" + url.QueryEscape(token),
+			"confirm_text": "Unsubscribe",
+		})
+	}
+
+	if _, err := services.Unsubscribe(token); err != nil {
+		log.Warn(err)
+
+		return m.ErrorView(c, core.StatusNotFound, err.Error()+".")
+	}
+
+	return m.View(c, "newsletter", core.Data{
+		"title_page": "Unsubscribed | " + core.AppName,
+		"heading":    "You're unsubscribed",
+		"message":    "You won't receive Top ghost stories anymore. You can subscribe again at any time.",
+	})
+}
@@ -2,12 +2,23 @@ package http
 
 import (
 	"fmt"
+	"gfly/app/console/queues"
 	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
 	"gfly/app/dto"
 	"gfly/app/http/response"
+	"gfly/app/services"
+	appUtils "gfly/app/utils"
+	"github.com/gflydev/console"
 	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
 	"github.com/gflydev/validation"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // ---------------------- Path data ------------------------
@@ -32,6 +43,154 @@ func PathID(c *core.Ctx, idName ...string) (int, *response.Error) {
 	return id, nil
 }
 
+// ---------------------- Client data ------------------------
+
+// ClientIP get the client IP address, honoring `X-Forwarded-For`/`X-Real-IP` only when the request comes
+// from one of the `TRUSTED_PROXIES`
+func ClientIP(c *core.Ctx) string {
+	header := &c.Root().Request.Header
+
+	return appUtils.ClientIP(
+		c.Root().RemoteIP().String(),
+		string(header.Peek(core.HeaderXForwardedFor)),
+		string(header.Peek("X-Real-IP")),
+		strings.Split(utils.Getenv("TRUSTED_PROXIES", ""), ","),
+	)
+}
+
+// VisitorID get an anonymous identifier of the visitor (hash of IP and User-Agent)
+func VisitorID(c *core.Ctx) string {
+	return utils.Sha256(ClientIP(c) + "|" + string(c.Root().UserAgent()))
+}
+
+// ResponseCacheKey builds the response cache key of a request from its path and normalized query
+// (see services.ResponseCacheKey). Variants are values negotiated from headers (e.g. the locale).
+// The age confirmation token is left out, its age rating is a variant. So is the A/B test variant of a click.
+func ResponseCacheKey(c *core.Ctx, variants ...string) string {
+	query := url.Values{}
+
+	c.Root().QueryArgs().VisitAll(func(key, value []byte) {
+		query.Add(string(key), string(value))
+	})
+
+	return services.ResponseCacheKey(c.Path(), query, []string{"age_token", "variant"}, variants...)
+}
+
+// NegotiateLocale get the locale of the response from `?lang=` or `Accept-Language`, falling back to the
+// default locale, and set the `Content-Language` and `Vary` headers
+func NegotiateLocale(c *core.Ctx) string {
+	locale := appUtils.NegotiateLocale(
+		c.QueryStr("lang"),
+		string(c.Root().Request.Header.Peek(core.HeaderAcceptLanguage)),
+		services.SupportedLocales(),
+		services.DefaultLocale(),
+	)
+
+	c.SetHeader(core.HeaderContentLanguage, locale)
+	c.SetHeader(core.HeaderVary, core.HeaderAcceptLanguage)
+
+	return locale
+}
+
+// AgeTokenHeader header carrying the age confirmation token of a reader
+const AgeTokenHeader = "X-Age-Token"
+
+// ViewerAgeRating get the highest age rating the viewer may open, from the birthdate of the authenticated
+// user or the age confirmation token (`X-Age-Token` header or `?age_token=`)
+func ViewerAgeRating(c *core.Ctx) int {
+	token := string(c.Root().Request.Header.Peek(AgeTokenHeader))
+	if token == "" {
+		token = c.QueryStr("age_token")
+	}
+
+	rating := services.VerifyAgeToken(token)
+
+	if user, ok := c.GetData(constants.User).(models.User); ok {
+		rating = max(rating, services.UserAgeRating(user))
+	}
+
+	return rating
+}
+
+// ViewerAccessRank get the rank of the plan of the authenticated user (0 for guests), see types.AccessLevel
+func ViewerAccessRank(c *core.Ctx) int {
+	if user, ok := c.GetData(constants.User).(models.User); ok {
+		return services.UserAccessRank(user)
+	}
+
+	return 0
+}
+
+// ---------------------- Experiments ------------------------
+
+// ExperimentCookie cookie identifying a guest in A/B tests
+const ExperimentCookie = "ab_visitor"
+
+// ExperimentVisitor get the identifier of the reader in A/B tests: the user ID of a signed-in reader,
+// otherwise the `ab_visitor` cookie, or the visitor hash (IP and User-Agent) the cookie is issued from,
+// so clients without cookies keep their bucket too. The cookie is only issued with a variant, see KeepExperimentVisitor.
+func ExperimentVisitor(c *core.Ctx) string {
+	if user, ok := c.GetData(constants.User).(models.User); ok {
+		return fmt.Sprintf("user:%d", user.ID)
+	}
+
+	visitorID := c.GetCookie(ExperimentCookie)
+	if visitorID == "" {
+		visitorID = VisitorID(c)[:32]
+	}
+
+	return "visitor:" + visitorID
+}
+
+// KeepExperimentVisitor issues the `ab_visitor` cookie to a guest served an A/B test variant, so that the guest keeps
+// their bucket when the IP or User-Agent changes. The response must be private: a shared cache would replay the cookie,
+// and the bucket, to other readers.
+func KeepExperimentVisitor(c *core.Ctx) {
+	if _, ok := c.GetData(constants.User).(models.User); ok || c.GetCookie(ExperimentCookie) != "" {
+		return
+	}
+
+	c.SetCookie(ExperimentCookie, VisitorID(c)[:32])
+}
+
+// ExperimentBucket get the A/B test bucket of the reader, see services.ExperimentBuckets
+func ExperimentBucket(visitorID string) int {
+	return appUtils.Bucket(visitorID, services.ExperimentBuckets)
+}
+
+// ---------------------- Analytics ------------------------
+
+// TrackArticleView count the view of an article (deduplicated per visitor) and queue its analytics event
+func TrackArticleView(c *core.Ctx, articleID int) {
+	counted, err := services.RecordArticleView(articleID, VisitorID(c))
+	if err != nil {
+		log.Warnf("Failed to record view for article %d: %v", articleID, err)
+
+		return
+	}
+
+	if counted {
+		event := ViewEventData(c, articleID, types.ViewEventView)
+		event.ReferrerHost = appUtils.ReferrerHost(string(c.Root().Referer()))
+
+		console.DispatchTask(queues.NewViewEventTask(event))
+	}
+}
+
+// ViewEventData get the analytics event of the reader of an article (visitor, device class, signed in or not)
+func ViewEventData(c *core.Ctx, articleID int, event types.ViewEvent) dto.ViewEvent {
+	_, authenticated := c.GetData(constants.User).(models.User)
+
+	return dto.ViewEvent{
+		ArticleID:     articleID,
+		Event:         event,
+		VisitorID:     VisitorID(c),
+		Device:        appUtils.DeviceClass(string(c.Root().UserAgent())),
+		Authenticated: authenticated,
+		CreatedAt:     time.Now(),
+	}
+}
+
 // ---------------------- Parse data ------------------------
 
 // Parse get body data from request
@@ -70,6 +229,31 @@ func FilterData(c *core.Ctx) dto.Filter {
 	filterDto.OrderBy = c.QueryStr("order_by")
 	filterDto.Page = page
 	filterDto.PerPage = limit
+	filterDto.Pagination = c.QueryStr("pagination")
+	filterDto.Cursor = c.QueryStr("cursor")
+	filterDto.Fields = c.QueryStr("fields")
+
+	return filterDto
+}
+
+// ArticleFilterData Parse article list filters shared by the public and admin list APIs.
+func ArticleFilterData(c *core.Ctx) dto.ArticleFilter {
+	filterDto := dto.ArticleFilter{
+		Filter: FilterData(c),
+	}
+
+	filterDto.Status = types.ArticleStatus(c.QueryStr("status"))
+	filterDto.AuthorID, _ = c.QueryInt("author_id")
+	filterDto.CategoryID, _ = c.QueryInt("category_id")
+	filterDto.PublishedFrom = c.QueryStr("published_from")
+	filterDto.PublishedTo = c.QueryStr("published_to")
+	filterDto.CreatedFrom = c.QueryStr("created_from")
+	filterDto.CreatedTo = c.QueryStr("created_to")
+	filterDto.HasVideo = c.QueryStr("has_video")
+	filterDto.HasCover = c.QueryStr("has_cover")
+	filterDto.MinViews, _ = c.QueryInt("min_views")
+	filterDto.ExcludeWarnings = c.QueryStr("exclude_warnings")
+	filterDto.AccessLevel = types.AccessLevel(c.QueryStr("access_level"))
 
 	return filterDto
 }
@@ -97,6 +281,10 @@ func ProcessPathParam(c *core.Ctx, paramName string) error {
 
 // Validate perform data input checking.
 func Validate(structData any, msgForTagFunc ...validation.MsgForTagFunc) *response.Error {
+	if len(msgForTagFunc) == 0 {
+		msgForTagFunc = []validation.MsgForTagFunc{dto.MsgForTag}
+	}
+
 	errorData, err := validation.Check(structData, msgForTagFunc...)
 
 	if err != nil {
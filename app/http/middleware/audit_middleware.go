@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"encoding/json"
+	"gfly/app/constants"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http"
+	"gfly/app/services"
+	"strconv"
+	"strings"
+
+	"github.com/gflydev/core"
+)
+
+// Audit is a route decorator that records a privileged mutation in the audit log. The target type is the
+// first part of the action (`user.status` → `user`), its ID is the `{id}` path parameter, or the `id` of the
+// response for a creation (`*.create`). The state of the target is captured before and after the handler
+// (see services.AuditSnapshot), only successful requests are recorded.
+//
+// A bulk action (`*.bulk`) records an entry per changed item: the IDs come from the `ids` of the request,
+// the changed ones from the `results` of the response (dto.BulkResult), the action is `<type>.bulk_<action>`.
+//
+// Parameters:
+//   - action (string): The audited action, e.g. user.status, article.update.
+//
+// Returns:
+//   - func(core.IHandler) core.IHandler: A function wrapping a handler, like Router.Apply.
+func Audit(action string) func(core.IHandler) core.IHandler {
+	targetType, _, _ := strings.Cut(action, ".")
+
+	return func(handler core.IHandler) core.IHandler {
+		return &auditHandler{
+			handler:    handler,
+			action:     action,
+			targetType: targetType,
+		}
+	}
+}
+
+// auditHandler handler recording the requests of the wrapped handler.
+type auditHandler struct {
+	handler    core.IHandler
+	action     string
+	targetType string
+}
+
+// Validate validates the request with the wrapped handler.
+func (h *auditHandler) Validate(c *core.Ctx) error {
+	return h.handler.Validate(c)
+}
+
+// Handle runs the wrapped handler and records the mutation when it succeeds.
+func (h *auditHandler) Handle(c *core.Ctx) error {
+	if strings.HasSuffix(h.action, ".bulk") {
+		return h.handleBulk(c)
+	}
+
+	targetID, _ := strconv.Atoi(c.PathVal("id"))
+	before := services.AuditSnapshot(h.targetType, targetID)
+
+	if err := h.handler.Handle(c); err != nil {
+		return err
+	}
+
+	statusCode := c.Root().Response.StatusCode()
+	if statusCode >= core.StatusBadRequest {
+		return nil
+	}
+
+	if targetID == 0 && strings.HasSuffix(h.action, ".create") {
+		var created struct {
+			ID int `json:"id"`
+		}
+		_ = json.Unmarshal(c.Root().Response.Body(), &created)
+		targetID = created.ID
+	}
+
+	// The mutation is done, a failed record is only logged
+	_ = services.RecordAudit(h.entry(c, h.action, targetID, before))
+
+	return nil
+}
+
+// handleBulk runs the wrapped bulk handler and records each changed item.
+func (h *auditHandler) handleBulk(c *core.Ctx) error {
+	var bulkRequest struct {
+		IDs    []int  `json:"ids"`
+		Action string `json:"action"`
+	}
+	_ = json.Unmarshal(c.Root().Request.Body(), &bulkRequest)
+
+	before := make(map[int]map[string]any, len(bulkRequest.IDs))
+	for _, targetID := range bulkRequest.IDs {
+		before[targetID] = services.AuditSnapshot(h.targetType, targetID)
+	}
+
+	if err := h.handler.Handle(c); err != nil {
+		return err
+	}
+
+	if c.Root().Response.StatusCode() >= core.StatusBadRequest {
+		return nil
+	}
+
+	var result dto.BulkResult
+	_ = json.Unmarshal(c.Root().Response.Body(), &result)
+
+	action := h.targetType + ".bulk_" + bulkRequest.Action
+	for _, item := range result.Results {
+		if item.Success {
+			_ = services.RecordAudit(h.entry(c, action, item.ID, before[item.ID]))
+		}
+	}
+
+	return nil
+}
+
+// entry creates the audit entry of a target of the request, its state after the handler is captured here.
+func (h *auditHandler) entry(c *core.Ctx, action string, targetID int, before map[string]any) dto.AuditEntry {
+	entry := dto.AuditEntry{
+		Action:     action,
+		TargetType: h.targetType,
+		TargetID:   targetID,
+		Before:     before,
+		After:      services.AuditSnapshot(h.targetType, targetID),
+		Method:     string(c.Root().Method()),
+		Path:       string(c.Root().Path()),
+		StatusCode: c.Root().Response.StatusCode(),
+		IP:         http.ClientIP(c), // Not forgeable with X-Forwarded-For unless sent by a trusted proxy
+		UserAgent:  string(c.Root().UserAgent()),
+	}
+
+	if user, ok := c.GetData(constants.User).(models.User); ok {
+		entry.ActorID = user.ID
+		entry.ActorEmail = user.Email
+	}
+
+	return entry
+}
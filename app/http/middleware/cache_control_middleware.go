@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/utils"
+)
+
+// cacheControlDefaults default `Cache-Control` policies of route groups.
+var cacheControlDefaults = map[string]string{
+	"articles": "public, max-age=60, stale-while-revalidate=300",
+	"feeds":    "public, max-age=300",
+	"sitemaps": "public, max-age=3600",
+	"admin":    "private, no-store",
+}
+
+// CacheControl is a middleware that sets the `Cache-Control` policy of a route group so that
+// browsers and the CDN can cache responses. The policy is read from `CACHE_CONTROL_<GROUP>`
+// (e.g. CACHE_CONTROL_ARTICLES). An empty policy doesn't set the header.
+//
+// Parameters:
+//   - group (string): The route group name (articles, feeds, sitemaps, admin).
+//
+// Returns:
+//   - core.MiddlewareHandler: A middleware handler function.
+func CacheControl(group string) core.MiddlewareHandler {
+	policy := utils.Getenv("CACHE_CONTROL_"+strings.ToUpper(group), cacheControlDefaults[group])
+
+	return func(c *core.Ctx) error {
+		if policy != "" {
+			c.SetHeader(core.HeaderCacheControl, policy)
+		}
+
+		return nil
+	}
+}
@@ -51,3 +51,63 @@ func (r UpdateArticleStatus) SetID(id int) {
 func (r UpdateArticleStatus) ToDto() dto.UpdateArticleStatus {
 	return r.UpdateArticleStatus
 }
+
+// ---------------------- Save Article Translation ------------------------
+
+// SaveArticleTranslation struct to describe the translation of an article in a locale
+type SaveArticleTranslation struct {
+	dto.SaveArticleTranslation
+}
+
+// ToDto convert struct to SaveArticleTranslation DTO object
+func (r SaveArticleTranslation) ToDto() dto.SaveArticleTranslation {
+	return r.SaveArticleTranslation
+}
+
+// ---------------------- Confirm Age ------------------------
+
+// ConfirmAge struct to describe the birthdate confirmed by a reader
+type ConfirmAge struct {
+	dto.ConfirmAge
+}
+
+// ToDto convert struct to ConfirmAge DTO object
+func (r ConfirmAge) ToDto() dto.ConfirmAge {
+	return r.ConfirmAge
+}
+
+// ---------------------- Reading Progress ------------------------
+
+// ReadingProgress struct to describe the scroll depth reported by a reader
+type ReadingProgress struct {
+	dto.ReadingProgress
+}
+
+// ToDto convert struct to ReadingProgress DTO object
+func (r ReadingProgress) ToDto() dto.ReadingProgress {
+	return r.ReadingProgress
+}
+
+// ---------------------- Save Article Variants ------------------------
+
+// SaveArticleVariants struct to describe the alternatives of an A/B test
+type SaveArticleVariants struct {
+	dto.SaveArticleVariants
+}
+
+// ToDto convert struct to SaveArticleVariants DTO object
+func (r SaveArticleVariants) ToDto() dto.SaveArticleVariants {
+	return r.SaveArticleVariants
+}
+
+// ---------------------- Bulk Articles ------------------------
+
+// BulkArticles struct to describe an action applied to several articles
+type BulkArticles struct {
+	dto.BulkArticles
+}
+
+// ToDto convert struct to BulkArticles DTO object
+func (r BulkArticles) ToDto() dto.BulkArticles {
+	return r.BulkArticles
+}
@@ -0,0 +1,18 @@
+package request
+
+import "gfly/app/dto"
+
+// ====================================================================
+// ========================== Add Requests ============================
+// ====================================================================
+
+// ---------------------- Create Export ------------------------
+
+type CreateExport struct {
+	dto.CreateExport
+}
+
+// ToDto Convert to CreateExport DTO object.
+func (r CreateExport) ToDto() dto.CreateExport {
+	return r.CreateExport
+}
@@ -0,0 +1,44 @@
+package request
+
+import "gfly/app/dto"
+
+// ====================================================================
+// ========================== Add Requests ============================
+// ====================================================================
+
+// ---------------------- Create Category ------------------------
+
+type CreateCategory struct {
+	dto.CreateCategory
+}
+
+// ToDto Convert to CreateCategory DTO object.
+func (r CreateCategory) ToDto() dto.CreateCategory {
+	return r.CreateCategory
+}
+
+// ---------------------- Follow ------------------------
+
+type Follow struct {
+	dto.Follow
+}
+
+// ToDto Convert to Follow DTO object.
+func (r Follow) ToDto() dto.Follow {
+	return r.Follow
+}
+
+// ====================================================================
+// ========================= Update Requests ==========================
+// ====================================================================
+
+// ---------------------- Notification Preferences ------------------------
+
+type NotificationPreferences struct {
+	dto.NotificationPreferences
+}
+
+// ToDto Convert to NotificationPreferences DTO object.
+func (r NotificationPreferences) ToDto() dto.NotificationPreferences {
+	return r.NotificationPreferences
+}
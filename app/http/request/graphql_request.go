@@ -0,0 +1,18 @@
+package request
+
+import "gfly/app/dto"
+
+// ====================================================================
+// ========================= Query Requests ===========================
+// ====================================================================
+
+// ---------------------- GraphQL Query ------------------------
+
+type GraphQLQuery struct {
+	dto.GraphQLQuery
+}
+
+// ToDto Convert to GraphQLQuery DTO object.
+func (r GraphQLQuery) ToDto() dto.GraphQLQuery {
+	return r.GraphQLQuery
+}
@@ -0,0 +1,18 @@
+package request
+
+import "gfly/app/dto"
+
+// ====================================================================
+// ========================== Add Requests ============================
+// ====================================================================
+
+// ---------------------- Subscribe ------------------------
+
+type Subscribe struct {
+	dto.Subscribe
+}
+
+// ToDto Convert to Subscribe DTO object.
+func (r Subscribe) ToDto() dto.Subscribe {
+	return r.Subscribe
+}
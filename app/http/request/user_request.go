@@ -51,3 +51,26 @@ func (r UpdateUserStatus) SetID(id int) {
 func (r UpdateUserStatus) ToDto() dto.UpdateUserStatus {
 	return r.UpdateUserStatus
 }
+
+// ---------------------- Grant Entitlement ------------------------
+
+type GrantEntitlement struct {
+	dto.GrantEntitlement
+}
+
+// ToDto Convert to GrantEntitlement DTO object.
+func (r GrantEntitlement) ToDto() dto.GrantEntitlement {
+	return r.GrantEntitlement
+}
+
+// ---------------------- Bulk Users ------------------------
+
+// BulkUsers struct to describe an action applied to several users
+type BulkUsers struct {
+	dto.BulkUsers
+}
+
+// ToDto convert struct to BulkUsers DTO object
+func (r BulkUsers) ToDto() dto.BulkUsers {
+	return r.BulkUsers
+}
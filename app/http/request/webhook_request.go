@@ -0,0 +1,33 @@
+package request
+
+import "gfly/app/dto"
+
+// ====================================================================
+// ========================== Add Requests ============================
+// ====================================================================
+
+// ---------------------- Create Webhook ------------------------
+
+type CreateWebhook struct {
+	dto.CreateWebhook
+}
+
+// ToDto Convert to CreateWebhook DTO object.
+func (r CreateWebhook) ToDto() dto.CreateWebhook {
+	return r.CreateWebhook
+}
+
+// ====================================================================
+// ========================= Update Requests ==========================
+// ====================================================================
+
+// ---------------------- Update Webhook ------------------------
+
+type UpdateWebhook struct {
+	dto.UpdateWebhook
+}
+
+// ToDto Convert to UpdateWebhook DTO object.
+func (r UpdateWebhook) ToDto() dto.UpdateWebhook {
+	return r.UpdateWebhook
+}
@@ -0,0 +1,31 @@
+package response
+
+import "time"
+
+// ArticleStats response structure of the analytics of an article
+type ArticleStats struct {
+	ArticleID          int          `json:"article_id" example:"1"`
+	From               string       `json:"from" example:"2024-01-01"`
+	To                 string       `json:"to" example:"2024-01-31"`
+	Granularity        string       `json:"granularity" example:"day"`
+	Views              int          `json:"views" example:"1250"`
+	UniqueVisitors     int          `json:"unique_visitors" example:"980"`
+	AuthenticatedViews int          `json:"authenticated_views" example:"310"`
+	ReadThroughRate    *float64     `json:"read_through_rate" example:"0.42"` // Null without progress data
+	Series             []StatsPoint `json:"series"`
+	TopReferrers       []StatsCount `json:"top_referrers"`
+	Devices            []StatsCount `json:"devices"`
+}
+
+// StatsPoint response structure of the views of a time bucket
+type StatsPoint struct {
+	Time           time.Time `json:"time"` // Start of the bucket (server time)
+	Views          int       `json:"views" example:"42"`
+	UniqueVisitors int       `json:"unique_visitors" example:"37"`
+}
+
+// StatsCount response structure of the views of a referrer host or device class
+type StatsCount struct {
+	Name  string `json:"name" example:"google.com"`
+	Views int    `json:"views" example:"120"`
+}
@@ -4,20 +4,90 @@ import "time"
 
 // Article response structure for API
 type Article struct {
+	ID              int         `json:"id"`
+	Title           string      `json:"title"`
+	Slug            string      `json:"slug"`
+	Excerpt         string      `json:"excerpt,omitempty"`
+	Content         string      `json:"content,omitempty"`
+	CoverImage      string      `json:"cover_image,omitempty"`
+	Status          string      `json:"status"`
+	SEODescription  string      `json:"seo_description,omitempty"`
+	SEOKeywords     string      `json:"seo_keywords,omitempty"`
+	AuthorID        int         `json:"author_id"`
+	PublishedAt     time.Time   `json:"published_at,omitempty"`
+	YouTubeURL      string      `json:"youtube_url,omitempty"`
+	TikTokURL       string      `json:"tiktok_url,omitempty"`
+	Videos          *Videos     `json:"videos,omitempty"`
+	ViewCount       int         `json:"view_count"`
+	ContentWarnings []string    `json:"content_warnings,omitempty"`
+	AgeRating       int         `json:"age_rating"`
+	AgeGated        bool        `json:"age_gated"` // Content and videos are withheld until the reader confirms their age
+	AccessLevel     string      `json:"access_level"`
+	Locked          bool        `json:"locked"`            // Content is a teaser, the full story needs a members or premium plan
+	Variant         string      `json:"variant,omitempty"` // A/B test variant of the title and cover image served to the reader
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at,omitempty"`
+	Locale          string      `json:"locale,omitempty"`
+	Alternates      []Alternate `json:"alternates,omitempty"`
+	CategoryIDs     []int       `json:"category_ids,omitempty"` // Story categories (admin API)
+}
+
+// Alternate localized version of an article (hreflang alternate)
+type Alternate struct {
+	Hreflang string `json:"hreflang" example:"en"`
+	Slug     string `json:"slug" example:"the-ghost-of-the-old-house"`
+	URL      string `json:"url" example:"https://example.com/truyen/the-ghost-of-the-old-house"`
+}
+
+// Breadcrumb entry of the breadcrumb trail of a public page
+type Breadcrumb struct {
+	Name string `json:"name" example:"Truyện ma có thật"`
+	URL  string `json:"url" example:"https://example.com/the-loai/truyen-ma-co-that"`
+}
+
+// ArticleTranslation response structure of an article translation
+type ArticleTranslation struct {
 	ID             int       `json:"id"`
+	ArticleID      int       `json:"article_id"`
+	Locale         string    `json:"locale"`
 	Title          string    `json:"title"`
 	Slug           string    `json:"slug"`
 	Excerpt        string    `json:"excerpt,omitempty"`
 	Content        string    `json:"content"`
-	CoverImage     string    `json:"cover_image,omitempty"`
-	Status         string    `json:"status"`
 	SEODescription string    `json:"seo_description,omitempty"`
 	SEOKeywords    string    `json:"seo_keywords,omitempty"`
-	AuthorID       int       `json:"author_id"`
-	PublishedAt    time.Time `json:"published_at,omitempty"`
-	YouTubeURL     string    `json:"youtube_url,omitempty"`
-	TikTokURL      string    `json:"tiktok_url,omitempty"`
-	ViewCount      int       `json:"view_count"`
 	CreatedAt      time.Time `json:"created_at"`
 	UpdatedAt      time.Time `json:"updated_at,omitempty"`
 }
+
+// Videos structured embed metadata of the videos attached to an article
+type Videos struct {
+	YouTube *Video `json:"youtube,omitempty"`
+	TikTok  *Video `json:"tiktok,omitempty"`
+}
+
+// Video embed metadata of a video link
+type Video struct {
+	Provider     string `json:"provider" example:"youtube"`
+	VideoID      string `json:"video_id,omitempty" example:"dQw4w9WgXcQ"`
+	ShortCode    string `json:"short_code,omitempty" example:"ZMeAbCdEf"`
+	CanonicalURL string `json:"canonical_url" example:"https://www.youtube.com/watch?v=dQw4w9WgXcQ"`
+	EmbedURL     string `json:"embed_url,omitempty" example:"https://www.youtube.com/embed/dQw4w9WgXcQ?start=90"`
+	StartTime    int    `json:"start_time,omitempty" example:"90"`
+}
+
+// AgeToken response structure of an age confirmation
+type AgeToken struct {
+	Token     string    `json:"token" example:"18.1767225600.5d41402abc4b2a76b9719d911017c592"` // Sent back in the `X-Age-Token` header
+	AgeRating int       `json:"age_rating" example:"18"`                                        // Highest age rating the reader may open
+	ExpiresAt time.Time `json:"expires_at"`                                                     // Expiry of the token
+}
+
+// Entitlement response structure of the plan of a user
+type Entitlement struct {
+	UserID    int        `json:"user_id" example:"1"`
+	Plan      string     `json:"plan" example:"premium"`
+	ExpiresAt *time.Time `json:"expires_at" example:"2026-12-31T23:59:59Z"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,35 @@
+package response
+
+import (
+	"encoding/json"
+	"gfly/app/dto"
+	"time"
+)
+
+// AuditLog response structure of an entry of the audit log
+type AuditLog struct {
+	ID         int             `json:"id" example:"1"`
+	Actor      AuditActor      `json:"actor"`
+	Action     string          `json:"action" example:"user.status"`
+	TargetType string          `json:"target_type" example:"user"`
+	TargetID   *int32          `json:"target_id" example:"12"`
+	Changes    json.RawMessage `json:"changes" swaggertype:"object"` // {"<field>": {"before": ..., "after": ...}}, null when nothing changed
+	Method     string          `json:"method" example:"PUT"`
+	Path       string          `json:"path" example:"/api/v1/admin/users/12/status"`
+	StatusCode int             `json:"status_code" example:"200"`
+	IP         string          `json:"ip" example:"203.0.113.7"` // Client IP, forwarded headers are only honored from TRUSTED_PROXIES
+	UserAgent  string          `json:"user_agent,omitempty" example:"Mozilla/5.0"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// AuditActor administrator of an entry of the audit log
+type AuditActor struct {
+	ID    int    `json:"id" example:"1"`
+	Email string `json:"email" example:"admin@example.com"`
+}
+
+// ListAuditLog response structure of a page of the audit log
+type ListAuditLog struct {
+	Meta dto.Meta   `json:"meta"`
+	Data []AuditLog `json:"data"`
+}
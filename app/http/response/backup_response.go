@@ -0,0 +1,12 @@
+package response
+
+import "gfly/app/dto"
+
+// ExportQueued struct to describe a queued content export.
+// @Description Queued content export response
+// @Tags Backups
+type ExportQueued struct {
+	Format dto.BackupFormat `json:"format" example:"zip"`           // Export format
+	Email  string           `json:"email" example:"admin@gfly.dev"` // Recipient of the download link
+	Status string           `json:"status" example:"queued"`        // Export status
+}
@@ -0,0 +1,26 @@
+package response
+
+import "time"
+
+// ArticleExperiment response structure of the A/B test of an article
+type ArticleExperiment struct {
+	ArticleID          int              `json:"article_id" example:"1"`
+	Variants           []ArticleVariant `json:"variants"`                           // Control first
+	Leader             string           `json:"leader,omitempty" example:"b"`       // Variant with the best click-through rate
+	Significant        bool             `json:"significant"`                        // The leader can be declared the winner
+	RequiredConfidence float64          `json:"required_confidence" example:"0.95"` // Confidence needed to declare a winner
+}
+
+// ArticleVariant response structure of an A/B test variant and its results
+type ArticleVariant struct {
+	Key         string    `json:"key" example:"b"`
+	Control     bool      `json:"control"` // The article as is
+	Title       string    `json:"title,omitempty" example:"The House That Breathes at Night"`
+	CoverImage  string    `json:"cover_image,omitempty" example:"https://example.com/images/cover-b.jpg"`
+	Impressions int       `json:"impressions" example:"5400"`
+	Clicks      int       `json:"clicks" example:"324"`
+	CTR         float64   `json:"ctr" example:"0.06"`
+	Lift        *float64  `json:"lift" example:"0.2"`        // Relative CTR change against the control
+	Confidence  *float64  `json:"confidence" example:"0.97"` // Confidence that the CTR differs from the control
+	CreatedAt   time.Time `json:"created_at"`
+}
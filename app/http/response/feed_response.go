@@ -0,0 +1,102 @@
+package response
+
+import "encoding/xml"
+
+// ====================================================================
+// =============================== RSS ================================
+// ====================================================================
+
+// RSS struct to describe an RSS 2.0 document.
+type RSS struct {
+	XMLName      xml.Name   `xml:"rss"`
+	Version      string     `xml:"version,attr"`
+	XmlnsAtom    string     `xml:"xmlns:atom,attr"`
+	XmlnsContent string     `xml:"xmlns:content,attr"`
+	Channel      RSSChannel `xml:"channel"`
+}
+
+// RSSChannel struct to describe the channel of an RSS document.
+type RSSChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language,omitempty"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	AtomLink      FeedLink  `xml:"atom:link"`
+	Items         []RSSItem `xml:"item"`
+}
+
+// RSSItem struct to describe a story inside an RSS channel.
+type RSSItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	GUID        RSSGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate,omitempty"`
+	Description CDATA         `xml:"description"`
+	Content     *CDATA        `xml:"content:encoded,omitempty"`
+	Enclosure   *RSSEnclosure `xml:"enclosure,omitempty"`
+}
+
+// RSSGUID struct to describe the unique identifier of an RSS item.
+type RSSGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSSEnclosure struct to describe media attached to an RSS item.
+type RSSEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Type   string `xml:"type,attr"`
+	Length int64  `xml:"length,attr"`
+}
+
+// CDATA wraps HTML content so that it is emitted as a CDATA section.
+type CDATA struct {
+	Value string `xml:",cdata"`
+}
+
+// ====================================================================
+// =============================== Atom ===============================
+// ====================================================================
+
+// AtomFeed struct to describe an Atom 1.0 document.
+type AtomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []FeedLink  `xml:"link"`
+	Author   AtomPerson  `xml:"author"`
+	Entries  []AtomEntry `xml:"entry"`
+}
+
+// AtomEntry struct to describe a story inside an Atom feed.
+type AtomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Links     []FeedLink `xml:"link"`
+	Published string     `xml:"published,omitempty"`
+	Updated   string     `xml:"updated"`
+	Summary   *AtomText  `xml:"summary,omitempty"`
+	Content   *AtomText  `xml:"content,omitempty"`
+}
+
+// AtomPerson struct to describe the author of an Atom feed.
+type AtomPerson struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+// AtomText struct to describe a text construct of an Atom entry.
+type AtomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// FeedLink struct to describe a link element shared by RSS (atom:link) and Atom.
+type FeedLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
@@ -0,0 +1,52 @@
+package response
+
+import (
+	"gfly/app/dto"
+	"time"
+)
+
+// Category response structure of a story category
+type Category struct {
+	ID   int    `json:"id" example:"1"`
+	Name string `json:"name" example:"Truyện ma có thật"`
+	Slug string `json:"slug" example:"truyen-ma-co-that"`
+}
+
+// Follow response structure of a category or an author followed by the current user
+type Follow struct {
+	Type      string    `json:"type" example:"category"` // category or author
+	ID        int       `json:"id" example:"1"`          // ID of the category or of the author
+	Name      string    `json:"name" example:"Truyện ma có thật"`
+	Slug      string    `json:"slug,omitempty" example:"truyen-ma-co-that"` // Categories only
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NotificationPreferences response structure of the notification channels of the current user
+type NotificationPreferences struct {
+	Email bool `json:"email" example:"true"`
+	InApp bool `json:"in_app" example:"true"`
+}
+
+// Notification response structure of an in-app notification
+type Notification struct {
+	ID        int                 `json:"id" example:"1"`
+	Type      string              `json:"type" example:"new_story"`
+	Article   NotificationArticle `json:"article"`
+	Read      bool                `json:"read"`
+	CreatedAt time.Time           `json:"created_at"`
+}
+
+// NotificationArticle story of a notification
+type NotificationArticle struct {
+	ID         int    `json:"id" example:"1"`
+	Title      string `json:"title" example:"The Ghost of the Old House"`
+	Slug       string `json:"slug" example:"the-ghost-of-the-old-house"`
+	CoverImage string `json:"cover_image,omitempty" example:"https://example.com/images/cover.jpg"`
+	URL        string `json:"url" example:"https://example.com/truyen/the-ghost-of-the-old-house"`
+}
+
+// ListNotification response structure of a page of notifications
+type ListNotification struct {
+	Meta dto.Meta       `json:"meta"`
+	Data []Notification `json:"data"`
+}
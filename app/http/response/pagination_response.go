@@ -2,11 +2,13 @@ package response
 
 // Pagination holds metadata about the pagination state
 type Pagination struct {
-	CurrentPage int `json:"current_page"`
-	PerPage     int `json:"per_page"`
-	Total       int `json:"total"`
-	TotalPages  int `json:"total_pages"`
-	HasMore     bool `json:"has_more"`
+	CurrentPage int    `json:"current_page"`
+	PerPage     int    `json:"per_page"`
+	Total       int    `json:"total"`
+	TotalPages  int    `json:"total_pages"`
+	HasMore     bool   `json:"has_more"`
+	NextCursor  string `json:"next_cursor,omitempty"` // Cursor of the next page (cursor mode)
+	PrevCursor  string `json:"prev_cursor,omitempty"` // Cursor of the previous page (cursor mode)
 }
 
 // PaginatedResponse is a generic response structure for paginated data
@@ -22,6 +22,7 @@ type User struct {
 	DeletedAt    interface{}      `json:"deleted_at" doc:"The timestamp of when the user was deleted."`
 	LastAccessAt interface{}      `json:"last_access_at" doc:"The timestamp of the user's last access."`
 	Avatar       *string          `json:"avatar" doc:"The URL of the user's avatar or profile picture."`
+	Birthdate    string           `json:"birthdate,omitempty" doc:"The birthdate confirmed by the user (YYYY-MM-DD)."`
 	Roles        []Role           `json:"roles" doc:"A list of roles assigned to the user."`
 }
 
@@ -0,0 +1,40 @@
+package response
+
+import (
+	"gfly/app/dto"
+	"time"
+)
+
+// Webhook response structure of a webhook subscription
+type Webhook struct {
+	ID          int        `json:"id" example:"1"`
+	URL         string     `json:"url" example:"https://bot.example.com/hooks/stories"`
+	Secret      string     `json:"secret,omitempty" example:"whsec_9f8e7d6c5b4a39281706f5e4d3c2b1a0"` // Signs the X-Webhook-Signature header, only returned on creation
+	Events      []string   `json:"events" example:"article.published,article.deleted"`
+	Description string     `json:"description,omitempty" example:"Discord bot"`
+	Active      bool       `json:"active" example:"true"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+// WebhookDelivery response structure of a delivery of an event to a webhook
+type WebhookDelivery struct {
+	ID            int        `json:"id" example:"12"`
+	WebhookID     int        `json:"webhook_id" example:"1"`
+	Event         string     `json:"event" example:"article.published"`
+	Payload       string     `json:"payload" example:"{\"event\":\"article.published\",\"created_at\":\"2025-01-01T08:00:00Z\",\"data\":{\"id\":7}}"`
+	Status        string     `json:"status" example:"retrying"` // pending, delivered, retrying or failed
+	Attempts      int        `json:"attempts" example:"2"`
+	ResponseCode  *int32     `json:"response_code" example:"503"` // Of the last attempt
+	ResponseBody  string     `json:"response_body,omitempty" example:"Service Unavailable"`
+	Error         string     `json:"error,omitempty" example:"dial tcp: connection refused"`
+	NextAttemptAt *time.Time `json:"next_attempt_at"`
+	DeliveredAt   *time.Time `json:"delivered_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// ListWebhookDelivery response structure of a page of webhook deliveries
+type ListWebhookDelivery struct {
+	Meta dto.Meta          `json:"meta"`
+	Data []WebhookDelivery `json:"data"`
+}
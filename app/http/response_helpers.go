@@ -0,0 +1,141 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"gfly/app/dto"
+	"gfly/app/services"
+	netHttp "net/http"
+	"time"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+)
+
+// ====================================================================
+// ===================== Conditional Response Helpers =================
+// ====================================================================
+
+// ETag builds a strong entity tag from a response body.
+//
+// Parameters:
+//   - body: The response body to fingerprint
+//
+// Returns:
+//   - string: Quoted entity tag (e.g. "\"9f86d08...\"")
+func ETag(body string) string {
+	return fmt.Sprintf("%q", utils.Sha256(body))
+}
+
+// NotModified writes the `ETag` and `Last-Modified` validators to the response and evaluates
+// the `If-None-Match`/`If-Modified-Since` request headers against them. When the client copy
+// is still fresh the status is set to 304 and the caller must stop without writing a body.
+//
+// Parameters:
+//   - c: The context object containing the HTTP request/response data
+//   - etag: The entity tag of the current representation (empty to skip)
+//   - lastModified: The modification time of the current representation (zero to skip)
+//
+// Returns:
+//   - bool: True if the response was turned into `304 Not Modified`
+//
+// Example Usage:
+//
+//	if http.NotModified(c, feed.ETag, feed.LastModified) {
+//		return nil
+//	}
+func NotModified(c *core.Ctx, etag string, lastModified time.Time) bool {
+	if etag != "" {
+		c.SetHeader(core.HeaderETag, etag)
+	}
+
+	if !lastModified.IsZero() {
+		c.SetHeader(core.HeaderLastModified, lastModified.UTC().Format(netHttp.TimeFormat))
+	}
+
+	request := &c.Root().Request.Header
+	notModified := services.IsNotModified(
+		string(request.Peek(core.HeaderIfNoneMatch)), string(request.Peek(core.HeaderIfModifiedSince)), etag, lastModified)
+
+	if notModified {
+		c.Status(core.StatusNotModified)
+	}
+
+	return notModified
+}
+
+// ConditionalSuccess sends a JSON success response validated by an `ETag` (hash of the JSON body)
+// and a `Last-Modified` header. The body isn't sent when the client copy is still fresh.
+//
+// Parameters:
+//   - c: The context object containing the HTTP request/response data
+//   - data: The response data
+//   - lastModified: The modification time of the response data (zero to skip)
+//
+// Returns:
+//   - error: Any error encountered while writing the response
+func ConditionalSuccess(c *core.Ctx, data any, lastModified time.Time) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return c.Success(data)
+	}
+
+	return ConditionalJSON(c, body, lastModified)
+}
+
+// ConditionalJSON sends a serialized JSON body like ConditionalSuccess.
+//
+// Parameters:
+//   - c: The context object containing the HTTP request/response data
+//   - body: The serialized JSON response
+//   - lastModified: The modification time of the response data (zero to skip)
+//
+// Returns:
+//   - error: Any error encountered while writing the response
+func ConditionalJSON(c *core.Ctx, body []byte, lastModified time.Time) error {
+	if NotModified(c, ETag(string(body)), lastModified) {
+		return nil
+	}
+
+	return c.Status(core.StatusOK).
+		SetHeader(core.HeaderContentType, core.MIMEApplicationJSONCharsetUTF8).
+		Raw(body)
+}
+
+// CachedSuccess sends a response like ConditionalSuccess and stores it in the response cache
+// under its dependency tags, so that the next requests of the same route and query skip the database.
+//
+// Parameters:
+//   - c: The context object containing the HTTP request/response data
+//   - key: The cache key built by ResponseCacheKey
+//   - data: The response data
+//   - lastModified: The modification time of the response data (zero to skip)
+//   - tags: The dependency tags (see services.TagArticleList, services.ArticleTag)
+//
+// Returns:
+//   - error: Any error encountered while writing the response
+//
+// Example Usage:
+//
+//	cacheKey := http.ResponseCacheKey(c)
+//	if cached, ok := services.GetCachedResponse(cacheKey); ok {
+//		return http.ConditionalJSON(c, []byte(cached.Body), cached.LastModified)
+//	}
+//	...
+//	return http.CachedSuccess(c, cacheKey, data, lastModified, services.TagArticleList)
+func CachedSuccess(c *core.Ctx, key string, data any, lastModified time.Time, tags ...string) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return c.Success(data)
+	}
+
+	if err = services.CacheResponse(key, dto.CachedResponse{
+		Body:         string(body),
+		LastModified: lastModified,
+	}, tags...); err != nil {
+		log.Warnf("Failed to cache response `%s`: %v", key, err)
+	}
+
+	return ConditionalJSON(c, body, lastModified)
+}
@@ -5,9 +5,20 @@ import (
 	"gfly/app/domain/models/types"
 	"gfly/app/http/controllers/api"
 	adminArticle "gfly/app/http/controllers/api/admin/article"
+	"gfly/app/http/controllers/api/admin/audit"
+	adminCategory "gfly/app/http/controllers/api/admin/category"
+	adminWebhook "gfly/app/http/controllers/api/admin/webhook"
 	"gfly/app/http/controllers/api/article"
+	"gfly/app/http/controllers/api/backup"
+	"gfly/app/http/controllers/api/category"
+	"gfly/app/http/controllers/api/follow"
+	"gfly/app/http/controllers/api/graphql"
+	"gfly/app/http/controllers/api/newsletter"
+	"gfly/app/http/controllers/api/notification"
 	"gfly/app/http/controllers/api/user"
+	"gfly/app/http/controllers/page/graphiql"
 	"gfly/app/http/middleware"
+	authMiddleware "gfly/app/modules/auth/middleware"
 	authRoute "gfly/app/modules/auth/routes"
 
 	"github.com/gflydev/core"
@@ -30,14 +41,48 @@ func ApiRoutes(r core.IFly) {
 		/* ===================== Public Routes ===================== */
 		// These routes are accessible without authentication
 		apiRouter.Group("articles", func(publicRouter *core.Group) {
+			publicRouter.Use(middleware.CacheControl("articles"))
+			// Identify signed-in readers (birthdate of age-gated stories) without requiring a token
+			publicRouter.Use(authMiddleware.OptionalJWTAuth())
+
 			publicRouter.GET("", article.NewListArticlesApi())
+			publicRouter.GET("/trending", article.NewListTrendingArticlesApi())
+			publicRouter.POST("/age-confirmation", article.NewConfirmAgeApi())
 			publicRouter.GET("/{slug:[a-z0-9-]+}", article.NewGetArticleBySlugApi())
+			publicRouter.POST("/{slug:[a-z0-9-]+}/progress", article.NewReportProgressApi())
+		})
+
+		apiRouter.GET("/categories", r.Apply(middleware.CacheControl("articles"))(category.NewListCategoriesApi()))
+
+		// Read-only GraphQL catalogue (articles, authors, categories), with the GraphiQL IDE outside production
+		apiRouter.POST("/graphql", graphql.NewGraphQLApi())
+		if core.AppEnv != "prod" {
+			apiRouter.GET("/graphql", graphiql.NewGraphiQLPage())
+		}
+
+		// Weekly digest subscriptions (with or without an account)
+		apiRouter.Group("/newsletter", func(newsletterRouter *core.Group) {
+			newsletterRouter.Use(authMiddleware.OptionalJWTAuth())
+
+			newsletterRouter.POST("/subscriptions", newsletter.NewSubscribeApi())
 		})
 
 		/* ==================== Authentication ==================== */
 		// Handles user authentication and authorization
 		authRoute.RegisterApi(apiRouter)
 
+		/* ==================== Current User ====================== */
+		// Follows and notifications of the signed-in user
+		apiRouter.Group("/me", func(meRouter *core.Group) {
+			meRouter.GET("/follows", follow.NewListFollowsApi())
+			meRouter.POST("/follows", follow.NewFollowApi())
+			meRouter.DELETE("/follows/{type}/{id}", follow.NewUnfollowApi())
+			meRouter.GET("/notifications", notification.NewListNotificationsApi())
+			meRouter.PUT("/notifications/read", notification.NewMarkNotificationsReadApi())
+			meRouter.GET("/notification-preferences", notification.NewGetNotificationPreferencesApi())
+			meRouter.PUT("/notification-preferences", notification.NewUpdateNotificationPreferencesApi())
+		})
+
 		/* ==================== Admin Routes ====================== */
 		// These routes require admin privileges
 		apiRouter.Group("/admin", func(adminRouter *core.Group) {
@@ -45,6 +90,10 @@ func ApiRoutes(r core.IFly) {
 				[]types.Role{types.RoleAdmin},
 				prefixAPI+"/admin",
 			))
+			adminRouter.Use(middleware.CacheControl("admin"))
+
+			// Mutations are recorded in the audit log with middleware.Audit
+			adminRouter.GET("/audit-logs", audit.NewListAuditLogsApi())
 
 			adminRouter.Group("/users", func(userRouter *core.Group) {
 				// Allow admin permission to access `/users/*` API
@@ -54,11 +103,14 @@ func ApiRoutes(r core.IFly) {
 				))
 
 				userRouter.GET("", user.NewListUsersApi())
-				userRouter.POST("", user.NewCreateUserApi())
-				userRouter.PUT("/{id}/status", r.Apply(middleware.PreventUpdateYourSelf)(user.NewUpdateUserStatusApi()))
-				userRouter.PUT("/{id}", r.Apply(middleware.PreventUpdateYourSelf)(user.NewUpdateUserApi()))
-				userRouter.DELETE("/{id}", r.Apply(middleware.PreventUpdateYourSelf)(user.NewDeleteUserApi()))
+				userRouter.POST("", middleware.Audit("user.create")(user.NewCreateUserApi()))
+				userRouter.POST("/bulk", middleware.Audit("user.bulk")(user.NewBulkUsersApi()))
+				userRouter.PUT("/{id}/status", middleware.Audit("user.status")(r.Apply(middleware.PreventUpdateYourSelf)(user.NewUpdateUserStatusApi())))
+				userRouter.PUT("/{id}", middleware.Audit("user.update")(r.Apply(middleware.PreventUpdateYourSelf)(user.NewUpdateUserApi())))
+				userRouter.DELETE("/{id}", middleware.Audit("user.delete")(r.Apply(middleware.PreventUpdateYourSelf)(user.NewDeleteUserApi())))
 				userRouter.GET("/{id}", user.NewGetUserByIdApi())
+				userRouter.PUT("/{id}/entitlement", middleware.Audit("user.grant_entitlement")(user.NewGrantEntitlementApi()))
+				userRouter.DELETE("/{id}/entitlement", middleware.Audit("user.revoke_entitlement")(user.NewRevokeEntitlementApi()))
 				userRouter.GET("/profile", user.NewGetUserProfileApi())
 			})
 
@@ -71,12 +123,43 @@ func ApiRoutes(r core.IFly) {
 					prefixAPI+"/articles",
 				))
 
-				articleRouter.POST("", adminArticle.NewCreateArticleApi())
+				articleRouter.POST("", middleware.Audit("article.create")(adminArticle.NewCreateArticleApi()))
 				articleRouter.GET("", adminArticle.NewListArticlesApi())
+				articleRouter.POST("/bulk", middleware.Audit("article.bulk")(adminArticle.NewBulkArticlesApi()))
+				articleRouter.POST("/import", middleware.Audit("article.import")(adminArticle.NewImportArticlesApi()))
+				articleRouter.GET("/imports/{id}", adminArticle.NewGetImportApi())
 				articleRouter.GET("/{id}", adminArticle.NewGetArticleByIdApi())
-				articleRouter.PUT("/{id}", adminArticle.NewUpdateArticleApi())
-				articleRouter.PUT("/{id}/status", adminArticle.NewUpdateArticleStatusApi())
-				articleRouter.DELETE("/{id}", adminArticle.NewDeleteArticleApi())
+				articleRouter.PUT("/{id}", middleware.Audit("article.update")(adminArticle.NewUpdateArticleApi()))
+				articleRouter.PUT("/{id}/status", middleware.Audit("article.status")(adminArticle.NewUpdateArticleStatusApi()))
+				articleRouter.DELETE("/{id}", middleware.Audit("article.delete")(adminArticle.NewDeleteArticleApi()))
+				articleRouter.GET("/{id}/stats", adminArticle.NewGetArticleStatsApi())
+				articleRouter.GET("/{id}/variants", adminArticle.NewGetArticleExperimentApi())
+				articleRouter.PUT("/{id}/variants", middleware.Audit("article.save_variants")(adminArticle.NewSaveArticleVariantsApi()))
+				articleRouter.DELETE("/{id}/variants", middleware.Audit("article.delete_variants")(adminArticle.NewDeleteArticleVariantsApi()))
+				articleRouter.POST("/{id}/variants/{key}/winner", middleware.Audit("article.declare_winner")(adminArticle.NewDeclareVariantWinnerApi()))
+				articleRouter.GET("/{id}/translations", adminArticle.NewListArticleTranslationsApi())
+				articleRouter.PUT("/{id}/translations/{locale}", middleware.Audit("article.save_translation")(adminArticle.NewSaveArticleTranslationApi()))
+				articleRouter.DELETE("/{id}/translations/{locale}", middleware.Audit("article.delete_translation")(adminArticle.NewDeleteArticleTranslationApi()))
+			})
+
+			/* ==================== Category Management ================= */
+			adminRouter.POST("/categories", middleware.Audit("category.create")(adminCategory.NewCreateCategoryApi()))
+			adminRouter.DELETE("/categories/{id}", middleware.Audit("category.delete")(adminCategory.NewDeleteCategoryApi()))
+
+			/* ==================== Content Exports ===================== */
+			// Full content export for backups and partners (admin-only)
+			adminRouter.POST("/exports", middleware.Audit("export.create")(backup.NewCreateExportApi()))
+
+			/* ==================== Webhooks ============================ */
+			// Signed outbound webhooks for content lifecycle events (admin-only)
+			adminRouter.Group("/webhooks", func(webhookRouter *core.Group) {
+				webhookRouter.GET("", adminWebhook.NewListWebhooksApi())
+				webhookRouter.POST("", middleware.Audit("webhook.create")(adminWebhook.NewCreateWebhookApi()))
+				webhookRouter.GET("/{id}", adminWebhook.NewGetWebhookApi())
+				webhookRouter.PUT("/{id}", middleware.Audit("webhook.update")(adminWebhook.NewUpdateWebhookApi()))
+				webhookRouter.DELETE("/{id}", middleware.Audit("webhook.delete")(adminWebhook.NewDeleteWebhookApi()))
+				webhookRouter.GET("/{id}/deliveries", adminWebhook.NewListWebhookDeliveriesApi())
+				webhookRouter.POST("/{id}/deliveries/{delivery_id}/redeliver", middleware.Audit("webhook.redeliver")(adminWebhook.NewRedeliverWebhookApi()))
 			})
 		})
 	})
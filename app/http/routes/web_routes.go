@@ -1,15 +1,53 @@
 package routes
 
 import (
+	"gfly/app/dto"
+	"gfly/app/http/controllers/api/backup"
+	"gfly/app/http/controllers/api/feed"
+	"gfly/app/http/controllers/api/sitemap"
 	"gfly/app/http/controllers/page"
+	"gfly/app/http/controllers/page/article"
 	"gfly/app/http/controllers/page/auth"
+	"gfly/app/http/controllers/page/category"
+	"gfly/app/http/controllers/page/newsletter"
 	"gfly/app/http/controllers/page/user"
+	cacheMiddleware "gfly/app/http/middleware"
 	"gfly/app/modules/auth/middleware"
 	"github.com/gflydev/core"
 )
 
 // WebRoutes func for describe a group of Web page routes.
 func WebRoutes(r core.IFly) {
+	// Sitemaps (Registered before session middleware so that crawlers don't need to log in)
+	r.GET("/sitemap.xml", r.Apply(cacheMiddleware.CacheControl("sitemaps"))(sitemap.NewGetSitemapApi()))
+	r.GET("/sitemaps/{name}", r.Apply(cacheMiddleware.CacheControl("sitemaps"))(sitemap.NewGetSitemapApi()))
+
+	// Public feeds (Registered before session middleware so that feed readers don't need to log in)
+	r.Group("/feeds", func(feedRouter *core.Group) {
+		feedRouter.Use(cacheMiddleware.CacheControl("feeds"))
+
+		feedRouter.GET("/articles.rss", feed.NewArticleFeedApi(dto.FeedFormatRSS))
+		feedRouter.GET("/articles.atom", feed.NewArticleFeedApi(dto.FeedFormatAtom))
+		feedRouter.GET("/authors/{id}/articles.rss", feed.NewArticleFeedApi(dto.FeedFormatRSS))
+		feedRouter.GET("/authors/{id}/articles.atom", feed.NewArticleFeedApi(dto.FeedFormatAtom))
+		feedRouter.GET("/categories/{slug}/articles.rss", feed.NewArticleFeedApi(dto.FeedFormatRSS))
+		feedRouter.GET("/categories/{slug}/articles.atom", feed.NewArticleFeedApi(dto.FeedFormatAtom))
+	})
+
+	// Content exports (Signed links emailed by `content:export`)
+	r.GET("/exports/{name}", backup.NewDownloadExportApi())
+
+	// Public story pages (Registered before session middleware so that crawlers don't need to log in,
+	// the session of a signed-in reader is still read to unlock members-only stories)
+	r.GET("/truyen/{slug}", r.Apply(middleware.SessionManipulation)(article.NewDetailPage()))
+	r.GET("/the-loai/{slug}", r.Apply(middleware.SessionManipulation)(category.NewDetailPage()))
+
+	// Newsletter links of the confirmation and digest emails (GET asks to confirm, POST unsubscribes: the confirm
+	// form and the one-click unsubscribe of mail clients)
+	r.GET("/newsletter/confirm", newsletter.NewConfirmPage())
+	r.GET("/newsletter/unsubscribe", newsletter.NewUnsubscribePage())
+	r.POST("/newsletter/unsubscribe", newsletter.NewUnsubscribePage())
+
 	r.Use(middleware.SessionAuth(
 		"/",
 		"/login",
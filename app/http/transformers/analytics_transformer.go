@@ -0,0 +1,46 @@
+package transformers
+
+import (
+	"gfly/app/dto"
+	"gfly/app/http/response"
+	"time"
+)
+
+// ToArticleStatsResponse transforms the analytics of an article to an ArticleStats response
+func ToArticleStatsResponse(stats dto.ArticleStats) response.ArticleStats {
+	series := make([]response.StatsPoint, len(stats.Series))
+	for i, point := range stats.Series {
+		series[i] = response.StatsPoint{
+			Time:           point.Time,
+			Views:          point.Views,
+			UniqueVisitors: point.UniqueVisitors,
+		}
+	}
+
+	return response.ArticleStats{
+		ArticleID:          stats.ArticleID,
+		From:               stats.From.Format(time.DateOnly),
+		To:                 stats.To.AddDate(0, 0, -1).Format(time.DateOnly),
+		Granularity:        stats.Granularity,
+		Views:              stats.Views,
+		UniqueVisitors:     stats.UniqueVisitors,
+		AuthenticatedViews: stats.AuthenticatedViews,
+		ReadThroughRate:    stats.ReadThroughRate,
+		Series:             series,
+		TopReferrers:       toStatsCountsResponse(stats.TopReferrers),
+		Devices:            toStatsCountsResponse(stats.Devices),
+	}
+}
+
+// toStatsCountsResponse transforms view counts to StatsCount responses
+func toStatsCountsResponse(counts []dto.StatsCount) []response.StatsCount {
+	result := make([]response.StatsCount, len(counts))
+	for i, count := range counts {
+		result[i] = response.StatsCount{
+			Name:  count.Name,
+			Views: count.Views,
+		}
+	}
+
+	return result
+}
@@ -2,28 +2,36 @@ package transformers
 
 import (
 	"gfly/app/domain/models"
+	"gfly/app/dto"
 	"gfly/app/http/response"
+	"gfly/app/utils"
+
+	dbNull "github.com/gflydev/db/null"
 )
 
 // ToArticleResponse transforms an Article model to an Article response
 func ToArticleResponse(article models.Article) response.Article {
 	return response.Article{
-		ID:             article.ID,
-		Title:          article.Title,
-		Slug:           article.Slug,
-		Excerpt:        article.Excerpt.String,
-		Content:        article.Content,
-		CoverImage:     article.CoverImage.String,
-		Status:         string(article.Status),
-		SEODescription: article.SEODescription.String,
-		SEOKeywords:    article.SEOKeywords.String,
-		AuthorID:       article.AuthorID,
-		PublishedAt:    article.PublishedAt.Time,
-		YouTubeURL:     article.YouTubeURL.String,
-		TikTokURL:      article.TikTokURL.String,
-		ViewCount:      article.ViewCount,
-		CreatedAt:      article.CreatedAt,
-		UpdatedAt:      article.UpdatedAt.Time,
+		ID:              article.ID,
+		Title:           article.Title,
+		Slug:            article.Slug,
+		Excerpt:         article.Excerpt.String,
+		Content:         article.Content,
+		CoverImage:      article.CoverImage.String,
+		Status:          string(article.Status),
+		SEODescription:  article.SEODescription.String,
+		SEOKeywords:     article.SEOKeywords.String,
+		AuthorID:        article.AuthorID,
+		PublishedAt:     article.PublishedAt.Time,
+		YouTubeURL:      article.YouTubeURL.String,
+		TikTokURL:       article.TikTokURL.String,
+		Videos:          ToVideosResponse(article),
+		ViewCount:       article.ViewCount,
+		ContentWarnings: utils.SplitList(article.ContentWarnings.String),
+		AgeRating:       article.AgeRating,
+		AccessLevel:     string(article.AccessLevel),
+		CreatedAt:       article.CreatedAt,
+		UpdatedAt:       article.UpdatedAt.Time,
 	}
 }
 
@@ -39,21 +47,25 @@ func ToArticleListResponse(articles []models.Article) []response.Article {
 // ToArticleResponse transforms an Article model to an Article response
 func ToArticleForGuestResponse(article models.Article) response.Article {
 	return response.Article{
-		Title:          article.Title,
-		Slug:           article.Slug,
-		Excerpt:        article.Excerpt.String,
-		Content:        article.Content,
-		CoverImage:     article.CoverImage.String,
-		Status:         string(article.Status),
-		SEODescription: article.SEODescription.String,
-		SEOKeywords:    article.SEOKeywords.String,
-		AuthorID:       article.AuthorID,
-		PublishedAt:    article.PublishedAt.Time,
-		YouTubeURL:     article.YouTubeURL.String,
-		TikTokURL:      article.TikTokURL.String,
-		ViewCount:      article.ViewCount,
-		CreatedAt:      article.CreatedAt,
-		UpdatedAt:      article.UpdatedAt.Time,
+		Title:           article.Title,
+		Slug:            article.Slug,
+		Excerpt:         article.Excerpt.String,
+		Content:         article.Content,
+		CoverImage:      article.CoverImage.String,
+		Status:          string(article.Status),
+		SEODescription:  article.SEODescription.String,
+		SEOKeywords:     article.SEOKeywords.String,
+		AuthorID:        article.AuthorID,
+		PublishedAt:     article.PublishedAt.Time,
+		YouTubeURL:      article.YouTubeURL.String,
+		TikTokURL:       article.TikTokURL.String,
+		Videos:          ToVideosResponse(article),
+		ViewCount:       article.ViewCount,
+		ContentWarnings: utils.SplitList(article.ContentWarnings.String),
+		AgeRating:       article.AgeRating,
+		AccessLevel:     string(article.AccessLevel),
+		CreatedAt:       article.CreatedAt,
+		UpdatedAt:       article.UpdatedAt.Time,
 	}
 }
 
@@ -65,3 +77,126 @@ func ToArticleListForGuestResponse(articles []models.Article) []response.Article
 	}
 	return result
 }
+
+// ToAgeGatedResponse withholds the content and the videos of an article response from readers who
+// haven't confirmed their age. The title, excerpt, cover and content warnings are kept.
+func ToAgeGatedResponse(articleResponse response.Article) response.Article {
+	articleResponse.Content = ""
+	articleResponse.YouTubeURL = ""
+	articleResponse.TikTokURL = ""
+	articleResponse.Videos = nil
+	articleResponse.AgeGated = true
+
+	return articleResponse
+}
+
+// ToLockedResponse replaces the content of an article response by its teaser for readers
+// without the plan of the article. The videos are part of the full story.
+func ToLockedResponse(articleResponse response.Article, teaser string) response.Article {
+	articleResponse.Content = teaser
+	articleResponse.YouTubeURL = ""
+	articleResponse.TikTokURL = ""
+	articleResponse.Videos = nil
+	articleResponse.Locked = true
+
+	return articleResponse
+}
+
+// ToEntitlementResponse transforms a UserEntitlement model to an Entitlement response
+func ToEntitlementResponse(entitlement models.UserEntitlement) response.Entitlement {
+	return response.Entitlement{
+		UserID:    entitlement.UserID,
+		Plan:      string(entitlement.Plan),
+		ExpiresAt: dbNull.TimeVal(entitlement.ExpiresAt),
+		CreatedAt: entitlement.CreatedAt,
+		UpdatedAt: entitlement.UpdatedAt.Time,
+	}
+}
+
+// ToAgeTokenResponse transforms a signed age confirmation to an AgeToken response
+func ToAgeTokenResponse(ageToken dto.AgeToken) response.AgeToken {
+	return response.AgeToken{
+		Token:     ageToken.Token,
+		AgeRating: ageToken.AgeRating,
+		ExpiresAt: ageToken.ExpiresAt,
+	}
+}
+
+// ToVideosResponse parses the video links of an article into structured embed metadata.
+// Links that can't be parsed are left out.
+func ToVideosResponse(article models.Article) *response.Videos {
+	var videos response.Videos
+
+	if video, ok := utils.ParseYouTubeURL(article.YouTubeURL.String); ok {
+		videos.YouTube = toVideoResponse(*video)
+	}
+
+	if video, ok := utils.ParseTikTokURL(article.TikTokURL.String); ok {
+		videos.TikTok = toVideoResponse(*video)
+	}
+
+	if videos.YouTube == nil && videos.TikTok == nil {
+		return nil
+	}
+
+	return &videos
+}
+
+// toVideoResponse transforms a parsed video link to a Video response
+func toVideoResponse(video utils.VideoEmbed) *response.Video {
+	return &response.Video{
+		Provider:     video.Provider,
+		VideoID:      video.VideoID,
+		ShortCode:    video.ShortCode,
+		CanonicalURL: video.CanonicalURL,
+		EmbedURL:     video.EmbedURL,
+		StartTime:    video.StartTime,
+	}
+}
+
+// ToAlternatesResponse lists the localized versions of an article (hreflang alternates).
+// The base article is the `x-default` version.
+//
+// Parameters:
+//   - article: models.Article - The base article
+//   - defaultLocale: string - The locale of the base article
+//   - translations: []models.ArticleTranslation - The translations of the article
+//
+// Returns:
+//   - []response.Alternate: One alternate per locale plus `x-default`
+func ToAlternatesResponse(article models.Article, defaultLocale string, translations []models.ArticleTranslation) []response.Alternate {
+	alternates := []response.Alternate{
+		{Hreflang: defaultLocale, Slug: article.Slug, URL: ArticleURL(article.Slug)},
+	}
+
+	for _, translation := range translations {
+		alternates = append(alternates, response.Alternate{
+			Hreflang: translation.Locale,
+			Slug:     translation.Slug,
+			URL:      ArticleURL(translation.Slug),
+		})
+	}
+
+	return append(alternates, response.Alternate{
+		Hreflang: "x-default",
+		Slug:     article.Slug,
+		URL:      ArticleURL(article.Slug),
+	})
+}
+
+// ToArticleTranslationResponse transforms an ArticleTranslation model to an ArticleTranslation response
+func ToArticleTranslationResponse(translation models.ArticleTranslation) response.ArticleTranslation {
+	return response.ArticleTranslation{
+		ID:             translation.ID,
+		ArticleID:      translation.ArticleID,
+		Locale:         translation.Locale,
+		Title:          translation.Title,
+		Slug:           translation.Slug,
+		Excerpt:        translation.Excerpt.String,
+		Content:        translation.Content,
+		SEODescription: translation.SEODescription.String,
+		SEOKeywords:    translation.SEOKeywords.String,
+		CreatedAt:      translation.CreatedAt,
+		UpdatedAt:      translation.UpdatedAt.Time,
+	}
+}
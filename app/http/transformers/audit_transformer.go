@@ -0,0 +1,35 @@
+package transformers
+
+import (
+	"encoding/json"
+	"gfly/app/domain/models"
+	"gfly/app/http/response"
+
+	dbNull "github.com/gflydev/db/null"
+)
+
+// ToAuditLogResponse transforms an AuditLog model to an AuditLog response
+func ToAuditLogResponse(auditLog models.AuditLog) response.AuditLog {
+	changes := json.RawMessage("null")
+	if auditLog.Changes.Valid {
+		changes = json.RawMessage(auditLog.Changes.String)
+	}
+
+	return response.AuditLog{
+		ID: auditLog.ID,
+		Actor: response.AuditActor{
+			ID:    auditLog.ActorID,
+			Email: auditLog.ActorEmail,
+		},
+		Action:     auditLog.Action,
+		TargetType: auditLog.TargetType,
+		TargetID:   dbNull.Int32Val(auditLog.TargetID),
+		Changes:    changes,
+		Method:     auditLog.Method,
+		Path:       auditLog.Path,
+		StatusCode: auditLog.StatusCode,
+		IP:         auditLog.IP,
+		UserAgent:  auditLog.UserAgent.String,
+		CreatedAt:  auditLog.CreatedAt,
+	}
+}
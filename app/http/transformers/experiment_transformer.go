@@ -0,0 +1,33 @@
+package transformers
+
+import (
+	"gfly/app/dto"
+	"gfly/app/http/response"
+)
+
+// ToArticleExperimentResponse transforms the results of an A/B test to an ArticleExperiment response
+func ToArticleExperimentResponse(experiment dto.ArticleExperiment) response.ArticleExperiment {
+	variants := make([]response.ArticleVariant, len(experiment.Variants))
+	for i, stats := range experiment.Variants {
+		variants[i] = response.ArticleVariant{
+			Key:         stats.Variant.Key,
+			Control:     i == 0,
+			Title:       stats.Variant.Title.String,
+			CoverImage:  stats.Variant.CoverImage.String,
+			Impressions: stats.Variant.Impressions,
+			Clicks:      stats.Variant.Clicks,
+			CTR:         stats.CTR,
+			Lift:        stats.Lift,
+			Confidence:  stats.Confidence,
+			CreatedAt:   stats.Variant.CreatedAt,
+		}
+	}
+
+	return response.ArticleExperiment{
+		ArticleID:          experiment.ArticleID,
+		Variants:           variants,
+		Leader:             experiment.Leader,
+		Significant:        experiment.Significant,
+		RequiredConfidence: experiment.Confidence,
+	}
+}
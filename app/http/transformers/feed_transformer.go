@@ -0,0 +1,228 @@
+package transformers
+
+import (
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http/response"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core"
+)
+
+// ArticleURL builds the public URL of an article page.
+//
+// Parameters:
+//   - slug: The article slug
+//
+// Returns:
+//   - string: Absolute URL of the story page (e.g. https://example.com/truyen/ghost-story)
+func ArticleURL(slug string) string {
+	return fmt.Sprintf("%s/truyen/%s", strings.TrimSuffix(core.AppURL, "/"), slug)
+}
+
+// ToRSSResponse converts published articles to an RSS 2.0 document.
+//
+// Parameters:
+//   - channel: dto.FeedChannel - The channel metadata
+//   - feedItems: []dto.FeedItem - The articles to syndicate, newest first (see services.FeedItems)
+//
+// Returns:
+//   - response.RSS: The RSS document ready to be marshalled
+func ToRSSResponse(channel dto.FeedChannel, feedItems []dto.FeedItem) response.RSS {
+	items := make([]response.RSSItem, 0, len(feedItems))
+
+	for _, feedItem := range feedItems {
+		article := feedItem.Article
+		link := ArticleURL(article.Slug)
+
+		item := response.RSSItem{
+			Title:       article.Title,
+			Link:        link,
+			GUID:        response.RSSGUID{IsPermaLink: true, Value: link},
+			PubDate:     articlePublishedAt(article).Format(time.RFC1123Z),
+			Description: response.CDATA{Value: articleSummary(article)},
+		}
+
+		if feedItem.Content != "" {
+			item.Content = &response.CDATA{Value: feedItem.Content}
+		}
+
+		// RSS requires the length of an enclosure, covers of unknown size are left out
+		if article.CoverImage.Valid && article.CoverImage.String != "" && feedItem.CoverSize > 0 {
+			coverURL := absoluteURL(article.CoverImage.String)
+
+			item.Enclosure = &response.RSSEnclosure{
+				URL:    coverURL,
+				Type:   imageMimeType(coverURL),
+				Length: feedItem.CoverSize,
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return response.RSS{
+		Version:      "2.0",
+		XmlnsAtom:    "http://www.w3.org/2005/Atom",
+		XmlnsContent: "http://purl.org/rss/1.0/modules/content/",
+		Channel: response.RSSChannel{
+			Title:         channel.Title,
+			Link:          channel.Link,
+			Description:   channel.Description,
+			Language:      channel.Language,
+			LastBuildDate: feedItemsUpdatedAt(feedItems).Format(time.RFC1123Z),
+			AtomLink: response.FeedLink{
+				Href: channel.SelfURL,
+				Rel:  "self",
+				Type: "application/rss+xml",
+			},
+			Items: items,
+		},
+	}
+}
+
+// ToAtomResponse converts published articles to an Atom 1.0 document.
+//
+// Parameters:
+//   - channel: dto.FeedChannel - The channel metadata
+//   - feedItems: []dto.FeedItem - The articles to syndicate, newest first (see services.FeedItems)
+//
+// Returns:
+//   - response.AtomFeed: The Atom document ready to be marshalled
+func ToAtomResponse(channel dto.FeedChannel, feedItems []dto.FeedItem) response.AtomFeed {
+	entries := make([]response.AtomEntry, 0, len(feedItems))
+
+	for _, feedItem := range feedItems {
+		article := feedItem.Article
+		link := ArticleURL(article.Slug)
+
+		entry := response.AtomEntry{
+			ID:        link,
+			Title:     article.Title,
+			Links:     []response.FeedLink{{Href: link, Rel: "alternate", Type: "text/html"}},
+			Published: articlePublishedAt(article).Format(time.RFC3339),
+			Updated:   articleUpdatedAt(article).Format(time.RFC3339),
+			Summary:   &response.AtomText{Type: "html", Value: articleSummary(article)},
+		}
+
+		if feedItem.Content != "" {
+			entry.Content = &response.AtomText{Type: "html", Value: feedItem.Content}
+		}
+
+		if article.CoverImage.Valid && article.CoverImage.String != "" {
+			coverURL := absoluteURL(article.CoverImage.String)
+
+			entry.Links = append(entry.Links, response.FeedLink{
+				Href: coverURL,
+				Rel:  "enclosure",
+				Type: imageMimeType(coverURL),
+			})
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return response.AtomFeed{
+		ID:       channel.SelfURL,
+		Title:    channel.Title,
+		Subtitle: channel.Description,
+		Updated:  feedItemsUpdatedAt(feedItems).Format(time.RFC3339),
+		Links: []response.FeedLink{
+			{Href: channel.SelfURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: channel.Link, Rel: "alternate", Type: "text/html"},
+		},
+		Author: response.AtomPerson{
+			Name: channel.Title,
+			URI:  channel.Link,
+		},
+		Entries: entries,
+	}
+}
+
+// FeedUpdatedAt returns the latest modification time among the given articles.
+//
+// Parameters:
+//   - articles: []models.Article - The articles of a feed
+//
+// Returns:
+//   - time.Time: The latest published/updated time, or the current time for an empty feed
+func FeedUpdatedAt(articles []models.Article) time.Time {
+	var latest time.Time
+
+	for _, article := range articles {
+		if updatedAt := articleUpdatedAt(article); updatedAt.After(latest) {
+			latest = updatedAt
+		}
+	}
+
+	if latest.IsZero() {
+		latest = time.Now()
+	}
+
+	return latest.UTC()
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// feedItemsUpdatedAt returns the latest modification time among the articles of feed items.
+func feedItemsUpdatedAt(feedItems []dto.FeedItem) time.Time {
+	articles := make([]models.Article, len(feedItems))
+	for i, feedItem := range feedItems {
+		articles[i] = feedItem.Article
+	}
+
+	return FeedUpdatedAt(articles)
+}
+
+// articlePublishedAt returns the publishing time of an article, falling back to its creation time.
+func articlePublishedAt(article models.Article) time.Time {
+	if article.PublishedAt.Valid {
+		return article.PublishedAt.Time
+	}
+
+	return article.CreatedAt
+}
+
+// articleUpdatedAt returns the last modification time of an article.
+func articleUpdatedAt(article models.Article) time.Time {
+	publishedAt := articlePublishedAt(article)
+
+	if article.UpdatedAt.Valid && article.UpdatedAt.Time.After(publishedAt) {
+		return article.UpdatedAt.Time
+	}
+
+	return publishedAt
+}
+
+// articleSummary returns the excerpt of an article, or its SEO description when there is no excerpt.
+func articleSummary(article models.Article) string {
+	if article.Excerpt.Valid && article.Excerpt.String != "" {
+		return article.Excerpt.String
+	}
+
+	return article.SEODescription.String
+}
+
+// absoluteURL prefixes a relative path with the application URL.
+func absoluteURL(url string) string {
+	if strings.HasPrefix(url, core.SchemaHTTP) {
+		return url
+	}
+
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(core.AppURL, "/"), strings.TrimPrefix(url, "/"))
+}
+
+// imageMimeType guesses the MIME type of an image from its URL.
+func imageMimeType(url string) string {
+	if mimeType := mime.TypeByExtension(path.Ext(strings.SplitN(url, "?", 2)[0])); mimeType != "" {
+		return mimeType
+	}
+
+	return "image/jpeg"
+}
@@ -0,0 +1,52 @@
+package transformers
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/http/response"
+)
+
+// ToCategoryResponse transforms a Category model to a Category response
+func ToCategoryResponse(category models.Category) response.Category {
+	return response.Category{
+		ID:   category.ID,
+		Name: category.Name,
+		Slug: category.Slug,
+	}
+}
+
+// ToFollowResponse transforms a followed category or author to a Follow response
+func ToFollowResponse(item dto.FollowItem) response.Follow {
+	return response.Follow{
+		Type:      string(item.Follow.TargetType),
+		ID:        item.Follow.TargetID,
+		Name:      item.Name,
+		Slug:      item.Slug,
+		CreatedAt: item.Follow.CreatedAt,
+	}
+}
+
+// ToNotificationPreferencesResponse transforms a NotificationPreference model to a NotificationPreferences response
+func ToNotificationPreferencesResponse(preference models.NotificationPreference) response.NotificationPreferences {
+	return response.NotificationPreferences{
+		Email: preference.Email,
+		InApp: preference.InApp,
+	}
+}
+
+// ToNotificationResponse transforms an in-app notification and its story to a Notification response
+func ToNotificationResponse(item dto.NotificationItem) response.Notification {
+	return response.Notification{
+		ID:   item.Notification.ID,
+		Type: string(item.Notification.Type),
+		Article: response.NotificationArticle{
+			ID:         item.Article.ID,
+			Title:      item.Article.Title,
+			Slug:       item.Article.Slug,
+			CoverImage: item.Article.CoverImage.String,
+			URL:        ArticleURL(item.Article.Slug),
+		},
+		Read:      item.Notification.ReadAt.Valid,
+		CreatedAt: item.Notification.CreatedAt,
+	}
+}
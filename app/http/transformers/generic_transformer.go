@@ -1,9 +1,33 @@
 package transformers
 
-import "gfly/app/utils"
+import (
+	"encoding/json"
+	"gfly/app/utils"
+
+	"github.com/gflydev/core"
+)
 
 // ToListResponse generic function takes a list of records, and their transformer function,
 // process then return a slice of response data
 func ToListResponse[T any, R any](records []T, transformerFn func(T) R) []R {
 	return utils.TransformList(records, transformerFn)
 }
+
+// ToFieldsResponse generic function keeps only the requested JSON fields of response records (sparse fieldsets)
+func ToFieldsResponse[R any](records []R, fields []string) []core.Data {
+	return utils.TransformList(records, func(record R) core.Data {
+		var data core.Data
+
+		encoded, _ := json.Marshal(record)
+		_ = json.Unmarshal(encoded, &data)
+
+		projected := core.Data{}
+		for _, field := range fields {
+			if value, ok := data[field]; ok {
+				projected[field] = value
+			}
+		}
+
+		return projected
+	})
+}
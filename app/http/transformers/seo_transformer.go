@@ -0,0 +1,171 @@
+package transformers
+
+import (
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/http/response"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core"
+)
+
+// ToArticleJSONLD converts an article to a schema.org `Article` structured data object.
+//
+// Parameters:
+//   - article: models.Article - The published article
+//   - author: *models.User - The author of the article (optional)
+//
+// Returns:
+//   - core.Data: The JSON-LD object ready to be marshalled into a `<script type="application/ld+json">` tag
+func ToArticleJSONLD(article models.Article, author *models.User) core.Data {
+	canonicalURL := ArticleURL(article.Slug)
+
+	jsonLD := core.Data{
+		"@context":         "https://schema.org",
+		"@type":            "Article",
+		"headline":         article.Title,
+		"url":              canonicalURL,
+		"mainEntityOfPage": core.Data{"@type": "WebPage", "@id": canonicalURL},
+		"datePublished":    articlePublishedAt(article).UTC().Format(time.RFC3339),
+		"dateModified":     articleUpdatedAt(article).UTC().Format(time.RFC3339),
+		"publisher":        core.Data{"@type": "Organization", "name": core.AppName, "url": core.AppURL},
+	}
+
+	if description := ArticleDescription(article); description != "" {
+		jsonLD["description"] = description
+	}
+
+	if imageURL := ArticleImageURL(article); imageURL != "" {
+		jsonLD["image"] = []string{imageURL}
+	}
+
+	if author != nil {
+		jsonLD["author"] = core.Data{"@type": "Person", "name": author.Fullname}
+	}
+
+	return jsonLD
+}
+
+// ArticleDescription returns the SEO description of an article, falling back to its excerpt.
+//
+// Parameters:
+//   - article: models.Article - The article
+//
+// Returns:
+//   - string: The description used by meta, OpenGraph and Twitter card tags
+func ArticleDescription(article models.Article) string {
+	if article.SEODescription.Valid && article.SEODescription.String != "" {
+		return article.SEODescription.String
+	}
+
+	return article.Excerpt.String
+}
+
+// ArticleImageURL returns the absolute URL of the cover image of an article.
+//
+// Parameters:
+//   - article: models.Article - The article
+//
+// Returns:
+//   - string: Absolute cover image URL, or empty string when the article has no cover image
+func ArticleImageURL(article models.Article) string {
+	if !article.CoverImage.Valid || article.CoverImage.String == "" {
+		return ""
+	}
+
+	return absoluteURL(article.CoverImage.String)
+}
+
+// CategoryURL builds the public URL of a category page.
+//
+// Parameters:
+//   - slug: The category slug
+//
+// Returns:
+//   - string: Absolute URL of the category page (e.g. https://example.com/the-loai/truyen-ma-co-that)
+func CategoryURL(slug string) string {
+	return fmt.Sprintf("%s/the-loai/%s", strings.TrimSuffix(core.AppURL, "/"), slug)
+}
+
+// ToBreadcrumbs builds the breadcrumb trail of a public page: the home page, then the category, then the article.
+//
+// Parameters:
+//   - category: *models.Category - The category of the page (optional)
+//   - article: *models.Article - The article of the page (optional)
+//
+// Returns:
+//   - []response.Breadcrumb: The trail, the current page last
+func ToBreadcrumbs(category *models.Category, article *models.Article) []response.Breadcrumb {
+	breadcrumbs := []response.Breadcrumb{
+		{Name: core.AppName, URL: strings.TrimSuffix(core.AppURL, "/") + "/"},
+	}
+
+	if category != nil {
+		breadcrumbs = append(breadcrumbs, response.Breadcrumb{Name: category.Name, URL: CategoryURL(category.Slug)})
+	}
+
+	if article != nil {
+		breadcrumbs = append(breadcrumbs, response.Breadcrumb{Name: article.Title, URL: ArticleURL(article.Slug)})
+	}
+
+	return breadcrumbs
+}
+
+// ToBreadcrumbJSONLD converts a breadcrumb trail to a schema.org `BreadcrumbList` structured data object.
+//
+// Parameters:
+//   - breadcrumbs: []response.Breadcrumb - The trail built by ToBreadcrumbs
+//
+// Returns:
+//   - core.Data: The JSON-LD object ready to be marshalled into a `<script type="application/ld+json">` tag
+func ToBreadcrumbJSONLD(breadcrumbs []response.Breadcrumb) core.Data {
+	items := make([]core.Data, len(breadcrumbs))
+	for i, breadcrumb := range breadcrumbs {
+		items[i] = core.Data{
+			"@type":    "ListItem",
+			"position": i + 1,
+			"name":     breadcrumb.Name,
+			"item":     breadcrumb.URL,
+		}
+	}
+
+	return core.Data{
+		"@context":        "https://schema.org",
+		"@type":           "BreadcrumbList",
+		"itemListElement": items,
+	}
+}
+
+// ToCategoryJSONLD converts a page of a category to a schema.org `CollectionPage` structured data object.
+//
+// Parameters:
+//   - category: models.Category - The category
+//   - articles: []models.Article - The published articles listed on the page
+//   - pageURL: string - The canonical URL of the page
+//
+// Returns:
+//   - core.Data: The JSON-LD object ready to be marshalled into a `<script type="application/ld+json">` tag
+func ToCategoryJSONLD(category models.Category, articles []models.Article, pageURL string) core.Data {
+	items := make([]core.Data, len(articles))
+	for i, article := range articles {
+		items[i] = core.Data{
+			"@type":    "ListItem",
+			"position": i + 1,
+			"url":      ArticleURL(article.Slug),
+			"name":     article.Title,
+		}
+	}
+
+	return core.Data{
+		"@context": "https://schema.org",
+		"@type":    "CollectionPage",
+		"name":     category.Name,
+		"url":      pageURL,
+		"mainEntity": core.Data{
+			"@type":           "ItemList",
+			"itemListElement": items,
+		},
+		"publisher": core.Data{"@type": "Organization", "name": core.AppName, "url": core.AppURL},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	dbNull "github.com/gflydev/db/null"
 	"github.com/gflydev/storage"
 	"strings"
+	"time"
 )
 
 // PublicAvatar converts an avatar path to a public URL
@@ -100,6 +101,21 @@ func ToSignUpResponse(user models.User) response.User {
 // Returns:
 //   - response.User: The converted user response object
 func ToUserResponse(user models.User) response.User {
+	userResponse := ToUserSummaryResponse(user)
+	userResponse.Roles = roles(user.ID)
+
+	return userResponse
+}
+
+// ToUserSummaryResponse converts a User model to a User response object
+// without loading the roles (lists which don't request the roles field)
+//
+// Parameters:
+//   - user: models.User - The user model to convert
+//
+// Returns:
+//   - response.User: The converted user response object
+func ToUserSummaryResponse(user models.User) response.User {
 	return response.User{
 		ID:           user.ID,
 		Email:        user.Email,
@@ -114,6 +130,15 @@ func ToUserResponse(user models.User) response.User {
 		BlockedAt:    dbNull.ScanTime(user.BlockedAt),
 		DeletedAt:    dbNull.ScanTime(user.DeletedAt),
 		LastAccessAt: dbNull.ScanTime(user.LastAccessAt),
-		Roles:        roles(user.ID),
+		Birthdate:    birthdate(user),
 	}
 }
+
+// birthdate formats the birthdate of a user (empty when the user has none)
+func birthdate(user models.User) string {
+	if !user.Birthdate.Valid {
+		return ""
+	}
+
+	return user.Birthdate.Time.Format(time.DateOnly)
+}
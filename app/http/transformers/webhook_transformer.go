@@ -0,0 +1,49 @@
+package transformers
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/http/response"
+	"gfly/app/utils"
+
+	dbNull "github.com/gflydev/db/null"
+)
+
+// ToWebhookResponse transforms a Webhook model to a Webhook response, without its signing secret
+func ToWebhookResponse(webhook models.Webhook) response.Webhook {
+	return response.Webhook{
+		ID:          webhook.ID,
+		URL:         webhook.URL,
+		Events:      utils.SplitList(webhook.Events),
+		Description: webhook.Description.String,
+		Active:      webhook.Active,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   dbNull.TimeVal(webhook.UpdatedAt),
+	}
+}
+
+// ToCreatedWebhookResponse transforms a created Webhook model to a Webhook response with its signing secret,
+// the only time the secret is returned
+func ToCreatedWebhookResponse(webhook models.Webhook) response.Webhook {
+	webhookResponse := ToWebhookResponse(webhook)
+	webhookResponse.Secret = webhook.Secret
+
+	return webhookResponse
+}
+
+// ToWebhookDeliveryResponse transforms a WebhookDelivery model to a WebhookDelivery response
+func ToWebhookDeliveryResponse(delivery models.WebhookDelivery) response.WebhookDelivery {
+	return response.WebhookDelivery{
+		ID:            delivery.ID,
+		WebhookID:     delivery.WebhookID,
+		Event:         string(delivery.Event),
+		Payload:       delivery.Payload,
+		Status:        string(delivery.Status),
+		Attempts:      delivery.Attempts,
+		ResponseCode:  dbNull.Int32Val(delivery.ResponseCode),
+		ResponseBody:  delivery.ResponseBody.String,
+		Error:         delivery.Error.String,
+		NextAttemptAt: dbNull.TimeVal(delivery.NextAttemptAt),
+		DeliveredAt:   dbNull.TimeVal(delivery.DeliveredAt),
+		CreatedAt:     delivery.CreatedAt,
+	}
+}
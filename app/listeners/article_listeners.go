@@ -0,0 +1,69 @@
+package listeners
+
+import (
+	"gfly/app/domain/models/types"
+	"gfly/app/events"
+	"gfly/app/services"
+)
+
+// ---------------------------------------------------------------
+// 					Register listeners.
+// ---------------------------------------------------------------
+
+// Auto-register listeners of the article events.
+func init() {
+	// Refresh cached lists and syndication feeds
+	events.Listen(func(event events.ArticleCreated) error {
+		// Published stories are refreshed by ArticlePublished, except imported ones
+		if event.Article.Status != types.ArticleStatusPublished {
+			services.InvalidateArticleResponses(event.Article)
+		} else if event.Imported {
+			services.InvalidateArticleResponses(event.Article)
+			services.InvalidateArticleFeeds(event.Article)
+		}
+
+		return nil
+	})
+	events.Listen(func(event events.ArticlePublished) error {
+		services.InvalidateArticleResponses(event.Article)
+		services.InvalidateArticleFeeds(event.Article)
+
+		return nil
+	})
+	events.Listen(func(event events.ArticleUpdated) error {
+		services.InvalidateArticleResponses(event.Article)
+		services.InvalidateArticleFeeds(event.Article)
+
+		return nil
+	})
+	events.Listen(func(event events.ArticleDeleted) error {
+		services.InvalidateArticleResponses(event.Article)
+		services.InvalidateArticleFeeds(event.Article)
+
+		return nil
+	})
+
+	// Followers of the author and of the categories are told once, re-publishing an archived story is silent
+	events.Listen(func(event events.ArticlePublished) error {
+		services.QueueNewStoryNotifications(event.Article)
+
+		return nil
+	})
+
+	// Outbound webhooks
+	events.Listen(func(event events.ArticlePublished) error {
+		services.DispatchWebhookEvent(types.WebhookEventArticlePublished, services.ArticleWebhookData(event.Article))
+
+		return nil
+	})
+	events.Listen(func(event events.ArticleUpdated) error {
+		services.DispatchWebhookEvent(types.WebhookEventArticleUpdated, services.ArticleWebhookData(event.Article))
+
+		return nil
+	})
+	events.Listen(func(event events.ArticleDeleted) error {
+		services.DispatchWebhookEvent(types.WebhookEventArticleDeleted, services.ArticleWebhookData(event.Article))
+
+		return nil
+	})
+}
@@ -0,0 +1,27 @@
+package listeners
+
+import (
+	"gfly/app/events"
+	"gfly/app/services"
+)
+
+// ---------------------------------------------------------------
+// 					Register listeners.
+// ---------------------------------------------------------------
+
+// Auto-register listeners of the category events.
+func init() {
+	// Refresh the cached lists and syndication feeds filtered by the category
+	events.Listen(func(event events.CategoryCreated) error {
+		services.InvalidateCategoryResponses(event.Category)
+		services.InvalidateCategoryFeeds(event.Category)
+
+		return nil
+	})
+	events.Listen(func(event events.CategoryDeleted) error {
+		services.InvalidateCategoryResponses(event.Category)
+		services.InvalidateCategoryFeeds(event.Category)
+
+		return nil
+	})
+}
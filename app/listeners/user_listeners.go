@@ -0,0 +1,44 @@
+package listeners
+
+import (
+	"gfly/app/domain/models/types"
+	"gfly/app/events"
+	"gfly/app/modules/auth/notifications"
+	"gfly/app/services"
+
+	"github.com/gflydev/notification"
+)
+
+// ---------------------------------------------------------------
+// 					Register listeners.
+// ---------------------------------------------------------------
+
+// Auto-register listeners of the user and password events.
+func init() {
+	// Outbound webhooks
+	events.Listen(func(event events.UserRegistered) error {
+		services.DispatchWebhookEvent(types.WebhookEventUserCreated, services.UserWebhookData(event.User))
+
+		return nil
+	})
+	events.Listen(func(event events.UserCreated) error {
+		services.DispatchWebhookEvent(types.WebhookEventUserCreated, services.UserWebhookData(event.User))
+
+		return nil
+	})
+
+	// The reset link is part of the request, a failed mail fails it
+	events.Listen(func(event events.PasswordResetRequested) error {
+		return notification.Send(notifications.ResetPassword{
+			Email: event.Email,
+		})
+	})
+
+	// The password is already changed, the notice is sent by the queue worker
+	events.ListenQueued("mail", func(event events.PasswordChanged) error {
+		return notification.Send(notifications.ChangePassword{
+			Email: event.Email,
+			Name:  event.Fullname,
+		})
+	})
+}
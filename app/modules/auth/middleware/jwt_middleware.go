@@ -88,3 +88,36 @@ func JWTAuth(excludes ...string) core.MiddlewareHandler {
 		return nil
 	}
 }
+
+// OptionalJWTAuth an HTTP middleware that identifies the user of a valid JWT token without requiring one.
+// Guests, and requests with an invalid, blocked or expired token, go through anonymously.
+//
+// Use:
+//
+//	publicRouter.Use(middleware.OptionalJWTAuth())
+func OptionalJWTAuth() core.MiddlewareHandler {
+	return func(c *core.Ctx) error {
+		jwtToken := services.ExtractToken(c)
+		if jwtToken == "" {
+			return nil
+		}
+
+		if isBlocked, err := services.IsBlockedToken(jwtToken); err != nil || isBlocked {
+			return nil
+		}
+
+		claims, err := services.ExtractTokenMetadata(jwtToken)
+		if err != nil || claims.Expires < time.Now().Unix() {
+			return nil
+		}
+
+		user, err := mb.GetModelByID[models.User](claims.UserID)
+		if err != nil || user == nil {
+			return nil
+		}
+
+		c.SetData(constants.User, *user)
+
+		return nil
+	}
+}
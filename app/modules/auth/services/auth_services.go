@@ -5,6 +5,7 @@ import (
 	"gfly/app/domain/models"
 	"gfly/app/domain/models/types"
 	"gfly/app/domain/repository"
+	"gfly/app/events"
 	"gfly/app/modules/auth"
 	"gfly/app/modules/auth/dto"
 	"github.com/gflydev/cache"
@@ -153,6 +154,8 @@ func SignUp(signUp *dto.SignUp) (*models.User, error) {
 		return nil, errors.New("Error occurs while signup user")
 	}
 
+	events.Dispatch(events.UserRegistered{User: *user})
+
 	return user, nil
 }
 
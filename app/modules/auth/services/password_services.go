@@ -4,13 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"gfly/app/domain/repository"
+	"gfly/app/events"
 	"gfly/app/modules/auth/dto"
-	"gfly/app/modules/auth/notifications"
 	"github.com/gflydev/core/log"
 	"github.com/gflydev/core/utils"
 	mb "github.com/gflydev/db"
 	dbNull "github.com/gflydev/db/null"
-	"github.com/gflydev/notification"
 	"time"
 )
 
@@ -59,8 +58,9 @@ func ForgotPassword(forgotPassword dto.ForgotPassword) error {
 	}
 
 	// Send notification via mail
-	if err := notification.Send(notifications.ResetPassword{
-		Email: user.Email,
+	if err := events.Dispatch(events.PasswordResetRequested{
+		UserID: user.ID,
+		Email:  user.Email,
 	}); err != nil {
 		log.Errorf("Service forgot password error '%v'", err)
 
@@ -76,7 +76,7 @@ func ForgotPassword(forgotPassword dto.ForgotPassword) error {
 // 1. Verifying the reset token and retrieving associated user
 // 2. Clearing the reset token
 // 3. Updating the password with a new hashed value
-// 4. Sending email notification about password change
+// 4. Dispatching PasswordChanged, the email notification is sent by the queue worker
 //
 // Parameters:
 //   - resetPassword: dto.ResetPassword struct containing the new password and reset token
@@ -84,7 +84,7 @@ func ForgotPassword(forgotPassword dto.ForgotPassword) error {
 // Returns:
 //   - error: nil if successful, otherwise:
 //   - "invalid input data" if token is invalid/expired
-//   - "service error" if database update or email notification fails
+//   - "service error" if database update fails
 //
 // Example usage:
 //
@@ -110,15 +110,12 @@ func ChangePassword(resetPassword dto.ResetPassword) error {
 		return errors.New("service error")
 	}
 
-	// Send notification via mail
-	if err := notification.Send(notifications.ChangePassword{
-		Email: user.Email,
-		Name:  user.Fullname,
-	}); err != nil {
-		log.Errorf("Change password error '%v'", err)
-
-		return errors.New("service error")
-	}
+	// Notify via mail (queued)
+	events.Dispatch(events.PasswordChanged{
+		UserID:   user.ID,
+		Email:    user.Email,
+		Fullname: user.Fullname,
+	})
 
 	return nil
 }
@@ -0,0 +1,29 @@
+package notifications
+
+import (
+	"github.com/gflydev/core"
+	notifyMail "github.com/gflydev/notification/mail"
+	view "github.com/gflydev/view/pongo"
+)
+
+type ConfirmSubscription struct {
+	Email string
+	URL   string // Confirmation link
+}
+
+func (n ConfirmSubscription) ToEmail() notifyMail.Data {
+	body := view.New().Parse("mails/confirm_subscription", core.Data{
+		// For primary template
+		"title":    "Confirm your subscription",
+		"base_url": core.AppURL,
+		"email":    n.Email,
+		// For confirm_subscription template
+		"confirm_url": n.URL,
+	})
+
+	return notifyMail.Data{
+		To:      n.Email,
+		Subject: "Confirm your subscription to Top ghost stories",
+		Body:    body,
+	}
+}
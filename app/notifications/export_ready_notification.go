@@ -0,0 +1,31 @@
+package notifications
+
+import (
+	"github.com/gflydev/core"
+	notifyMail "github.com/gflydev/notification/mail"
+	view "github.com/gflydev/view/pongo"
+)
+
+type ExportReady struct {
+	Email string
+	Name  string // Export file name
+	URL   string // Signed download link
+}
+
+func (n ExportReady) ToEmail() notifyMail.Data {
+	body := view.New().Parse("mails/export_ready", core.Data{
+		// For primary template
+		"title":    "Content export ready",
+		"base_url": core.AppURL,
+		"email":    n.Email,
+		// For export_ready template
+		"file_name":    n.Name,
+		"download_url": n.URL,
+	})
+
+	return notifyMail.Data{
+		To:      n.Email,
+		Subject: "Content export ready",
+		Body:    body,
+	}
+}
@@ -0,0 +1,39 @@
+package notifications
+
+import (
+	"github.com/gflydev/core"
+	notifyMail "github.com/gflydev/notification/mail"
+	view "github.com/gflydev/view/pongo"
+)
+
+type NewStory struct {
+	Email      string
+	Fullname   string
+	Title      string
+	Excerpt    string
+	CoverImage string
+	URL        string // Story page
+}
+
+func (n NewStory) ToEmail() notifyMail.Data {
+	body := view.New().Parse("mails/new_story", core.Data{
+		// For primary template
+		"title":    n.Title,
+		"base_url": core.AppURL,
+		"email":    n.Email,
+		// For new_story template
+		"fullname": n.Fullname,
+		"story": core.Data{
+			"Title":      n.Title,
+			"Excerpt":    n.Excerpt,
+			"CoverImage": n.CoverImage,
+			"URL":        n.URL,
+		},
+	})
+
+	return notifyMail.Data{
+		To:      n.Email,
+		Subject: "New story: " + n.Title,
+		Body:    body,
+	}
+}
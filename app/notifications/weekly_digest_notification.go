@@ -0,0 +1,35 @@
+package notifications
+
+import (
+	"gfly/app/dto"
+
+	"github.com/gflydev/core"
+	notifyMail "github.com/gflydev/notification/mail"
+	view "github.com/gflydev/view/pongo"
+)
+
+type WeeklyDigest struct {
+	Email          string
+	Week           string // ISO week of the digest
+	Items          []dto.DigestItem
+	UnsubscribeURL string // One-click unsubscribe link
+}
+
+func (n WeeklyDigest) ToEmail() notifyMail.Data {
+	body := view.New().Parse("mails/weekly_digest", core.Data{
+		// For primary template
+		"title":           "Top ghost stories",
+		"base_url":        core.AppURL,
+		"email":           n.Email,
+		"unsubscribe_url": n.UnsubscribeURL,
+		// For weekly_digest template
+		"week":    n.Week,
+		"stories": n.Items,
+	})
+
+	return notifyMail.Data{
+		To:      n.Email,
+		Subject: "Top ghost stories of the week",
+		Body:    body,
+	}
+}
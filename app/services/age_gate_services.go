@@ -0,0 +1,181 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	appUtils "gfly/app/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// AllowedAgeRating returns the highest age rating a reader of the given age may open.
+//
+// Parameters:
+//   - age (int): The age of the reader in full years.
+//
+// Returns:
+//   - int: One of the age ratings (0 for readers younger than 13).
+func AllowedAgeRating(age int) int {
+	allowed := 0
+
+	for _, rating := range types.AgeRatingList {
+		if age >= rating {
+			allowed = rating
+		}
+	}
+
+	return allowed
+}
+
+// UserAgeRating returns the highest age rating a user may open from the birthdate confirmed in the profile.
+//
+// Parameters:
+//   - user (models.User): The authenticated user.
+//
+// Returns:
+//   - int: The age rating (0 when the user has no birthdate).
+func UserAgeRating(user models.User) int {
+	if !user.Birthdate.Valid {
+		return 0
+	}
+
+	return AllowedAgeRating(appUtils.Age(user.Birthdate.Time, time.Now()))
+}
+
+// IsAgeGated checks whether an article is hidden to readers allowed up to an age rating.
+//
+// Parameters:
+//   - article (models.Article): The article.
+//   - allowedRating (int): The highest age rating the reader may open.
+//
+// Returns:
+//   - bool: True when the content of the article must not be shown.
+func IsAgeGated(article models.Article, allowedRating int) bool {
+	return article.AgeRating > allowedRating
+}
+
+// IssueAgeToken signs an age confirmation for a reader. The token carries the highest age rating
+// the reader may open, not the birthdate, and expires after `AGE_TOKEN_TTL` hours (24 by default).
+//
+// Parameters:
+//   - birthdate (time.Time): The birthdate given by the reader.
+//
+// Returns:
+//   - (*dto.AgeToken, error): The signed token, or an error.
+//
+// Possible Errors:
+//   - "Invalid birthdate": Returned for a date in the future.
+//   - "Age confirmation is not configured": Returned when no signing key is set.
+func IssueAgeToken(birthdate time.Time) (*dto.AgeToken, error) {
+	if birthdate.After(time.Now()) {
+		return nil, errors.New("Invalid birthdate")
+	}
+
+	rating := AllowedAgeRating(appUtils.Age(birthdate, time.Now()))
+	expiresAt := time.Now().Add(time.Duration(utils.Getenv("AGE_TOKEN_TTL", 24)) * time.Hour)
+
+	signature, err := ageTokenSignature(rating, expiresAt.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AgeToken{
+		Token:     fmt.Sprintf("%d.%d.%s", rating, expiresAt.Unix(), signature),
+		AgeRating: rating,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// VerifyAgeToken verifies a signed age confirmation.
+//
+// Parameters:
+//   - token (string): The token issued by IssueAgeToken.
+//
+// Returns:
+//   - int: The age rating carried by the token (0 when the token is missing, invalid or expired,
+//     or when no signing key is set).
+func VerifyAgeToken(token string) int {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0
+	}
+
+	rating, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0
+	}
+
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return 0
+	}
+
+	signature, err := ageTokenSignature(rating, expires)
+	if err != nil || !hmac.Equal([]byte(parts[2]), []byte(signature)) {
+		return 0
+	}
+
+	return rating
+}
+
+// ConfirmUserBirthdate stores the birthdate confirmed by a user who has none yet.
+// A birthdate already in the profile is kept, so it can't be changed to open age-gated stories.
+//
+// Parameters:
+//   - user (models.User): The authenticated user.
+//   - birthdate (time.Time): The confirmed birthdate.
+//
+// Returns:
+//   - error: An error if the user can't be saved.
+func ConfirmUserBirthdate(user models.User, birthdate time.Time) error {
+	if user.Birthdate.Valid {
+		return nil
+	}
+
+	user.Birthdate = dbNull.Time(birthdate)
+	user.UpdatedAt = time.Now()
+
+	if err := mb.UpdateModel(&user); err != nil {
+		log.Errorf("Error while saving birthdate of user %d: %v", user.ID, err)
+
+		return errors.New("Error occurs while saving birthdate")
+	}
+
+	return nil
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// ageTokenSignature signs an age confirmation with `AGE_TOKEN_KEY` (JWT_SECRET_KEY by default).
+// Without a key anyone could sign tokens, so none is signed nor accepted.
+func ageTokenSignature(rating int, expires int64) (string, error) {
+	key := utils.Getenv("AGE_TOKEN_KEY", utils.Getenv("JWT_SECRET_KEY", ""))
+	if key == "" {
+		log.Error("Missing AGE_TOKEN_KEY and JWT_SECRET_KEY to sign age confirmation tokens")
+
+		return "", errors.New("Age confirmation is not configured")
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(fmt.Sprintf("age|%d|%d", rating, expires)))
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
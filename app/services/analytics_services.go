@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"time"
+
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/try"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+)
+
+// statsMaxDays the longest period of the analytics of an article
+const statsMaxDays = 366
+
+// statsMaxHourlyDays the longest period of hourly analytics
+const statsMaxHourlyDays = 31
+
+// statsTopReferrers the number of referrer hosts of the analytics
+const statsTopReferrers = 10
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// SaveViewEvent stores a reading event of an article. It is called by the queue worker (see queues.ViewEventTask),
+// never by the request handling.
+//
+// Parameters:
+//   - event (dto.ViewEvent): The collected event.
+//
+// Returns:
+//   - error: An error object if the event can't be saved.
+func SaveViewEvent(event dto.ViewEvent) error {
+	viewEvent := &models.ArticleViewEvent{
+		ArticleID:     event.ArticleID,
+		Event:         event.Event,
+		VisitorID:     event.VisitorID,
+		ReferrerHost:  optionalString(event.ReferrerHost),
+		Device:        event.Device,
+		Authenticated: event.Authenticated,
+		CreatedAt:     event.CreatedAt,
+	}
+
+	if event.Event == types.ViewEventProgress {
+		viewEvent.Progress = dbNull.Int16(int16(event.Progress))
+	}
+
+	if err := mb.CreateModel(viewEvent); err != nil {
+		log.Errorf("Error while saving %s event of article %d: %v", event.Event, event.ArticleID, err)
+
+		return errors.New("Error occurs while saving view event")
+	}
+
+	return nil
+}
+
+// RecordReadingProgress accepts a progress report once per visitor and 10% step within the `VIEW_DEDUP_WINDOW`
+// (minutes, 30 by default), so the scroll handler of a story page can report freely.
+//
+// Parameters:
+//   - articleID (int): The ID of the read article.
+//   - visitorID (string): A stable hash identifying the visitor (e.g. IP + User-Agent).
+//   - progress (int): The scroll depth in percent.
+//
+// Returns:
+//   - (bool, error): True if the progress should be collected, false if it is a duplicate, and any error encountered.
+func RecordReadingProgress(articleID int, visitorID string, progress int) (bool, error) {
+	window := time.Duration(utils.Getenv("VIEW_DEDUP_WINDOW", 30)) * time.Minute
+
+	return redisClient().SetNX(
+		context.Background(),
+		redisKey("views:progress:%d:%s:%d", articleID, visitorID, progress/10),
+		1,
+		window,
+	).Result()
+}
+
+// ArticleStats computes the analytics of an article over a period: views over time (empty buckets included),
+// top referrers, devices, unique visitors and the read-through rate.
+//
+// The read-through rate is the share of visitors who reported progress and reached `READ_THROUGH_PROGRESS`
+// percent of the story (90 by default). It is nil when no progress was reported.
+//
+// Parameters:
+//   - filter (dto.ArticleStatsFilter): The article, the period (last 30 days by default) and the granularity (day by default).
+//
+// Returns:
+//   - (*dto.ArticleStats, error): The analytics or an error if any step fails.
+//
+// Possible Errors:
+//   - "Article not found": Returned when the article doesn't exist.
+//   - "From must be before to", "Period is limited to 366 days", "Hourly stats are limited to 31 days":
+//     Returned for an invalid period.
+func ArticleStats(filter dto.ArticleStatsFilter) (*dto.ArticleStats, error) {
+	if _, err := mb.GetModelByID[models.Article](filter.ArticleID); err != nil {
+		return nil, errors.New("Article not found")
+	}
+
+	stats, err := statsPeriod(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wall-clock bounds, `created_at` is a timestamp without time zone
+	from := stats.From.Format(time.DateTime)
+	to := stats.To.Format(time.DateTime)
+	readThrough := utils.Getenv("READ_THROUGH_PROGRESS", 90)
+
+	try.Perform(func() {
+		var totals []struct {
+			Views              int `db:"views"`
+			UniqueVisitors     int `db:"unique_visitors"`
+			AuthenticatedViews int `db:"authenticated_views"`
+		}
+		_, _ = mb.Instance().Raw(
+			"SELECT COUNT(*) AS views, COUNT(DISTINCT visitor_id) AS unique_visitors, "+
+				"COUNT(*) FILTER (WHERE authenticated) AS authenticated_views "+
+				"FROM "+models.TableArticleViewEvent+" "+
+				"WHERE article_id = $1 AND event = $2 AND created_at >= $3 AND created_at < $4",
+			stats.ArticleID, types.ViewEventView, from, to,
+		).Find(&totals)
+
+		if len(totals) > 0 {
+			stats.Views = totals[0].Views
+			stats.UniqueVisitors = totals[0].UniqueVisitors
+			stats.AuthenticatedViews = totals[0].AuthenticatedViews
+		}
+
+		// One bucket per step of the period, with or without views
+		_, _ = mb.Instance().Raw(
+			"SELECT series.bucket, COUNT(e.id) AS views, COUNT(DISTINCT e.visitor_id) AS unique_visitors "+
+				"FROM generate_series(date_trunc($1, $3::timestamp), $4::timestamp - interval '1 second', ('1 ' || $1)::interval) AS series(bucket) "+
+				"LEFT JOIN "+models.TableArticleViewEvent+" e ON e.article_id = $2 AND e.event = $5 "+
+				"AND e.created_at >= GREATEST(series.bucket, $3::timestamp) AND e.created_at < LEAST(series.bucket + ('1 ' || $1)::interval, $4::timestamp) "+
+				"GROUP BY series.bucket ORDER BY series.bucket",
+			stats.Granularity, stats.ArticleID, from, to, types.ViewEventView,
+		).Find(&stats.Series)
+
+		_, _ = mb.Instance().Raw(
+			"SELECT COALESCE(referrer_host, 'direct') AS name, COUNT(*) AS views "+
+				"FROM "+models.TableArticleViewEvent+" "+
+				"WHERE article_id = $1 AND event = $2 AND created_at >= $3 AND created_at < $4 "+
+				"GROUP BY name ORDER BY views DESC, name LIMIT $5",
+			stats.ArticleID, types.ViewEventView, from, to, statsTopReferrers,
+		).Find(&stats.TopReferrers)
+
+		_, _ = mb.Instance().Raw(
+			"SELECT device AS name, COUNT(*) AS views "+
+				"FROM "+models.TableArticleViewEvent+" "+
+				"WHERE article_id = $1 AND event = $2 AND created_at >= $3 AND created_at < $4 "+
+				"GROUP BY device ORDER BY views DESC, device",
+			stats.ArticleID, types.ViewEventView, from, to,
+		).Find(&stats.Devices)
+
+		var readers []struct {
+			Readers  int `db:"readers"`
+			Finished int `db:"finished"`
+		}
+		_, _ = mb.Instance().Raw(
+			"SELECT COUNT(DISTINCT visitor_id) AS readers, "+
+				"COUNT(DISTINCT visitor_id) FILTER (WHERE progress >= $5) AS finished "+
+				"FROM "+models.TableArticleViewEvent+" "+
+				"WHERE article_id = $1 AND event = $2 AND created_at >= $3 AND created_at < $4",
+			stats.ArticleID, types.ViewEventProgress, from, to, readThrough,
+		).Find(&readers)
+
+		if len(readers) > 0 && readers[0].Readers > 0 {
+			rate := float64(readers[0].Finished) / float64(readers[0].Readers)
+			stats.ReadThroughRate = &rate
+		}
+	}).Catch(func(e try.E) {
+		log.Errorf("Error while computing stats of article %d: %v", filter.ArticleID, e)
+		err = errors.New("Error occurs while computing article stats")
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// statsPeriod resolves the period and the granularity of the analytics of an article.
+// The period covers whole days, `To` is the exclusive end of the last day.
+func statsPeriod(filter dto.ArticleStatsFilter) (*dto.ArticleStats, error) {
+	// Dates are wall-clock days of the server, like the `created_at` column
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	to := today
+	if filter.To != "" {
+		// Validated by `datetime=2006-01-02`
+		to, _ = time.Parse(time.DateOnly, filter.To)
+	}
+
+	from := to.AddDate(0, 0, -29)
+	if filter.From != "" {
+		from, _ = time.Parse(time.DateOnly, filter.From)
+	}
+
+	granularity := filter.Granularity
+	if granularity == "" {
+		granularity = "day"
+	}
+
+	days := int(to.Sub(from).Hours()/24) + 1
+
+	switch {
+	case days < 1:
+		return nil, errors.New("From must be before to")
+	case days > statsMaxDays:
+		return nil, errors.New("Period is limited to 366 days")
+	case granularity == "hour" && days > statsMaxHourlyDays:
+		return nil, errors.New("Hourly stats are limited to 31 days")
+	}
+
+	return &dto.ArticleStats{
+		ArticleID:   filter.ArticleID,
+		From:        from,
+		To:          to.AddDate(0, 0, 1),
+		Granularity: granularity,
+	}, nil
+}
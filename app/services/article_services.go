@@ -1,17 +1,23 @@
 package services
 
 import (
+	"database/sql"
 	"fmt"
 	"gfly/app/domain/models"
 	"gfly/app/domain/models/types"
 	"gfly/app/dto"
+	"gfly/app/events"
+	appUtils "gfly/app/utils"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gflydev/core"
 	"github.com/gflydev/core/errors"
 	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/try"
+	"github.com/gflydev/core/utils"
 	mb "github.com/gflydev/db"
 	dbNull "github.com/gflydev/db/null"
 	qb "github.com/jivegroup/fluentsql"
@@ -31,8 +37,6 @@ import (
 //
 //	([]models.Article, int, error): A list of article models, the total number of articles, and any error encountered.
 func FindArticles(filterDto dto.ArticleFilter) ([]models.Article, int, error) {
-	// DB Model instance
-	dbInstance := mb.Instance()
 	// Error variable
 	var err error
 
@@ -45,28 +49,7 @@ func FindArticles(filterDto dto.ArticleFilter) ([]models.Article, int, error) {
 		offset = (filterDto.Page - 1) * filterDto.PerPage
 	}
 
-	builder := dbInstance.Select("*").
-		Where(models.TableArticle+".deleted_at", qb.Null, nil).
-		When(filterDto.Keyword != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
-			query.WhereGroup(func(queryGroup qb.WhereBuilder) *qb.WhereBuilder {
-				queryGroup.Where(models.TableArticle+".title", qb.Like, "%"+filterDto.Keyword+"%").
-					WhereOr(models.TableArticle+".slug", qb.Like, "%"+filterDto.Keyword+"%").
-					WhereOr(models.TableArticle+".excerpt", qb.Like, "%"+filterDto.Keyword+"%").
-					WhereOr(models.TableArticle+".content", qb.Like, "%"+filterDto.Keyword+"%")
-
-				// Check if keyword matches any article status
-				for _, status := range types.ArticleStatusList {
-					if string(status) == filterDto.Keyword {
-						queryGroup.WhereOr(models.TableArticle+".status", qb.Eq, filterDto.Status)
-						break
-					}
-				}
-
-				return &queryGroup
-			})
-
-			return &query
-		}).
+	builder := articleFilterQuery(filterDto).
 		Limit(filterDto.PerPage, offset)
 
 	if filterDto.OrderBy != "" {
@@ -91,6 +74,15 @@ func FindArticles(filterDto dto.ArticleFilter) ([]models.Article, int, error) {
 		if field, ok := orderByFields[orderKey]; ok {
 			builder.OrderBy(field.(string), direction)
 		}
+
+		// Trending order ranked by ComputeTrendingScores, newest first for unranked articles
+		if orderKey == "trending" {
+			if ids := TrendingArticleIDs(utils.Getenv("TRENDING_SIZE", 500)); len(ids) > 0 {
+				builder.OrderBy(trendingOrderExpression(ids), qb.Asc)
+			}
+
+			builder.OrderBy(models.TableArticle+".published_at", qb.Desc)
+		}
 	}
 
 	// Query data
@@ -100,73 +92,94 @@ func FindArticles(filterDto dto.ArticleFilter) ([]models.Article, int, error) {
 	return articles, total, err
 }
 
-// CreateArticle creates a new article in the system.
+// FindArticlesByCursor retrieves a page of articles using cursor (keyset) pagination.
+// Unlike FindArticles, pages stay stable when articles are published while a client is scrolling.
 //
-// This function performs the following steps:
-// 1. Verifies that no other article exists with the same slug.
-// 2. Creates a new article entity in the database.
+// The order is `order_by` (`-published_at` by default) with the article ID as tie breaker.
+// `published_at` falls back to `created_at` for articles which have never been published.
+// The `trending` order isn't supported in this mode.
 //
 // Parameters:
-//   - createArticleDto (dto.CreateArticle): The payload containing the article details.
+//   - filterDto (dto.ArticleFilter): The filter containing search criteria, order by field, cursor and per-page details.
 //
 // Returns:
-//   - (*models.Article, error): The created article object or an error if any step fails.
-func CreateArticle(createArticleDto dto.CreateArticle) (*models.Article, error) {
-	// Check if an article with the same slug already exists
-	existingArticle, err := mb.GetModel[models.Article](qb.Condition{
-		Field: models.TableArticle + ".slug",
-		Opt:   qb.Eq,
-		Value: createArticleDto.Slug,
-	})
+//   - ([]models.Article, dto.Cursors, int, error): The articles, the cursors of the adjacent pages,
+//     the total number of matching articles and any error encountered.
+func FindArticlesByCursor(filterDto dto.ArticleFilter) ([]models.Article, dto.Cursors, int, error) {
+	var articles []models.Article
 
-	if err == nil && existingArticle != nil {
-		return nil, errors.New("An article with this slug already exists")
+	orderBy := filterDto.OrderBy
+	if orderBy == "" {
+		orderBy = "-published_at"
 	}
 
-	// Create new article
-	article := &models.Article{
-		Title:     createArticleDto.Title,
-		Slug:      createArticleDto.Slug,
-		Content:   createArticleDto.Content,
-		AuthorID:  createArticleDto.AuthorID,
-		Status:    createArticleDto.Status,
-		CreatedAt: time.Now(),
+	columns := map[string]cursorColumn{
+		"id":           {Expression: models.TableArticle + ".id"},
+		"title":        {Expression: models.TableArticle + ".title"},
+		"slug":         {Expression: models.TableArticle + ".slug"},
+		"status":       {Expression: models.TableArticle + ".status"},
+		"published_at": {Expression: fmt.Sprintf("COALESCE(%[1]s.published_at, %[1]s.created_at)", models.TableArticle), Time: true},
+		"created_at":   {Expression: models.TableArticle + ".created_at", Time: true},
 	}
 
-	// Set optional fields if provided
-	if createArticleDto.Excerpt != "" {
-		article.Excerpt = dbNull.String(createArticleDto.Excerpt)
-	}
+	builder := articleFilterQuery(filterDto)
 
-	if createArticleDto.CoverImage != "" {
-		article.CoverImage = dbNull.String(createArticleDto.CoverImage)
+	cursor, err := applyCursor(builder, models.TableArticle+".id", columns, orderBy, filterDto.Cursor, filterDto.PerPage)
+	if err != nil {
+		return nil, dto.Cursors{}, 0, err
 	}
 
-	if createArticleDto.SEODescription != "" {
-		article.SEODescription = dbNull.String(createArticleDto.SEODescription)
-	}
+	total, err := builder.Find(&articles)
+	if err != nil {
+		return nil, dto.Cursors{}, 0, err
+	}
+
+	articles, cursors := cursorPage(articles, orderBy, filterDto.PerPage, cursor, func(article models.Article) (string, int) {
+		switch strings.TrimPrefix(orderBy, "-") {
+		case "title":
+			return article.Title, article.ID
+		case "slug":
+			return article.Slug, article.ID
+		case "status":
+			return string(article.Status), article.ID
+		case "published_at":
+			if article.PublishedAt.Valid {
+				return cursorTime(article.PublishedAt.Time), article.ID
+			}
+
+			return cursorTime(article.CreatedAt), article.ID
+		case "created_at":
+			return cursorTime(article.CreatedAt), article.ID
+		}
 
-	if createArticleDto.SEOKeywords != "" {
-		article.SEOKeywords = dbNull.String(createArticleDto.SEOKeywords)
-	}
+		return "", article.ID
+	})
 
-	if createArticleDto.YouTubeURL != "" {
-		article.YouTubeURL = dbNull.String(createArticleDto.YouTubeURL)
-	}
+	return articles, cursors, total, nil
+}
 
-	if createArticleDto.TikTokURL != "" {
-		article.TikTokURL = dbNull.String(createArticleDto.TikTokURL)
+// CreateArticle creates a new article in the system.
+//
+// This function performs the following steps:
+// 1. Verifies that no other article exists with the same slug and that the categories exist.
+// 2. Creates a new article entity in the database with its categories.
+// 3. Dispatches ArticleCreated, and ArticlePublished when the article is published (see app/listeners).
+//
+// Parameters:
+//   - createArticleDto (dto.CreateArticle): The payload containing the article details.
+//
+// Returns:
+//   - (*models.Article, error): The created article object or an error if any step fails.
+func CreateArticle(createArticleDto dto.CreateArticle) (*models.Article, error) {
+	article, err := createArticle(createArticleDto, time.Time{})
+	if err != nil {
+		return nil, err
 	}
 
-	// Set published date if status is published
-	if article.Status == types.ArticleStatusPublished {
-		article.PublishedAt = dbNull.Time(time.Now())
-	}
+	events.Dispatch(events.ArticleCreated{Article: *article})
 
-	// Create article in database
-	if err := mb.CreateModel(article); err != nil {
-		log.Errorf("Error while creating article: %v", err)
-		return nil, errors.New("Error occurs while creating article")
+	if article.Status == types.ArticleStatusPublished {
+		events.Dispatch(events.ArticlePublished{Article: *article})
 	}
 
 	return article, nil
@@ -188,13 +201,14 @@ func GetArticleByID(articleID int) (*models.Article, error) {
 	return article, nil
 }
 
-// GetArticleBySlug retrieves an article by its slug and increments its view count.
+// GetArticleBySlug retrieves an article by its slug. Deleted articles aren't served.
 //
 // Parameters:
 //   - slug (string): The slug of the article to retrieve.
 //
 // Returns:
-//   - (*models.Article, error): The article object or an error if not found.
+//   - (*models.Article, error): The article object, "Article not found" for a missing article
+//     or "Article no longer available" for a deleted article.
 func GetArticleBySlug(slug string) (*models.Article, error) {
 	article, err := mb.GetModel[models.Article](qb.Condition{
 		Field: models.TableArticle + ".slug",
@@ -206,11 +220,38 @@ func GetArticleBySlug(slug string) (*models.Article, error) {
 		return nil, errors.New("Article not found")
 	}
 
-	// Increment view count when article is retrieved by slug
-	// This is typically for guest/public views
-	if err := IncrementArticleViewCount(article.ID); err != nil {
-		log.Warnf("Failed to increment view count for article %d: %v", article.ID, err)
-		// Continue even if view count update fails
+	if err = CheckArticleNotDeleted(article); err != nil {
+		return nil, err
+	}
+
+	return article, nil
+}
+
+// GetPublishedArticleBySlug retrieves a published article by its slug for public pages.
+//
+// Parameters:
+//   - slug (string): The slug of the article to retrieve.
+//
+// Returns:
+//   - (*models.Article, error): The article object, "Article not found" for missing or draft articles,
+//     or "Article no longer available" for archived or deleted articles.
+func GetPublishedArticleBySlug(slug string) (*models.Article, error) {
+	article, err := mb.GetModel[models.Article](qb.Condition{
+		Field: models.TableArticle + ".slug",
+		Opt:   qb.Eq,
+		Value: slug,
+	})
+
+	if err != nil || article == nil || article.Status == types.ArticleStatusDraft {
+		return nil, errors.New("Article not found")
+	}
+
+	if err = CheckArticleNotDeleted(article); err != nil {
+		return article, err
+	}
+
+	if article.Status == types.ArticleStatusArchived {
+		return article, errors.New("Article no longer available")
 	}
 
 	return article, nil
@@ -228,6 +269,7 @@ func GetArticleBySlug(slug string) (*models.Article, error) {
 //
 // Possible Errors:
 //   - "Article not found": Returned when no article is found for the provided ID.
+//   - "Category %d not found": Returned when a category of the article doesn't exist.
 //   - "Error occurs while updating article": Returned when an error occurs during the update process.
 func UpdateArticle(updateArticleDto dto.UpdateArticle) (*models.Article, error) {
 	// Get article by ID
@@ -249,7 +291,12 @@ func UpdateArticle(updateArticleDto dto.UpdateArticle) (*models.Article, error)
 		}
 	}
 
+	if err = checkCategories(updateArticleDto.CategoryIDs); err != nil {
+		return nil, err
+	}
+
 	// Update article with data from DTO
+	firstPublication := !article.PublishedAt.Valid
 	article = updateArticleFromDto(article, updateArticleDto)
 
 	// Update article in database
@@ -258,6 +305,20 @@ func UpdateArticle(updateArticleDto dto.UpdateArticle) (*models.Article, error)
 		return nil, errors.New("Error occurs while updating article")
 	}
 
+	// An empty list removes the categories, a missing one keeps them
+	if updateArticleDto.CategoryIDs != nil {
+		if err := saveArticleCategories(article.ID, updateArticleDto.CategoryIDs); err != nil {
+			log.Errorf("Error while saving categories of article %d: %v", article.ID, err)
+			return nil, errors.New("Error occurs while updating article")
+		}
+	}
+
+	if firstPublication && article.PublishedAt.Valid {
+		events.Dispatch(events.ArticlePublished{Article: *article})
+	} else {
+		events.Dispatch(events.ArticleUpdated{Article: *article})
+	}
+
 	return article, nil
 }
 
@@ -292,7 +353,8 @@ func UpdateArticleStatus(updateArticleStatusDto dto.UpdateArticleStatus) (*model
 	article.Status = updateArticleStatusDto.Status
 
 	// Update published_at if status is changed to published
-	if article.Status == types.ArticleStatusPublished && !article.PublishedAt.Valid {
+	firstPublication := article.Status == types.ArticleStatusPublished && !article.PublishedAt.Valid
+	if firstPublication {
 		article.PublishedAt = dbNull.Time(time.Now())
 	}
 
@@ -302,10 +364,16 @@ func UpdateArticleStatus(updateArticleStatusDto dto.UpdateArticleStatus) (*model
 		return nil, errors.New("Error occurs while updating article status")
 	}
 
+	if firstPublication {
+		events.Dispatch(events.ArticlePublished{Article: *article})
+	} else {
+		events.Dispatch(events.ArticleUpdated{Article: *article})
+	}
+
 	return article, nil
 }
 
-// DeleteArticleByID deletes an article from the system.
+// DeleteArticleByID deletes an article from the system permanently, see SoftDeleteArticle to hide it.
 //
 // This function performs the following steps:
 // 1. Fetches the article by its ID.
@@ -330,39 +398,235 @@ func DeleteArticleByID(articleID int) error {
 		return errors.New("Error occurs while deleting article")
 	}
 
+	events.Dispatch(events.ArticleDeleted{Article: *article})
+
 	return nil
 }
 
-// IncrementArticleViewCount increments the view count for an article.
+// SoftDeleteArticle marks an article as deleted. It's hidden from the lists and the public pages
+// like a deleted article, and kept until RestoreArticle.
 //
 // Parameters:
-//   - articleID (int): The ID of the article to update.
+//   - articleID (int): The ID of the article.
 //
 // Returns:
-//   - error: An error object if the update fails.
-func IncrementArticleViewCount(articleID int) error {
-	// Get the current article
+//   - (*models.Article, error): The deleted article or an error if any step fails.
+//
+// Possible Errors:
+//   - "Article not found": Returned when no article is found for the provided ID.
+//   - "Article already deleted": Returned for a deleted article.
+func SoftDeleteArticle(articleID int) (*models.Article, error) {
 	article, err := mb.GetModelByID[models.Article](articleID)
-	if err != nil {
-		return errors.New("Article not found")
+	if err != nil || article == nil {
+		return nil, errors.New("Article not found")
 	}
 
-	// Increment view count
-	article.ViewCount++
+	if article.DeletedAt.Valid {
+		return nil, errors.New("Article already deleted")
+	}
 
-	// Update only the view count field
-	if err := mb.UpdateModel(article); err != nil {
-		log.Errorf("Error while updating article view count: %v", err)
-		return errors.New("Error occurs while updating article view count")
+	article.DeletedAt = dbNull.Time(time.Now())
+	article.UpdatedAt = dbNull.Time(time.Now())
+
+	if err = mb.UpdateModel(article); err != nil {
+		log.Errorf("Error while deleting article %d: %v", articleID, err)
+
+		return nil, errors.New("Error occurs while deleting article")
+	}
+
+	events.Dispatch(events.ArticleDeleted{Article: *article})
+
+	return article, nil
+}
+
+// RestoreArticle restores an article deleted by SoftDeleteArticle with its status.
+//
+// Parameters:
+//   - articleID (int): The ID of the article.
+//
+// Returns:
+//   - (*models.Article, error): The restored article or an error if any step fails.
+//
+// Possible Errors:
+//   - "Article not found": Returned when no article is found for the provided ID.
+//   - "Article is not deleted": Returned for an article which isn't deleted.
+func RestoreArticle(articleID int) (*models.Article, error) {
+	article, err := mb.GetModelByID[models.Article](articleID)
+	if err != nil || article == nil {
+		return nil, errors.New("Article not found")
+	}
+
+	if !article.DeletedAt.Valid {
+		return nil, errors.New("Article is not deleted")
+	}
+
+	article.DeletedAt = sql.NullTime{}
+	article.UpdatedAt = dbNull.Time(time.Now())
+
+	if err = mb.UpdateModel(article); err != nil {
+		log.Errorf("Error while restoring article %d: %v", articleID, err)
+
+		return nil, errors.New("Error occurs while restoring article")
+	}
+
+	events.Dispatch(events.ArticleUpdated{Article: *article})
+
+	return article, nil
+}
+
+// CheckArticleNotDeleted checks that an article found by the public APIs and pages can be served.
+//
+// Parameters:
+//   - article (*models.Article): The found article, nil when the lookup found nothing.
+//
+// Returns:
+//   - error: "Article not found" for a nil article, "Article no longer available" for a deleted article.
+func CheckArticleNotDeleted(article *models.Article) error {
+	if article == nil {
+		return errors.New("Article not found")
+	}
+
+	if article.DeletedAt.Valid {
+		return errors.New("Article no longer available")
 	}
 
 	return nil
 }
 
+// IncrementArticleViewCount atomically adds views to the view count of an article.
+// Use RecordArticleView to count visitor views; this function is used to flush them.
+//
+// Parameters:
+//   - articleID (int): The ID of the article to update.
+//   - count (int): The number of views to add.
+//
+// Returns:
+//   - error: An error object if the update fails.
+func IncrementArticleViewCount(articleID, count int) (err error) {
+	try.Perform(func() {
+		// Single statement update: no read-modify-write, no other columns rewritten
+		_ = mb.Instance().
+			Raw("UPDATE "+models.TableArticle+" SET view_count = view_count + $1 WHERE id = $2", count, articleID).
+			Update(&models.Article{})
+	}).Catch(func(e try.E) {
+		log.Errorf("Error while updating article view count: %v", e)
+		err = errors.New("Error occurs while updating article view count")
+	})
+
+	return
+}
+
+// ArticlesLastModified returns the latest modification time of articles (`Last-Modified` of article responses).
+//
+// Parameters:
+//   - articles (...models.Article): The articles of a response.
+//
+// Returns:
+//   - time.Time: The latest update (or creation) time, zero when there is no article.
+func ArticlesLastModified(articles ...models.Article) time.Time {
+	var lastModified time.Time
+
+	for _, article := range articles {
+		modified := article.CreatedAt
+		if article.UpdatedAt.Valid && article.UpdatedAt.Time.After(modified) {
+			modified = article.UpdatedAt.Time
+		}
+
+		if modified.After(lastModified) {
+			lastModified = modified
+		}
+	}
+
+	return lastModified
+}
+
 // ====================================================================
 // ======================== Helper Functions ==========================
 // ====================================================================
 
+// createArticle saves a new article and its categories without dispatching events. A published article
+// gets the given publishing time, or the current time when it's zero.
+func createArticle(createArticleDto dto.CreateArticle, publishedAt time.Time) (*models.Article, error) {
+	// Check if an article with the same slug already exists
+	existingArticle, err := mb.GetModel[models.Article](qb.Condition{
+		Field: models.TableArticle + ".slug",
+		Opt:   qb.Eq,
+		Value: createArticleDto.Slug,
+	})
+
+	if err == nil && existingArticle != nil {
+		return nil, errors.New("An article with this slug already exists")
+	}
+
+	if err = checkCategories(createArticleDto.CategoryIDs); err != nil {
+		return nil, err
+	}
+
+	// Create new article
+	article := &models.Article{
+		Title:     createArticleDto.Title,
+		Slug:      createArticleDto.Slug,
+		Content:   createArticleDto.Content,
+		AuthorID:  createArticleDto.AuthorID,
+		Status:    createArticleDto.Status,
+		CreatedAt: time.Now(),
+	}
+
+	// Set optional fields if provided
+	if createArticleDto.Excerpt != "" {
+		article.Excerpt = dbNull.String(createArticleDto.Excerpt)
+	}
+
+	if createArticleDto.CoverImage != "" {
+		article.CoverImage = dbNull.String(createArticleDto.CoverImage)
+	}
+
+	if createArticleDto.SEODescription != "" {
+		article.SEODescription = dbNull.String(createArticleDto.SEODescription)
+	}
+
+	if createArticleDto.SEOKeywords != "" {
+		article.SEOKeywords = dbNull.String(createArticleDto.SEOKeywords)
+	}
+
+	if createArticleDto.YouTubeURL != "" {
+		article.YouTubeURL = dbNull.String(createArticleDto.YouTubeURL)
+	}
+
+	if createArticleDto.TikTokURL != "" {
+		article.TikTokURL = dbNull.String(createArticleDto.TikTokURL)
+	}
+
+	article.ContentWarnings = contentWarningsColumn(createArticleDto.ContentWarnings)
+	article.AgeRating = createArticleDto.AgeRating
+
+	article.AccessLevel = types.AccessLevelPublic
+	if createArticleDto.AccessLevel != "" {
+		article.AccessLevel = createArticleDto.AccessLevel
+	}
+
+	// Set published date if status is published
+	if article.Status == types.ArticleStatusPublished {
+		if publishedAt.IsZero() {
+			publishedAt = time.Now()
+		}
+
+		article.PublishedAt = dbNull.Time(publishedAt)
+	}
+
+	// Create article in database
+	if err := mb.CreateModel(article); err != nil {
+		log.Errorf("Error while creating article: %v", err)
+		return nil, errors.New("Error occurs while creating article")
+	}
+
+	if err := saveArticleCategories(article.ID, createArticleDto.CategoryIDs); err != nil {
+		log.Errorf("Error while saving categories of article %d: %v", article.ID, err)
+	}
+
+	return article, nil
+}
+
 // updateArticleFromDto updates an existing Article model with data from UpdateArticle DTO.
 // Only updates fields that are provided in the DTO.
 //
@@ -409,6 +673,19 @@ func updateArticleFromDto(article *models.Article, updateArticleDto dto.UpdateAr
 		article.TikTokURL = dbNull.String(updateArticleDto.TikTokURL)
 	}
 
+	// An empty list removes the content warnings, a missing one keeps them
+	if updateArticleDto.ContentWarnings != nil {
+		article.ContentWarnings = contentWarningsColumn(updateArticleDto.ContentWarnings)
+	}
+
+	if updateArticleDto.AgeRating != nil {
+		article.AgeRating = *updateArticleDto.AgeRating
+	}
+
+	if updateArticleDto.AccessLevel != "" {
+		article.AccessLevel = updateArticleDto.AccessLevel
+	}
+
 	// Handle status change
 	if updateArticleDto.Status != "" && updateArticleDto.Status != article.Status {
 		article.Status = updateArticleDto.Status
@@ -423,3 +700,145 @@ func updateArticleFromDto(article *models.Article, updateArticleDto dto.UpdateAr
 
 	return article
 }
+
+// articleFilterQuery builds the article query matching the search criteria of a filter.
+func articleFilterQuery(filterDto dto.ArticleFilter) *mb.DBModel {
+	return mb.Instance().Select(articleFields.selectColumns(filterDto.Fields)...).
+		When(filterDto.Deleted != "with", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			// Soft deleted articles are only listed on demand (admin list)
+			if filterDto.Deleted == "only" {
+				query.Where(models.TableArticle+".deleted_at", qb.NotNull, nil)
+			} else {
+				query.Where(models.TableArticle+".deleted_at", qb.Null, nil)
+			}
+
+			return &query
+		}).
+		When(filterDto.Keyword != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.WhereGroup(func(queryGroup qb.WhereBuilder) *qb.WhereBuilder {
+				queryGroup.Where(models.TableArticle+".title", qb.Like, "%"+filterDto.Keyword+"%").
+					WhereOr(models.TableArticle+".slug", qb.Like, "%"+filterDto.Keyword+"%").
+					WhereOr(models.TableArticle+".excerpt", qb.Like, "%"+filterDto.Keyword+"%").
+					WhereOr(models.TableArticle+".content", qb.Like, "%"+filterDto.Keyword+"%")
+
+				// Check if keyword matches any article status
+				for _, status := range types.ArticleStatusList {
+					if string(status) == filterDto.Keyword {
+						queryGroup.WhereOr(models.TableArticle+".status", qb.Eq, filterDto.Keyword)
+						break
+					}
+				}
+
+				return &queryGroup
+			})
+
+			return &query
+		}).
+		When(filterDto.Status != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableArticle+".status", qb.Eq, filterDto.Status)
+
+			return &query
+		}).
+		When(filterDto.AuthorID > 0, func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableArticle+".author_id", qb.Eq, filterDto.AuthorID)
+
+			return &query
+		}).
+		When(filterDto.CategoryID > 0, func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableArticle+".id", qb.In, qb.QueryInstance().
+				Select(models.TableArticleCategory+".article_id").
+				From(models.TableArticleCategory).
+				Where(models.TableArticleCategory+".category_id", qb.Eq, filterDto.CategoryID))
+
+			return &query
+		}).
+		When(filterDto.PublishedFrom != "" || filterDto.PublishedTo != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			whereDateRange(&query, models.TableArticle+".published_at", filterDto.PublishedFrom, filterDto.PublishedTo)
+
+			return &query
+		}).
+		When(filterDto.CreatedFrom != "" || filterDto.CreatedTo != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			whereDateRange(&query, models.TableArticle+".created_at", filterDto.CreatedFrom, filterDto.CreatedTo)
+
+			return &query
+		}).
+		When(filterDto.HasVideo != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			if hasVideo, _ := strconv.ParseBool(filterDto.HasVideo); hasVideo {
+				// NULL <> '' is not true, so empty columns are excluded
+				query.WhereGroup(func(queryGroup qb.WhereBuilder) *qb.WhereBuilder {
+					queryGroup.Where(models.TableArticle+".youtube_url", qb.NotEq, "").
+						WhereOr(models.TableArticle+".tiktok_url", qb.NotEq, "")
+
+					return &queryGroup
+				})
+			} else {
+				whereEmpty(&query, models.TableArticle+".youtube_url")
+				whereEmpty(&query, models.TableArticle+".tiktok_url")
+			}
+
+			return &query
+		}).
+		When(filterDto.HasCover != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			if hasCover, _ := strconv.ParseBool(filterDto.HasCover); hasCover {
+				query.Where(models.TableArticle+".cover_image", qb.NotEq, "")
+			} else {
+				whereEmpty(&query, models.TableArticle+".cover_image")
+			}
+
+			return &query
+		}).
+		When(filterDto.MinViews > 0, func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableArticle+".view_count", qb.GrEq, filterDto.MinViews)
+
+			return &query
+		}).
+		When(filterDto.AccessLevel != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableArticle+".access_level", qb.Eq, filterDto.AccessLevel)
+
+			return &query
+		}).
+		When(filterDto.ExcludeWarnings != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			// Labels are stored comma separated, wrapping them in commas matches whole labels only
+			for _, warning := range appUtils.SplitList(filterDto.ExcludeWarnings) {
+				query.WhereGroup(func(queryGroup qb.WhereBuilder) *qb.WhereBuilder {
+					queryGroup.Where(models.TableArticle+".content_warnings", qb.Null, nil).
+						WhereOr("(',' || "+models.TableArticle+".content_warnings || ',')", qb.NotLike, "%,"+warning+",%")
+
+					return &queryGroup
+				})
+			}
+
+			return &query
+		})
+}
+
+// contentWarningsColumn stores content warning labels comma separated (NULL when there is no label).
+func contentWarningsColumn(warnings []types.ContentWarning) sql.NullString {
+	return optionalString(strings.Join(appUtils.SplitList(strings.Join(types.ContentWarningArrStr(warnings...), ",")), ","))
+}
+
+// ContentWarnings lists the content warning labels of an article.
+func ContentWarnings(article models.Article) []string {
+	return appUtils.SplitList(article.ContentWarnings.String)
+}
+
+// whereDateRange restricts a timestamp column to a range of days (YYYY-MM-DD, both ends included).
+func whereDateRange(query *qb.WhereBuilder, column, from, to string) {
+	if fromDate, err := time.Parse(time.DateOnly, from); err == nil {
+		query.Where(column, qb.GrEq, fromDate)
+	}
+
+	if toDate, err := time.Parse(time.DateOnly, to); err == nil {
+		query.Where(column, qb.Lesser, toDate.AddDate(0, 0, 1))
+	}
+}
+
+// whereEmpty restricts an optional text column to NULL or empty values.
+func whereEmpty(query *qb.WhereBuilder, column string) {
+	query.WhereGroup(func(queryGroup qb.WhereBuilder) *qb.WhereBuilder {
+		queryGroup.Where(column, qb.Null, nil).
+			WhereOr(column, qb.Eq, "")
+
+		return &queryGroup
+	})
+}
@@ -0,0 +1,284 @@
+package services
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/events"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// DefaultLocale returns the locale of base articles (`DEFAULT_LOCALE`, "vi" by default).
+func DefaultLocale() string {
+	return strings.ToLower(utils.Getenv("DEFAULT_LOCALE", "vi"))
+}
+
+// SupportedLocales returns the locales served by public endpoints (`SUPPORTED_LOCALES`, "vi,en" by default).
+// The default locale is always supported.
+func SupportedLocales() []string {
+	locales := []string{DefaultLocale()}
+
+	for _, locale := range strings.Split(utils.Getenv("SUPPORTED_LOCALES", "vi,en"), ",") {
+		locale = strings.ToLower(strings.TrimSpace(locale))
+		if locale != "" && !slices.Contains(locales, locale) {
+			locales = append(locales, locale)
+		}
+	}
+
+	return locales
+}
+
+// FindArticleTranslations retrieves the translations of an article.
+//
+// Parameters:
+//   - articleID (int): The ID of the base article.
+//
+// Returns:
+//   - ([]models.ArticleTranslation, error): The translations ordered by locale and any error encountered.
+func FindArticleTranslations(articleID int) ([]models.ArticleTranslation, error) {
+	var translations []models.ArticleTranslation
+
+	_, err := mb.Instance().Select("*").
+		Where(models.TableArticleTranslation+".article_id", qb.Eq, articleID).
+		OrderBy(models.TableArticleTranslation+".locale", qb.Asc).
+		Find(&translations)
+
+	return translations, err
+}
+
+// SaveArticleTranslation creates or replaces the translation of an article in a locale.
+//
+// Parameters:
+//   - translationDto (dto.SaveArticleTranslation): The translation data.
+//
+// Returns:
+//   - (*models.ArticleTranslation, error): The saved translation or an error if any step fails.
+//
+// Possible Errors:
+//   - "Article not found": Returned when the base article doesn't exist.
+//   - "Unsupported locale %s": Returned for the default locale (the base article) or a locale outside SUPPORTED_LOCALES.
+//   - "An article with this slug already exists": Returned when the slug is used by another article in the locale.
+func SaveArticleTranslation(translationDto dto.SaveArticleTranslation) (*models.ArticleTranslation, error) {
+	locale := strings.ToLower(translationDto.Locale)
+	if locale == DefaultLocale() || !slices.Contains(SupportedLocales(), locale) {
+		return nil, errors.New("Unsupported locale %s", translationDto.Locale)
+	}
+
+	article, err := GetArticleByID(translationDto.ArticleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !translationSlugAvailable(article.ID, locale, translationDto.Slug) {
+		return nil, errors.New("An article with this slug already exists")
+	}
+
+	translation, err := mb.GetModel[models.ArticleTranslation](
+		qb.Condition{Field: models.TableArticleTranslation + ".article_id", Opt: qb.Eq, Value: article.ID},
+		qb.Condition{Field: models.TableArticleTranslation + ".locale", Opt: qb.Eq, Value: locale},
+	)
+	if err != nil || translation == nil {
+		translation = &models.ArticleTranslation{
+			ArticleID: article.ID,
+			Locale:    locale,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	translation.Title = translationDto.Title
+	translation.Slug = translationDto.Slug
+	translation.Excerpt = optionalString(translationDto.Excerpt)
+	translation.Content = translationDto.Content
+	translation.SEODescription = optionalString(translationDto.SEODescription)
+	translation.SEOKeywords = optionalString(translationDto.SEOKeywords)
+
+	if translation.ID == 0 {
+		err = mb.CreateModel(translation)
+	} else {
+		translation.UpdatedAt = dbNull.Time(time.Now())
+		err = mb.UpdateModel(translation)
+	}
+
+	if err != nil {
+		log.Errorf("Error while saving %s translation of article %d: %v", locale, article.ID, err)
+
+		return nil, errors.New("Error occurs while saving article translation")
+	}
+
+	events.Dispatch(events.ArticleUpdated{Article: *article})
+
+	return translation, nil
+}
+
+// DeleteArticleTranslation deletes the translation of an article in a locale.
+//
+// Parameters:
+//   - articleID (int): The ID of the base article.
+//   - locale (string): The locale of the translation.
+//
+// Returns:
+//   - error: "Translation not found", or an error if the deletion fails.
+func DeleteArticleTranslation(articleID int, locale string) error {
+	translation, err := mb.GetModel[models.ArticleTranslation](
+		qb.Condition{Field: models.TableArticleTranslation + ".article_id", Opt: qb.Eq, Value: articleID},
+		qb.Condition{Field: models.TableArticleTranslation + ".locale", Opt: qb.Eq, Value: strings.ToLower(locale)},
+	)
+	if err != nil || translation == nil {
+		return errors.New("Translation not found")
+	}
+
+	if err = mb.DeleteModel(translation); err != nil {
+		log.Errorf("Error while deleting %s translation of article %d: %v", locale, articleID, err)
+
+		return errors.New("Error occurs while deleting article translation")
+	}
+
+	if article, err := GetArticleByID(articleID); err == nil {
+		events.Dispatch(events.ArticleUpdated{Article: *article})
+	}
+
+	return nil
+}
+
+// TranslatedArticleSlug maps the slug of a translation to the slug of its base article.
+//
+// Parameters:
+//   - slug (string): A slug which doesn't match any base article.
+//
+// Returns:
+//   - (string, string, bool): The base article slug, the locale of the translation and true when the slug is translated.
+func TranslatedArticleSlug(slug string) (string, string, bool) {
+	translation, err := mb.GetModel[models.ArticleTranslation](qb.Condition{
+		Field: models.TableArticleTranslation + ".slug",
+		Opt:   qb.Eq,
+		Value: slug,
+	})
+	if err != nil || translation == nil {
+		return "", "", false
+	}
+
+	article, err := GetArticleByID(translation.ArticleID)
+	if err != nil {
+		return "", "", false
+	}
+
+	return article.Slug, translation.Locale, true
+}
+
+// LocalizeArticle applies the translation of a locale to an article.
+// The base article is returned unchanged when the locale has no translation.
+//
+// Parameters:
+//   - article (models.Article): The base article.
+//   - translations ([]models.ArticleTranslation): The translations of the article.
+//   - locale (string): The requested locale.
+//
+// Returns:
+//   - (models.Article, string): The localized article and the locale of its content.
+func LocalizeArticle(article models.Article, translations []models.ArticleTranslation, locale string) (models.Article, string) {
+	for _, translation := range translations {
+		if translation.Locale == locale && translation.ArticleID == article.ID {
+			return applyTranslation(article, translation), locale
+		}
+	}
+
+	return article, DefaultLocale()
+}
+
+// LocalizeArticles applies the translations of a locale to a list of articles.
+//
+// Parameters:
+//   - articles ([]models.Article): The base articles.
+//   - locale (string): The requested locale.
+//
+// Returns:
+//   - ([]models.Article, []string): The localized articles and the locale of each article content.
+func LocalizeArticles(articles []models.Article, locale string) ([]models.Article, []string) {
+	locales := make([]string, len(articles))
+	for i := range articles {
+		locales[i] = DefaultLocale()
+	}
+
+	if locale == DefaultLocale() || len(articles) == 0 {
+		return articles, locales
+	}
+
+	ids := make([]int, len(articles))
+	for i, article := range articles {
+		ids[i] = article.ID
+	}
+
+	var translations []models.ArticleTranslation
+	if _, err := mb.Instance().Select("*").
+		Where(models.TableArticleTranslation+".article_id", qb.In, ids).
+		Where(models.TableArticleTranslation+".locale", qb.Eq, locale).
+		Find(&translations); err != nil {
+		log.Warnf("Failed to load %s translations: %v", locale, err)
+
+		return articles, locales
+	}
+
+	localized := make([]models.Article, len(articles))
+	for i, article := range articles {
+		localized[i], locales[i] = LocalizeArticle(article, translations, locale)
+	}
+
+	return localized, locales
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// applyTranslation replaces the translatable fields of an article.
+func applyTranslation(article models.Article, translation models.ArticleTranslation) models.Article {
+	article.Title = translation.Title
+	article.Slug = translation.Slug
+	article.Excerpt = translation.Excerpt
+	article.Content = translation.Content
+	article.SEODescription = translation.SEODescription
+	article.SEOKeywords = translation.SEOKeywords
+
+	// The localized article is modified when either the article or the translation is
+	modified := translation.CreatedAt
+	if translation.UpdatedAt.Valid {
+		modified = translation.UpdatedAt.Time
+	}
+
+	if !article.UpdatedAt.Valid || modified.After(article.UpdatedAt.Time) {
+		article.UpdatedAt = dbNull.Time(modified)
+	}
+
+	return article
+}
+
+// translationSlugAvailable checks that a translated slug is used neither by a base article
+// nor by the translation of another article in the same locale.
+func translationSlugAvailable(articleID int, locale, slug string) bool {
+	if article, err := mb.GetModel[models.Article](qb.Condition{
+		Field: models.TableArticle + ".slug",
+		Opt:   qb.Eq,
+		Value: slug,
+	}); err == nil && article != nil && article.ID != articleID {
+		return false
+	}
+
+	translation, err := mb.GetModel[models.ArticleTranslation](
+		qb.Condition{Field: models.TableArticleTranslation + ".locale", Opt: qb.Eq, Value: locale},
+		qb.Condition{Field: models.TableArticleTranslation + ".slug", Opt: qb.Eq, Value: slug},
+	)
+
+	return err != nil || translation == nil || translation.ArticleID == articleID
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"encoding/json"
+	"gfly/app/domain/models"
+	"gfly/app/domain/repository"
+	"gfly/app/dto"
+	appUtils "gfly/app/utils"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// RecordAudit saves an entry of the audit log with the fields changed by the mutation.
+//
+// Parameters:
+//   - entry (dto.AuditEntry): The actor, the action, its target and the state of the target before and after it.
+//
+// Returns:
+//   - error: An error if the entry can't be saved.
+func RecordAudit(entry dto.AuditEntry) error {
+	auditLog := &models.AuditLog{
+		ActorID:    entry.ActorID,
+		ActorEmail: entry.ActorEmail,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		Method:     entry.Method,
+		Path:       truncateText(entry.Path, 255),
+		StatusCode: entry.StatusCode,
+		IP:         entry.IP,
+		UserAgent:  optionalString(truncateText(entry.UserAgent, 500)),
+		CreatedAt:  time.Now(),
+	}
+
+	if entry.TargetID > 0 {
+		auditLog.TargetID = dbNull.Int32(int32(entry.TargetID))
+	}
+
+	if changes := appUtils.AuditDiff(entry.Before, entry.After); changes != nil {
+		data, err := json.Marshal(changes)
+		if err != nil {
+			return err
+		}
+
+		auditLog.Changes = dbNull.String(string(data))
+	}
+
+	if err := mb.CreateModel(auditLog); err != nil {
+		log.Errorf("Error while recording %s of %s %d: %v", entry.Action, entry.TargetType, entry.TargetID, err)
+
+		return errors.New("Error occurs while recording audit log")
+	}
+
+	return nil
+}
+
+// AuditSnapshot retrieves the audited state of a target: the fields an administrator can change.
+// Secrets are hashed, long texts are replaced by their hash.
+//
+// Parameters:
+//   - targetType (string): The target type (user, article, category or webhook).
+//   - targetID (int): The ID of the target.
+//
+// Returns:
+//   - map[string]any: The state of the target, nil for an unknown type or a missing target.
+func AuditSnapshot(targetType string, targetID int) map[string]any {
+	if targetID < 1 {
+		return nil
+	}
+
+	switch targetType {
+	case "user":
+		return userAuditSnapshot(targetID)
+	case "article":
+		return articleAuditSnapshot(targetID)
+	case "category":
+		if category, err := GetCategoryByID(targetID); err == nil {
+			return appUtils.AuditSnapshot(core.Data{
+				"name": category.Name,
+				"slug": category.Slug,
+			})
+		}
+	case "webhook":
+		if webhook, err := GetWebhookByID(targetID); err == nil {
+			return appUtils.AuditSnapshot(core.Data{
+				"url":         webhook.URL,
+				"secret_hash": utils.Sha256(webhook.Secret),
+				"events":      WebhookEvents(*webhook),
+				"description": webhook.Description.String,
+				"active":      webhook.Active,
+			})
+		}
+	}
+
+	return nil
+}
+
+// FindAuditLogs retrieves a page of the audit log, latest first.
+//
+// Parameters:
+//   - filterDto (dto.AuditLogFilter): The optional actor, action, target and period, and the page details.
+//
+// Returns:
+//   - ([]models.AuditLog, int, error): The entries, the total number of matching entries and any error encountered.
+func FindAuditLogs(filterDto dto.AuditLogFilter) ([]models.AuditLog, int, error) {
+	var auditLogs []models.AuditLog
+	offset := 0
+
+	if filterDto.Page > 0 {
+		offset = (filterDto.Page - 1) * filterDto.PerPage
+	}
+
+	total, err := mb.Instance().Select("*").
+		When(filterDto.ActorID > 0, func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableAuditLog+".actor_id", qb.Eq, filterDto.ActorID)
+
+			return &query
+		}).
+		When(filterDto.Action != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableAuditLog+".action", qb.Eq, filterDto.Action)
+
+			return &query
+		}).
+		When(filterDto.TargetType != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableAuditLog+".target_type", qb.Eq, filterDto.TargetType)
+
+			return &query
+		}).
+		When(filterDto.TargetID > 0, func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableAuditLog+".target_id", qb.Eq, filterDto.TargetID)
+
+			return &query
+		}).
+		When(filterDto.From != "" || filterDto.To != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			whereDateRange(&query, models.TableAuditLog+".created_at", filterDto.From, filterDto.To)
+
+			return &query
+		}).
+		OrderBy(models.TableAuditLog+".id", qb.Desc).
+		Limit(filterDto.PerPage, offset).
+		Find(&auditLogs)
+
+	return auditLogs, total, err
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// truncateText fits a text into a column of the given length (in bytes).
+func truncateText(text string, length int) string {
+	if len(text) <= length {
+		return text
+	}
+
+	return strings.ToValidUTF8(text[:length], "")
+}
+
+// userAuditSnapshot audited state of a user: profile, status, roles and plan.
+func userAuditSnapshot(userID int) map[string]any {
+	user, err := mb.GetModelByID[models.User](userID)
+	if err != nil || user == nil {
+		return nil
+	}
+
+	var roles []string
+	for _, role := range repository.Pool.GetRolesByUserID(userID) {
+		roles = append(roles, string(role.Slug))
+	}
+
+	state := core.Data{
+		"email":       user.Email,
+		"fullname":    user.Fullname,
+		"phone":       user.Phone,
+		"avatar":      user.Avatar.String,
+		"status":      user.Status,
+		"roles":       roles,
+		"verified_at": dbNull.TimeVal(user.VerifiedAt),
+		"blocked_at":  dbNull.TimeVal(user.BlockedAt),
+		"deleted_at":  dbNull.TimeVal(user.DeletedAt),
+		"plan":        nil,
+	}
+
+	if entitlement, err := GetUserEntitlement(userID); err == nil {
+		state["plan"] = entitlement.Plan
+		state["plan_expires_at"] = dbNull.TimeVal(entitlement.ExpiresAt)
+	}
+
+	return appUtils.AuditSnapshot(state)
+}
+
+// articleAuditSnapshot audited state of an article. The content is replaced by its hash.
+func articleAuditSnapshot(articleID int) map[string]any {
+	article, err := mb.GetModelByID[models.Article](articleID)
+	if err != nil || article == nil {
+		return nil
+	}
+
+	categoryIDs, _ := FindArticleCategoryIDs(articleID)
+
+	return appUtils.AuditSnapshot(core.Data{
+		"title":            article.Title,
+		"slug":             article.Slug,
+		"excerpt":          article.Excerpt.String,
+		"content_hash":     utils.Sha256(article.Content),
+		"cover_image":      article.CoverImage.String,
+		"status":           article.Status,
+		"author_id":        article.AuthorID,
+		"access_level":     article.AccessLevel,
+		"age_rating":       article.AgeRating,
+		"content_warnings": ContentWarnings(*article),
+		"category_ids":     categoryIDs,
+		"seo_description":  article.SEODescription.String,
+		"seo_keywords":     article.SEOKeywords.String,
+		"youtube_url":      article.YouTubeURL.String,
+		"tiktok_url":       article.TikTokURL.String,
+		"published_at":     dbNull.TimeVal(article.PublishedAt),
+		"deleted_at":       dbNull.TimeVal(article.DeletedAt),
+	})
+}
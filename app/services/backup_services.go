@@ -0,0 +1,797 @@
+package services
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/domain/repository"
+	"gfly/app/dto"
+	"gfly/app/events"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+	"github.com/gflydev/storage"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// BackupDir directory of generated content exports, relative to the storage root.
+const BackupDir = "app/exports"
+
+// backupContentFile name of the JSON Lines file inside a zip bundle.
+const backupContentFile = "content.jsonl"
+
+// backupMediaDir directory of the media files inside a zip bundle.
+const backupMediaDir = "media/"
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// ExportContent exports all categories, articles (soft deleted ones included) with their category links
+// and translations, and their authors, then writes the export to storage.
+//
+// The JSON Lines file starts with a manifest record followed by one record per category, author, article
+// and translation (see EncodeBackup). The zip bundle contains the same file as `content.jsonl` plus the
+// cover images and avatars kept in local storage under `media/`. Passwords are not exported.
+//
+// Parameters:
+//   - format (dto.BackupFormat): The export format.
+//
+// Returns:
+//   - (string, error): The file name inside BackupDir and any error encountered.
+func ExportContent(format dto.BackupFormat) (string, error) {
+	if format != dto.BackupFormatJSONL && format != dto.BackupFormatZip {
+		return "", errors.New("Unsupported export format %q", format)
+	}
+
+	backup := dto.BackupContent{
+		Manifest: dto.BackupManifest{
+			Version:    dto.BackupVersion,
+			ExportedAt: time.Now().UTC(),
+			Media:      []string{},
+		},
+	}
+
+	// Categories
+	var categories []models.Category
+	if _, err := mb.Instance().Select("*").OrderBy(models.TableCategory+".id", qb.Asc).Find(&categories); err != nil {
+		return "", err
+	}
+
+	for _, category := range categories {
+		backup.Categories = append(backup.Categories, toBackupCategory(category))
+	}
+
+	// Category links by article
+	var articleCategories []models.ArticleCategory
+	if _, err := mb.Instance().Select("*").
+		OrderBy(models.TableArticleCategory+".article_id", qb.Asc).
+		OrderBy(models.TableArticleCategory+".category_id", qb.Asc).
+		Find(&articleCategories); err != nil {
+		return "", err
+	}
+
+	categoryIDs := map[int][]int{}
+	for _, articleCategory := range articleCategories {
+		categoryIDs[articleCategory.ArticleID] = append(categoryIDs[articleCategory.ArticleID], articleCategory.CategoryID)
+	}
+
+	// Articles
+	authorIDs := map[int]bool{}
+
+	for page := 1; ; page++ {
+		items, _, err := mb.FindModels[models.Article](page, 500, models.TableArticle+".id", qb.Asc)
+		if err != nil {
+			return "", err
+		}
+
+		for _, item := range items {
+			article := toBackupArticle(item)
+			article.CategoryIDs = categoryIDs[item.ID]
+
+			backup.Articles = append(backup.Articles, article)
+			authorIDs[item.AuthorID] = true
+		}
+
+		if len(items) < 500 {
+			break
+		}
+	}
+
+	// Translations
+	var translations []models.ArticleTranslation
+	if _, err := mb.Instance().Select("*").OrderBy(models.TableArticleTranslation+".id", qb.Asc).Find(&translations); err != nil {
+		return "", err
+	}
+
+	for _, translation := range translations {
+		backup.Translations = append(backup.Translations, toBackupTranslation(translation))
+	}
+
+	// Authors
+	ids := make([]int, 0, len(authorIDs))
+	for authorID := range authorIDs {
+		ids = append(ids, authorID)
+	}
+
+	var users []models.User
+	if len(ids) > 0 {
+		if _, err := mb.Instance().
+			Select("*").
+			Where(models.TableUser+".id", qb.In, ids).
+			OrderBy(models.TableUser+".id", qb.Asc).
+			Limit(len(ids), 0).
+			Find(&users); err != nil {
+			return "", err
+		}
+	}
+
+	for _, user := range users {
+		backup.Authors = append(backup.Authors, toBackupAuthor(user))
+	}
+
+	// Media kept in local storage
+	if format == dto.BackupFormatZip {
+		backup.Manifest.Media = backupMediaPaths(backup.Authors, backup.Articles)
+	}
+
+	data, err := EncodeBackup(backup)
+	if err != nil {
+		return "", err
+	}
+
+	if format == dto.BackupFormatZip {
+		if data, err = zipBackup(data, backup.Manifest.Media); err != nil {
+			return "", err
+		}
+	}
+
+	// Write to storage
+	fs := storage.Instance()
+	fs.MakeDir(BackupDir)
+
+	name := fmt.Sprintf("backup-%s.%s", backup.Manifest.ExportedAt.Format("20060102-150405"), format)
+	if !fs.PutData(path.Join(BackupDir, name), data) {
+		return "", errors.New("Unable to write export %s", name)
+	}
+
+	log.Infof("Exported %d articles, %d translations, %d categories and %d authors to %s",
+		len(backup.Articles), len(backup.Translations), len(backup.Categories), len(backup.Authors), name)
+
+	return name, nil
+}
+
+// RestoreContent restores an export (JSON Lines file or zip bundle) into a database without articles.
+//
+// Categories are matched with existing categories by slug, otherwise created. Authors are matched with
+// existing users by email, otherwise created with a random password (they have to reset it). Articles keep
+// their slug, status, timestamps, view count, categories and translations but get new IDs.
+// Media files of a zip bundle are written back to storage unless a file already exists at the same path.
+//
+// Parameters:
+//   - data ([]byte): The export content.
+//
+// Returns:
+//   - (dto.RestoreReport, error): The restore report and any error encountered.
+func RestoreContent(data []byte) (dto.RestoreReport, error) {
+	var report dto.RestoreReport
+
+	if _, total, err := mb.FindModels[models.Article](1, 1, models.TableArticle+".id", qb.Asc); err != nil {
+		return report, err
+	} else if total > 0 {
+		return report, errors.New("Database is not empty: %d articles found", total)
+	}
+
+	content := data
+	var bundle *zip.Reader
+
+	// Zip bundle
+	if bytes.HasPrefix(data, []byte("PK")) {
+		var err error
+		if bundle, err = zip.NewReader(bytes.NewReader(data), int64(len(data))); err != nil {
+			return report, errors.New("Invalid export bundle: %v", err)
+		}
+
+		if content, err = readZipFile(bundle, backupContentFile); err != nil {
+			return report, errors.New("Invalid export bundle: %v", err)
+		}
+	}
+
+	backup, err := DecodeBackup(content)
+	if err != nil {
+		return report, err
+	}
+
+	// Media
+	fs := storage.Instance()
+
+	if bundle != nil {
+		for _, file := range bundle.File {
+			mediaPath := strings.TrimPrefix(file.Name, backupMediaDir)
+			if !strings.HasPrefix(file.Name, backupMediaDir) || file.FileInfo().IsDir() || !isStoragePath(mediaPath) || fs.Exists(mediaPath) {
+				continue
+			}
+
+			media, err := readZipFile(bundle, file.Name)
+			if err != nil {
+				return report, err
+			}
+
+			fs.MakeDir(path.Dir(mediaPath))
+			if !fs.PutData(mediaPath, media) {
+				return report, errors.New("Unable to restore media %s", mediaPath)
+			}
+
+			report.Media++
+		}
+	}
+
+	// Categories, authors, articles then translations
+	categoryIDs := map[int]int{}
+
+	for _, backupCategory := range backup.Categories {
+		categoryID, created, err := restoreCategory(backupCategory)
+		if err != nil {
+			return report, err
+		}
+
+		categoryIDs[backupCategory.ID] = categoryID
+		if created {
+			report.CreatedCategories++
+		} else {
+			report.MatchedCategories++
+		}
+	}
+
+	authorIDs := map[int]int{}
+
+	for _, backupAuthor := range backup.Authors {
+		authorID, created, err := restoreAuthor(backupAuthor)
+		if err != nil {
+			return report, err
+		}
+
+		authorIDs[backupAuthor.ID] = authorID
+		if created {
+			report.CreatedAuthors++
+		} else {
+			report.MatchedAuthors++
+		}
+	}
+
+	articleIDs := map[int]int{}
+	var restoredArticles []models.Article
+
+	for _, backupArticle := range backup.Articles {
+		authorID, ok := authorIDs[backupArticle.AuthorID]
+		if !ok {
+			return report, errors.New("Unknown author %d of article %q", backupArticle.AuthorID, backupArticle.Slug)
+		}
+
+		article := fromBackupArticle(backupArticle, authorID)
+		if err := mb.CreateModel(&article); err != nil {
+			return report, errors.New("Unable to restore article %q: %v", backupArticle.Slug, err)
+		}
+
+		articleIDs[backupArticle.ID] = article.ID
+		restoredArticles = append(restoredArticles, article)
+
+		var articleCategoryIDs []int
+		for _, categoryID := range backupArticle.CategoryIDs {
+			if restoredID, ok := categoryIDs[categoryID]; ok {
+				articleCategoryIDs = append(articleCategoryIDs, restoredID)
+			}
+		}
+
+		if err := saveArticleCategories(article.ID, articleCategoryIDs); err != nil {
+			return report, errors.New("Unable to restore categories of article %q: %v", backupArticle.Slug, err)
+		}
+
+		report.Articles++
+	}
+
+	for _, backupTranslation := range backup.Translations {
+		articleID, ok := articleIDs[backupTranslation.ArticleID]
+		if !ok {
+			return report, errors.New("Unknown article %d of translation %q", backupTranslation.ArticleID, backupTranslation.Slug)
+		}
+
+		translation := fromBackupTranslation(backupTranslation, articleID)
+		if err := mb.CreateModel(&translation); err != nil {
+			return report, errors.New("Unable to restore translation %q: %v", backupTranslation.Slug, err)
+		}
+
+		report.Translations++
+	}
+
+	// Restored content is refreshed like imported content: caches only, no notifications
+	for _, article := range restoredArticles {
+		events.Dispatch(events.ArticleCreated{Article: article, Imported: true})
+	}
+
+	log.Infof("Restored %d articles, %d translations, %d categories created, %d categories matched, "+
+		"%d authors created, %d authors matched, %d media files",
+		report.Articles, report.Translations, report.CreatedCategories, report.MatchedCategories,
+		report.CreatedAuthors, report.MatchedAuthors, report.Media)
+
+	return report, nil
+}
+
+// EncodeBackup writes the records of an export as JSON Lines: the manifest, then the categories, authors,
+// articles and translations. The manifest counts are set from the records.
+//
+// Parameters:
+//   - backup (dto.BackupContent): The exported records.
+//
+// Returns:
+//   - ([]byte, error): The JSON Lines content and any error encountered.
+func EncodeBackup(backup dto.BackupContent) ([]byte, error) {
+	manifest := backup.Manifest
+	manifest.Categories = len(backup.Categories)
+	manifest.Authors = len(backup.Authors)
+	manifest.Articles = len(backup.Articles)
+	manifest.Translations = len(backup.Translations)
+
+	records := []dto.BackupRecord{{Type: dto.BackupRecordManifest, Manifest: &manifest}}
+	for i := range backup.Categories {
+		records = append(records, dto.BackupRecord{Type: dto.BackupRecordCategory, Category: &backup.Categories[i]})
+	}
+	for i := range backup.Authors {
+		records = append(records, dto.BackupRecord{Type: dto.BackupRecordAuthor, Author: &backup.Authors[i]})
+	}
+	for i := range backup.Articles {
+		records = append(records, dto.BackupRecord{Type: dto.BackupRecordArticle, Article: &backup.Articles[i]})
+	}
+	for i := range backup.Translations {
+		records = append(records, dto.BackupRecord{Type: dto.BackupRecordTranslation, Translation: &backup.Translations[i]})
+	}
+
+	var content bytes.Buffer
+	encoder := json.NewEncoder(&content)
+
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, err
+		}
+	}
+
+	return content.Bytes(), nil
+}
+
+// DecodeBackup reads the records of a JSON Lines export (see EncodeBackup) and checks its manifest.
+// Exports of version 1 have no categories nor translations.
+//
+// Parameters:
+//   - content ([]byte): The JSON Lines content.
+//
+// Returns:
+//   - (dto.BackupContent, error): The records grouped by type and any error encountered.
+func DecodeBackup(content []byte) (dto.BackupContent, error) {
+	var backup dto.BackupContent
+
+	records, err := parseBackupRecords(content)
+	if err != nil {
+		return backup, err
+	}
+
+	backup.Manifest = *records[0].Manifest
+
+	for _, record := range records[1:] {
+		switch {
+		case record.Type == dto.BackupRecordCategory && record.Category != nil:
+			backup.Categories = append(backup.Categories, *record.Category)
+		case record.Type == dto.BackupRecordAuthor && record.Author != nil:
+			backup.Authors = append(backup.Authors, *record.Author)
+		case record.Type == dto.BackupRecordArticle && record.Article != nil:
+			backup.Articles = append(backup.Articles, *record.Article)
+		case record.Type == dto.BackupRecordTranslation && record.Translation != nil:
+			backup.Translations = append(backup.Translations, *record.Translation)
+		}
+	}
+
+	return backup, nil
+}
+
+// GetBackupFile reads a content export from storage.
+//
+// Parameters:
+//   - name (string): File name inside BackupDir.
+//
+// Returns:
+//   - ([]byte, error): The file content, or "Export not found".
+func GetBackupFile(name string) ([]byte, error) {
+	if strings.Contains(name, "/") || strings.Contains(name, "..") {
+		return nil, errors.New("Export not found")
+	}
+
+	fs := storage.Instance()
+	filePath := path.Join(BackupDir, name)
+
+	if !fs.Exists(filePath) {
+		return nil, errors.New("Export not found")
+	}
+
+	return fs.Get(filePath)
+}
+
+// BackupDownloadURL builds a signed download link of a content export.
+// Links expire after `BACKUP_LINK_TTL` hours (48 by default).
+//
+// Parameters:
+//   - name (string): File name inside BackupDir.
+//
+// Returns:
+//   - string: The download URL.
+func BackupDownloadURL(name string) string {
+	expires := time.Now().Add(time.Duration(utils.Getenv("BACKUP_LINK_TTL", 48)) * time.Hour).Unix()
+
+	return fmt.Sprintf("%s/exports/%s?expires=%d&signature=%s",
+		strings.TrimSuffix(core.AppURL, "/"), name, expires, backupSignature(name, expires))
+}
+
+// VerifyBackupSignature checks the signature and expiry of a download link.
+//
+// Parameters:
+//   - name (string): File name inside BackupDir.
+//   - expires (string): Expiry time of the link (unix seconds).
+//   - signature (string): Signature of the link.
+//
+// Returns:
+//   - bool: True if the link is valid.
+func VerifyBackupSignature(name, expires, signature string) bool {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return false
+	}
+
+	return hmac.Equal([]byte(signature), []byte(backupSignature(name, expiresAt)))
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// backupSignature signs a download link with `BACKUP_SIGNING_KEY` (JWT_SECRET_KEY by default).
+func backupSignature(name string, expires int64) string {
+	key := utils.Getenv("BACKUP_SIGNING_KEY", utils.Getenv("JWT_SECRET_KEY", ""))
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(fmt.Sprintf("%s|%d", name, expires)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// toBackupArticle converts an article model to an export record.
+func toBackupArticle(article models.Article) dto.BackupArticle {
+	return dto.BackupArticle{
+		ID:              article.ID,
+		Title:           article.Title,
+		Slug:            article.Slug,
+		Excerpt:         article.Excerpt.String,
+		Content:         article.Content,
+		CoverImage:      article.CoverImage.String,
+		Status:          article.Status,
+		SEODescription:  article.SEODescription.String,
+		SEOKeywords:     article.SEOKeywords.String,
+		AuthorID:        article.AuthorID,
+		YouTubeURL:      article.YouTubeURL.String,
+		TikTokURL:       article.TikTokURL.String,
+		ViewCount:       article.ViewCount,
+		ContentWarnings: article.ContentWarnings.String,
+		AgeRating:       article.AgeRating,
+		AccessLevel:     article.AccessLevel,
+		PublishedAt:     dbNull.TimeVal(article.PublishedAt),
+		CreatedAt:       article.CreatedAt,
+		UpdatedAt:       dbNull.TimeVal(article.UpdatedAt),
+		DeletedAt:       dbNull.TimeVal(article.DeletedAt),
+	}
+}
+
+// fromBackupArticle converts an export record to an article model of the given author.
+func fromBackupArticle(article dto.BackupArticle, authorID int) models.Article {
+	return models.Article{
+		Title:           article.Title,
+		Slug:            article.Slug,
+		Excerpt:         optionalString(article.Excerpt),
+		Content:         article.Content,
+		CoverImage:      optionalString(article.CoverImage),
+		Status:          article.Status,
+		SEODescription:  optionalString(article.SEODescription),
+		SEOKeywords:     optionalString(article.SEOKeywords),
+		AuthorID:        authorID,
+		YouTubeURL:      optionalString(article.YouTubeURL),
+		TikTokURL:       optionalString(article.TikTokURL),
+		ViewCount:       article.ViewCount,
+		ContentWarnings: optionalString(article.ContentWarnings),
+		AgeRating:       article.AgeRating,
+		AccessLevel:     backupAccessLevel(article.AccessLevel),
+		PublishedAt:     optionalTime(article.PublishedAt),
+		CreatedAt:       article.CreatedAt,
+		UpdatedAt:       optionalTime(article.UpdatedAt),
+		DeletedAt:       optionalTime(article.DeletedAt),
+	}
+}
+
+// toBackupCategory converts a category model to an export record.
+func toBackupCategory(category models.Category) dto.BackupCategory {
+	return dto.BackupCategory{
+		ID:        category.ID,
+		Name:      category.Name,
+		Slug:      category.Slug,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: dbNull.TimeVal(category.UpdatedAt),
+	}
+}
+
+// restoreCategory finds a category by slug or creates it. It returns the category ID and whether the category was created.
+func restoreCategory(category dto.BackupCategory) (int, bool, error) {
+	if existing, err := GetCategoryBySlug(category.Slug); err == nil {
+		return existing.ID, false, nil
+	}
+
+	restored := &models.Category{
+		Name:      category.Name,
+		Slug:      category.Slug,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: optionalTime(category.UpdatedAt),
+	}
+
+	if err := mb.CreateModel(restored); err != nil {
+		return 0, false, errors.New("Unable to restore category %q: %v", category.Slug, err)
+	}
+
+	events.Dispatch(events.CategoryCreated{Category: *restored})
+
+	return restored.ID, true, nil
+}
+
+// toBackupTranslation converts an article translation model to an export record.
+func toBackupTranslation(translation models.ArticleTranslation) dto.BackupTranslation {
+	return dto.BackupTranslation{
+		ArticleID:      translation.ArticleID,
+		Locale:         translation.Locale,
+		Title:          translation.Title,
+		Slug:           translation.Slug,
+		Excerpt:        translation.Excerpt.String,
+		Content:        translation.Content,
+		SEODescription: translation.SEODescription.String,
+		SEOKeywords:    translation.SEOKeywords.String,
+		CreatedAt:      translation.CreatedAt,
+		UpdatedAt:      dbNull.TimeVal(translation.UpdatedAt),
+	}
+}
+
+// fromBackupTranslation converts an export record to a translation of the given article.
+func fromBackupTranslation(translation dto.BackupTranslation, articleID int) models.ArticleTranslation {
+	return models.ArticleTranslation{
+		ArticleID:      articleID,
+		Locale:         translation.Locale,
+		Title:          translation.Title,
+		Slug:           translation.Slug,
+		Excerpt:        optionalString(translation.Excerpt),
+		Content:        translation.Content,
+		SEODescription: optionalString(translation.SEODescription),
+		SEOKeywords:    optionalString(translation.SEOKeywords),
+		CreatedAt:      translation.CreatedAt,
+		UpdatedAt:      optionalTime(translation.UpdatedAt),
+	}
+}
+
+// toBackupAuthor converts a user model to an export record.
+func toBackupAuthor(user models.User) dto.BackupAuthor {
+	roles := []types.Role{}
+	for _, role := range repository.Pool.GetRolesByUserID(user.ID) {
+		roles = append(roles, types.Role(role.Slug))
+	}
+
+	return dto.BackupAuthor{
+		ID:         user.ID,
+		Email:      user.Email,
+		Fullname:   user.Fullname,
+		Phone:      user.Phone,
+		Avatar:     user.Avatar.String,
+		Status:     user.Status,
+		Roles:      roles,
+		CreatedAt:  user.CreatedAt,
+		VerifiedAt: dbNull.TimeVal(user.VerifiedAt),
+	}
+}
+
+// restoreAuthor finds an author by email or creates it. It returns the user ID and whether the user was created.
+func restoreAuthor(author dto.BackupAuthor) (int, bool, error) {
+	if user := repository.Pool.GetUserByEmail(author.Email); user != nil {
+		return user.ID, false, nil
+	}
+
+	user := &models.User{
+		Status:     author.Status,
+		Email:      author.Email,
+		Password:   utils.GeneratePassword(utils.Token()),
+		Fullname:   author.Fullname,
+		Phone:      author.Phone,
+		Token:      dbNull.String(""),
+		Avatar:     optionalString(author.Avatar),
+		CreatedAt:  author.CreatedAt,
+		UpdatedAt:  time.Now(),
+		VerifiedAt: optionalTime(author.VerifiedAt),
+	}
+
+	if user.Status == "" {
+		user.Status = types.UserStatusPending
+	}
+
+	if err := mb.CreateModel(user); err != nil {
+		return 0, false, errors.New("Unable to restore author %s: %v", author.Email, err)
+	}
+
+	if len(author.Roles) > 0 {
+		if err := repository.Pool.SyncRolesWithUser(user.ID, author.Roles...); err != nil {
+			return 0, false, errors.New("Unable to restore roles of author %s: %v", author.Email, err)
+		}
+	}
+
+	return user.ID, true, nil
+}
+
+// backupMediaPaths lists the cover images and avatars kept in local storage.
+func backupMediaPaths(authors []dto.BackupAuthor, articles []dto.BackupArticle) []string {
+	fs := storage.Instance()
+	seen := map[string]bool{}
+	paths := []string{}
+
+	add := func(mediaPath string) {
+		if isStoragePath(mediaPath) && !seen[mediaPath] && fs.Exists(mediaPath) {
+			seen[mediaPath] = true
+			paths = append(paths, mediaPath)
+		}
+	}
+
+	for _, author := range authors {
+		add(author.Avatar)
+	}
+
+	for _, article := range articles {
+		add(article.CoverImage)
+	}
+
+	return paths
+}
+
+// isStoragePath checks whether a media reference is a relative path inside storage (not a URL).
+func isStoragePath(mediaPath string) bool {
+	return mediaPath != "" &&
+		!strings.Contains(mediaPath, "://") &&
+		!strings.HasPrefix(mediaPath, "/") &&
+		!strings.Contains(mediaPath, "..")
+}
+
+// zipBackup builds a zip bundle with the JSON Lines content and the media files.
+func zipBackup(content []byte, media []string) ([]byte, error) {
+	fs := storage.Instance()
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+
+	files := map[string]func() ([]byte, error){
+		backupContentFile: func() ([]byte, error) { return content, nil },
+	}
+	names := []string{backupContentFile}
+
+	for _, mediaPath := range media {
+		name := backupMediaDir + mediaPath
+		files[name] = func() ([]byte, error) { return fs.Get(mediaPath) }
+		names = append(names, name)
+	}
+
+	for _, name := range names {
+		data, err := files[name]()
+		if err != nil {
+			return nil, err
+		}
+
+		file, err := writer.Create(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = file.Write(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// readZipFile reads a file of a zip bundle.
+func readZipFile(bundle *zip.Reader, name string) ([]byte, error) {
+	file, err := bundle.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+// parseBackupRecords reads the records of a JSON Lines export and checks its manifest.
+func parseBackupRecords(content []byte) ([]dto.BackupRecord, error) {
+	var records []dto.BackupRecord
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var record dto.BackupRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, errors.New("Invalid export record at line %d: %v", line, err)
+		}
+
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.New("Invalid export file: %v", err)
+	}
+
+	if len(records) == 0 || records[0].Type != dto.BackupRecordManifest || records[0].Manifest == nil {
+		return nil, errors.New("Invalid export file: missing manifest")
+	}
+
+	if records[0].Manifest.Version > dto.BackupVersion {
+		return nil, errors.New("Unsupported export version %d", records[0].Manifest.Version)
+	}
+
+	return records, nil
+}
+
+// optionalString converts an optional value to a NullString (NULL when empty).
+func optionalString(value string) sql.NullString {
+	if value == "" {
+		return sql.NullString{}
+	}
+
+	return dbNull.String(value)
+}
+
+// backupAccessLevel defaults the access level of exports made before access levels to public.
+func backupAccessLevel(accessLevel types.AccessLevel) types.AccessLevel {
+	if accessLevel == "" {
+		return types.AccessLevelPublic
+	}
+
+	return accessLevel
+}
+
+// optionalTime converts an optional time to a NullTime (NULL when missing).
+func optionalTime(value *time.Time) sql.NullTime {
+	if value == nil {
+		return sql.NullTime{}
+	}
+
+	return dbNull.Time(*value)
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/domain/repository"
+	"gfly/app/dto"
+	"slices"
+
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	mb "github.com/gflydev/db"
+)
+
+// maxArticleCategories largest number of categories of an article.
+const maxArticleCategories = 10
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// BulkUpdateArticles applies an action to several articles. Items are applied one by one,
+// a failing item doesn't stop the others and is reported in the result.
+//
+// Parameters:
+//   - bulkDto (dto.BulkArticles): The IDs of the articles and the action.
+//
+// Returns:
+//   - (dto.BulkResult, error): The result of each article, or an error when the whole request is invalid.
+//
+// Possible Errors:
+//   - "Category %d not found": Returned when a category of assign_categories doesn't exist.
+func BulkUpdateArticles(bulkDto dto.BulkArticles) (dto.BulkResult, error) {
+	result := dto.BulkResult{Action: string(bulkDto.Action)}
+
+	if bulkDto.Action == types.BulkArticleAssignCategories {
+		if err := checkCategories(bulkDto.CategoryIDs); err != nil {
+			return result, err
+		}
+	}
+
+	for _, articleID := range bulkDto.IDs {
+		result.Add(articleID, bulkUpdateArticle(articleID, bulkDto))
+	}
+
+	return result, nil
+}
+
+// BulkUpdateUsers applies an action to several users. Items are applied one by one,
+// a failing item doesn't stop the others and is reported in the result.
+// The actor can't change their own account, like the single user APIs.
+//
+// Parameters:
+//   - bulkDto (dto.BulkUsers): The IDs of the users and the action.
+//   - actorID (int): The ID of the user applying the action.
+//
+// Returns:
+//   - dto.BulkResult: The result of each user.
+func BulkUpdateUsers(bulkDto dto.BulkUsers, actorID int) dto.BulkResult {
+	result := dto.BulkResult{Action: string(bulkDto.Action)}
+
+	for _, userID := range bulkDto.IDs {
+		if userID == actorID {
+			result.Add(userID, errors.New("Don't allow update yourself"))
+
+			continue
+		}
+
+		result.Add(userID, bulkUpdateUser(userID, bulkDto))
+	}
+
+	return result
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// bulkUpdateArticle applies the action of a bulk request to an article.
+func bulkUpdateArticle(articleID int, bulkDto dto.BulkArticles) error {
+	switch bulkDto.Action {
+	case types.BulkArticlePublish, types.BulkArticleArchive:
+		if err := checkArticleEditable(articleID); err != nil {
+			return err
+		}
+
+		status := types.ArticleStatusPublished
+		if bulkDto.Action == types.BulkArticleArchive {
+			status = types.ArticleStatusArchived
+		}
+
+		_, err := UpdateArticleStatus(dto.UpdateArticleStatus{ID: articleID, Status: status})
+
+		return err
+	case types.BulkArticleDelete:
+		_, err := SoftDeleteArticle(articleID)
+
+		return err
+	case types.BulkArticleRestore:
+		_, err := RestoreArticle(articleID)
+
+		return err
+	case types.BulkArticleAssignCategories:
+		if err := checkArticleEditable(articleID); err != nil {
+			return err
+		}
+
+		categoryIDs, err := FindArticleCategoryIDs(articleID)
+		if err != nil {
+			log.Errorf("Error while fetching categories of article %d: %v", articleID, err)
+
+			return errors.New("Error occurs while updating article")
+		}
+
+		for _, categoryID := range bulkDto.CategoryIDs {
+			if !slices.Contains(categoryIDs, categoryID) {
+				categoryIDs = append(categoryIDs, categoryID)
+			}
+		}
+
+		if len(categoryIDs) > maxArticleCategories {
+			return errors.New("An article has at most %d categories", maxArticleCategories)
+		}
+
+		_, err = UpdateArticle(dto.UpdateArticle{ID: articleID, CategoryIDs: categoryIDs})
+
+		return err
+	}
+
+	return errors.New("Unknown action %v", bulkDto.Action)
+}
+
+// bulkUpdateUser applies the action of a bulk request to a user.
+func bulkUpdateUser(userID int, bulkDto dto.BulkUsers) error {
+	switch bulkDto.Action {
+	case types.BulkUserActivate, types.BulkUserBlock:
+		status := types.UserStatusActive
+		if bulkDto.Action == types.BulkUserBlock {
+			status = types.UserStatusBlocked
+		}
+
+		_, err := UpdateUserStatus(dto.UpdateUserStatus{ID: userID, Status: status})
+
+		return err
+	case types.BulkUserDelete:
+		return DeleteUserByID(userID)
+	case types.BulkUserSetRoles:
+		if user, err := mb.GetModelByID[models.User](userID); err != nil || user == nil {
+			return errors.New("User not found")
+		}
+
+		if err := repository.Pool.SyncRolesWithUser(userID, bulkDto.Roles...); err != nil {
+			log.Errorf("Error while syncing roles of user %d: %v", userID, err)
+
+			return errors.New("Error occurs while syncing user roles")
+		}
+
+		return nil
+	}
+
+	return errors.New("Unknown action %v", bulkDto.Action)
+}
+
+// checkArticleEditable refuses to change a deleted article, it must be restored first.
+func checkArticleEditable(articleID int) error {
+	article, err := mb.GetModelByID[models.Article](articleID)
+	if err != nil || article == nil {
+		return errors.New("Article not found")
+	}
+
+	if article.DeletedAt.Valid {
+		return errors.New("Article is deleted, restore it first")
+	}
+
+	return nil
+}
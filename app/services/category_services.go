@@ -0,0 +1,278 @@
+package services
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/events"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	mb "github.com/gflydev/db"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// FindCategories retrieves all story categories ordered by name.
+//
+// Returns:
+//   - ([]models.Category, error): The categories and any error encountered.
+func FindCategories() ([]models.Category, error) {
+	var categories []models.Category
+
+	_, err := mb.Instance().Select("*").
+		OrderBy(models.TableCategory+".name", qb.Asc).
+		Find(&categories)
+
+	return categories, err
+}
+
+// GetCategoryByID retrieves a category by its ID.
+//
+// Parameters:
+//   - categoryID (int): The ID of the category.
+//
+// Returns:
+//   - (*models.Category, error): The category or "Category not found".
+func GetCategoryByID(categoryID int) (*models.Category, error) {
+	category, err := mb.GetModelByID[models.Category](categoryID)
+	if err != nil || category == nil {
+		return nil, errors.New("Category not found")
+	}
+
+	return category, nil
+}
+
+// GetCategoryBySlug retrieves a category by its slug.
+//
+// Parameters:
+//   - slug (string): The slug of the category.
+//
+// Returns:
+//   - (*models.Category, error): The category or "Category not found".
+func GetCategoryBySlug(slug string) (*models.Category, error) {
+	category, err := mb.GetModel[models.Category](qb.Condition{
+		Field: models.TableCategory + ".slug",
+		Opt:   qb.Eq,
+		Value: slug,
+	})
+	if err != nil || category == nil {
+		return nil, errors.New("Category not found")
+	}
+
+	return category, nil
+}
+
+// CreateCategory creates a story category.
+//
+// Parameters:
+//   - createCategoryDto (dto.CreateCategory): The name and slug of the category.
+//
+// Returns:
+//   - (*models.Category, error): The created category or an error if any step fails.
+//
+// Possible Errors:
+//   - "A category with this slug already exists": Returned for a duplicated slug.
+func CreateCategory(createCategoryDto dto.CreateCategory) (*models.Category, error) {
+	slug := strings.TrimSpace(createCategoryDto.Slug)
+
+	existingCategory, err := mb.GetModel[models.Category](qb.Condition{
+		Field: models.TableCategory + ".slug",
+		Opt:   qb.Eq,
+		Value: slug,
+	})
+	if err == nil && existingCategory != nil {
+		return nil, errors.New("A category with this slug already exists")
+	}
+
+	category := &models.Category{
+		Name:      strings.TrimSpace(createCategoryDto.Name),
+		Slug:      slug,
+		CreatedAt: time.Now(),
+	}
+
+	if err = mb.CreateModel(category); err != nil {
+		log.Errorf("Error while creating category: %v", err)
+
+		return nil, errors.New("Error occurs while creating category")
+	}
+
+	events.Dispatch(events.CategoryCreated{Category: *category})
+
+	return category, nil
+}
+
+// DeleteCategory deletes a category. Its articles are kept without it, its follows are removed.
+//
+// Parameters:
+//   - categoryID (int): The ID of the category.
+//
+// Returns:
+//   - error: "Category not found" or an error if the category can't be deleted.
+func DeleteCategory(categoryID int) error {
+	category, err := GetCategoryByID(categoryID)
+	if err != nil {
+		return err
+	}
+
+	var follows []models.Follow
+	if _, err = mb.Instance().Select("*").
+		Where(models.TableFollow+".target_type", qb.Eq, types.FollowTypeCategory).
+		Where(models.TableFollow+".target_id", qb.Eq, categoryID).
+		Find(&follows); err == nil {
+		for i := range follows {
+			_ = mb.DeleteModel(&follows[i])
+		}
+	}
+
+	if err = mb.DeleteModel(category); err != nil {
+		log.Errorf("Error while deleting category %d: %v", categoryID, err)
+
+		return errors.New("Error occurs while deleting category")
+	}
+
+	events.Dispatch(events.CategoryDeleted{Category: *category})
+
+	return nil
+}
+
+// FindArticleCategoryIDs retrieves the IDs of the categories of an article.
+//
+// Parameters:
+//   - articleID (int): The ID of the article.
+//
+// Returns:
+//   - ([]int, error): The category IDs and any error encountered.
+func FindArticleCategoryIDs(articleID int) ([]int, error) {
+	var articleCategories []models.ArticleCategory
+
+	_, err := mb.Instance().Select("*").
+		Where(models.TableArticleCategory+".article_id", qb.Eq, articleID).
+		OrderBy(models.TableArticleCategory+".category_id", qb.Asc).
+		Find(&articleCategories)
+
+	ids := make([]int, len(articleCategories))
+	for i, articleCategory := range articleCategories {
+		ids[i] = articleCategory.CategoryID
+	}
+
+	return ids, err
+}
+
+// FindCategoriesOfArticles retrieves the categories of several articles with two queries.
+//
+// Parameters:
+//   - articleIDs ([]int): The IDs of the articles.
+//
+// Returns:
+//   - (map[int][]models.Category, error): The categories of each article ordered by name, and any error encountered.
+func FindCategoriesOfArticles(articleIDs []int) (map[int][]models.Category, error) {
+	categoriesByArticle := make(map[int][]models.Category, len(articleIDs))
+	if len(articleIDs) == 0 {
+		return categoriesByArticle, nil
+	}
+
+	var articleCategories []models.ArticleCategory
+	if _, err := mb.Instance().Select("*").
+		Where(models.TableArticleCategory+".article_id", qb.In, articleIDs).
+		Find(&articleCategories); err != nil {
+		return nil, err
+	}
+
+	var categoryIDs []int
+	for _, articleCategory := range articleCategories {
+		if !slices.Contains(categoryIDs, articleCategory.CategoryID) {
+			categoryIDs = append(categoryIDs, articleCategory.CategoryID)
+		}
+	}
+
+	if len(categoryIDs) == 0 {
+		return categoriesByArticle, nil
+	}
+
+	var categories []models.Category
+	if _, err := mb.Instance().Select("*").
+		Where(models.TableCategory+".id", qb.In, categoryIDs).
+		OrderBy(models.TableCategory+".name", qb.Asc).
+		Find(&categories); err != nil {
+		return nil, err
+	}
+
+	for _, category := range categories {
+		for _, articleCategory := range articleCategories {
+			if articleCategory.CategoryID == category.ID {
+				categoriesByArticle[articleCategory.ArticleID] = append(categoriesByArticle[articleCategory.ArticleID], category)
+			}
+		}
+	}
+
+	return categoriesByArticle, nil
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// checkCategories verifies that categories exist before they are given to an article.
+func checkCategories(categoryIDs []int) error {
+	if len(categoryIDs) == 0 {
+		return nil
+	}
+
+	var categories []models.Category
+	if _, err := mb.Instance().Select("*").
+		Where(models.TableCategory+".id", qb.In, categoryIDs).
+		Find(&categories); err != nil {
+		return errors.New("Error occurs while loading categories")
+	}
+
+	for _, categoryID := range categoryIDs {
+		if !slices.ContainsFunc(categories, func(category models.Category) bool { return category.ID == categoryID }) {
+			return errors.New("Category %d not found", categoryID)
+		}
+	}
+
+	return nil
+}
+
+// saveArticleCategories replaces the categories of an article.
+func saveArticleCategories(articleID int, categoryIDs []int) error {
+	var current []models.ArticleCategory
+	if _, err := mb.Instance().Select("*").
+		Where(models.TableArticleCategory+".article_id", qb.Eq, articleID).
+		Find(&current); err != nil {
+		return err
+	}
+
+	for i := range current {
+		if !slices.Contains(categoryIDs, current[i].CategoryID) {
+			if err := mb.DeleteModel(&current[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, categoryID := range categoryIDs {
+		if slices.ContainsFunc(current, func(articleCategory models.ArticleCategory) bool {
+			return articleCategory.CategoryID == categoryID
+		}) {
+			continue
+		}
+
+		if err := mb.CreateModel(&models.ArticleCategory{
+			ArticleID:  articleID,
+			CategoryID: categoryID,
+			CreatedAt:  time.Now(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
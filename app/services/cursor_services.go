@@ -0,0 +1,153 @@
+package services
+
+import (
+	"gfly/app/dto"
+	"gfly/app/utils"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core/errors"
+	mb "github.com/gflydev/db"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// cursorColumn struct to describe a sort key of cursor pagination.
+type cursorColumn struct {
+	Expression string // SQL expression of the sort key
+	Time       bool   // Whether the sort key is a timestamp
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// applyCursor orders a query by the sort key of `orderBy` then by ID, restricts it to the rows after the cursor
+// and fetches one extra row to know whether there is a next page.
+//
+// Parameters:
+//   - builder (*mb.DBModel): The query.
+//   - idColumn (string): The ID column, used as tie breaker.
+//   - columns (map[string]cursorColumn): The supported sort keys.
+//   - orderBy (string): The order, prefix with '-' for descending.
+//   - rawCursor (string): The cursor received from the client (empty for the first page).
+//   - perPage (int): The page size.
+//
+// Returns:
+//   - (*utils.Cursor, error): The decoded cursor (nil for the first page) and any error encountered.
+func applyCursor(builder *mb.DBModel, idColumn string, columns map[string]cursorColumn, orderBy, rawCursor string, perPage int) (*utils.Cursor, error) {
+	key := strings.TrimPrefix(orderBy, "-")
+	descending := strings.HasPrefix(orderBy, "-")
+
+	column, ok := columns[key]
+	if !ok {
+		return nil, errors.New("Cursor pagination does not support order_by=%s", orderBy)
+	}
+
+	var cursor *utils.Cursor
+
+	if rawCursor != "" {
+		decoded, err := utils.DecodeCursor(rawCursor)
+		if err != nil || decoded.OrderBy != orderBy {
+			return nil, errors.New("Invalid cursor")
+		}
+
+		cursor = &decoded
+	}
+
+	// Previous pages are read in reverse order
+	direction, after, afterOrEqual := qb.Asc, qb.Greater, qb.GrEq
+	if descending != (cursor != nil && cursor.Backward) {
+		direction, after, afterOrEqual = qb.Desc, qb.Lesser, qb.LeEq
+	}
+
+	if cursor != nil {
+		var value any = cursor.Value
+
+		if column.Time {
+			t, err := time.Parse(time.RFC3339Nano, cursor.Value)
+			if err != nil {
+				return nil, errors.New("Invalid cursor")
+			}
+			value = t
+		}
+
+		if column.Expression == idColumn {
+			builder.Where(idColumn, after, cursor.ID)
+		} else {
+			// (key, id) after (value, cursor id)
+			builder.Where(column.Expression, afterOrEqual, value).
+				WhereGroup(func(queryGroup qb.WhereBuilder) *qb.WhereBuilder {
+					queryGroup.Where(column.Expression, after, value).
+						WhereOr(idColumn, after, cursor.ID)
+
+					return &queryGroup
+				})
+		}
+	}
+
+	builder.OrderBy(column.Expression, direction)
+	if column.Expression != idColumn {
+		builder.OrderBy(idColumn, direction)
+	}
+
+	builder.Limit(perPage+1, 0)
+
+	return cursor, nil
+}
+
+// cursorPage trims the extra row fetched by applyCursor, restores the order of previous pages
+// and builds the cursors of the adjacent pages.
+//
+// Parameters:
+//   - items ([]T): The rows fetched by the query.
+//   - orderBy (string): The order of the list.
+//   - perPage (int): The page size.
+//   - cursor (*utils.Cursor): The cursor returned by applyCursor.
+//   - key (func(T) (string, int)): Returns the sort key and the ID of a row.
+//
+// Returns:
+//   - ([]T, dto.Cursors): The page rows and the cursors of the adjacent pages.
+func cursorPage[T any](items []T, orderBy string, perPage int, cursor *utils.Cursor, key func(T) (string, int)) ([]T, dto.Cursors) {
+	var cursors dto.Cursors
+
+	hasMore := len(items) > perPage
+	if hasMore {
+		items = items[:perPage]
+	}
+
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		slices.Reverse(items)
+	}
+
+	if len(items) == 0 {
+		return []T{}, cursors
+	}
+
+	encode := func(item T, backward bool) string {
+		value, id := key(item)
+
+		return utils.EncodeCursor(utils.Cursor{
+			OrderBy:  orderBy,
+			Value:    value,
+			ID:       id,
+			Backward: backward,
+		})
+	}
+
+	if hasMore || backward {
+		cursors.Next = encode(items[len(items)-1], false)
+	}
+
+	if (cursor != nil && !backward) || (backward && hasMore) {
+		cursors.Prev = encode(items[0], true)
+	}
+
+	return items, cursors
+}
+
+// cursorTime formats a timestamp sort key.
+func cursorTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	appUtils "gfly/app/utils"
+	"time"
+
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// GetUserEntitlement retrieves the entitlement of a user, expired or not.
+//
+// Parameters:
+//   - userID (int): The ID of the user.
+//
+// Returns:
+//   - (*models.UserEntitlement, error): The entitlement, or "Entitlement not found".
+func GetUserEntitlement(userID int) (*models.UserEntitlement, error) {
+	entitlement, err := mb.GetModel[models.UserEntitlement](qb.Condition{
+		Field: models.TableUserEntitlement + ".user_id",
+		Opt:   qb.Eq,
+		Value: userID,
+	})
+	if err != nil || entitlement == nil {
+		return nil, errors.New("Entitlement not found")
+	}
+
+	return entitlement, nil
+}
+
+// GrantEntitlement grants a plan to a user, replacing the current plan.
+//
+// Parameters:
+//   - grantDto (dto.GrantEntitlement): The plan and its expiry.
+//
+// Returns:
+//   - (*models.UserEntitlement, error): The saved entitlement or an error if any step fails.
+//
+// Possible Errors:
+//   - "User not found": Returned when the user doesn't exist.
+//   - "Expiry must be in the future": Returned for an expiry in the past.
+func GrantEntitlement(grantDto dto.GrantEntitlement) (*models.UserEntitlement, error) {
+	if _, err := mb.GetModelByID[models.User](grantDto.UserID); err != nil {
+		return nil, errors.New("User not found")
+	}
+
+	if grantDto.ExpiresAt != nil && !grantDto.ExpiresAt.After(time.Now()) {
+		return nil, errors.New("Expiry must be in the future")
+	}
+
+	entitlement, err := GetUserEntitlement(grantDto.UserID)
+	if err != nil {
+		entitlement = &models.UserEntitlement{
+			UserID:    grantDto.UserID,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	entitlement.Plan = grantDto.Plan
+	entitlement.ExpiresAt = optionalTime(grantDto.ExpiresAt)
+
+	if entitlement.ID == 0 {
+		err = mb.CreateModel(entitlement)
+	} else {
+		entitlement.UpdatedAt = dbNull.Time(time.Now())
+		err = mb.UpdateModel(entitlement)
+	}
+
+	if err != nil {
+		log.Errorf("Error while granting %s plan to user %d: %v", grantDto.Plan, grantDto.UserID, err)
+
+		return nil, errors.New("Error occurs while granting entitlement")
+	}
+
+	return entitlement, nil
+}
+
+// RevokeEntitlement revokes the plan of a user.
+//
+// Parameters:
+//   - userID (int): The ID of the user.
+//
+// Returns:
+//   - error: "Entitlement not found", or an error if the deletion fails.
+func RevokeEntitlement(userID int) error {
+	entitlement, err := GetUserEntitlement(userID)
+	if err != nil {
+		return err
+	}
+
+	if err = mb.DeleteModel(entitlement); err != nil {
+		log.Errorf("Error while revoking entitlement of user %d: %v", userID, err)
+
+		return errors.New("Error occurs while revoking entitlement")
+	}
+
+	return nil
+}
+
+// ExpireEntitlements deletes the entitlements whose expiry has passed.
+//
+// Returns:
+//   - (int, error): The number of expired entitlements and any error encountered.
+func ExpireEntitlements() (int, error) {
+	var entitlements []models.UserEntitlement
+
+	if _, err := mb.Instance().Select("*").
+		Where(models.TableUserEntitlement+".expires_at", qb.NotNull, nil).
+		Where(models.TableUserEntitlement+".expires_at", qb.LeEq, time.Now()).
+		Find(&entitlements); err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for i := range entitlements {
+		if err := mb.DeleteModel(&entitlements[i]); err != nil {
+			log.Errorf("Error while expiring entitlement of user %d: %v", entitlements[i].UserID, err)
+
+			continue
+		}
+
+		expired++
+	}
+
+	return expired, nil
+}
+
+// UserAccessRank returns the rank of the highest access level a user may read (see types.AccessLevel).
+//
+// Parameters:
+//   - user (models.User): The authenticated user.
+//
+// Returns:
+//   - int: The rank of the active plan of the user (0 without plan).
+func UserAccessRank(user models.User) int {
+	entitlement, err := GetUserEntitlement(user.ID)
+	if err != nil || !isEntitlementActive(*entitlement, time.Now()) {
+		return 0
+	}
+
+	return entitlement.Plan.Rank()
+}
+
+// IsLocked checks whether the full content of an article is hidden to readers of an access rank.
+//
+// Parameters:
+//   - article (models.Article): The article.
+//   - accessRank (int): The rank of the reader plan (0 for guests).
+//
+// Returns:
+//   - bool: True when only the teaser must be shown.
+func IsLocked(article models.Article, accessRank int) bool {
+	return article.AccessLevel.Rank() > accessRank
+}
+
+// ArticleTeaser cuts the sanitized content of an article at a block boundary after `TEASER_LENGTH`
+// characters of text (600 by default). A content without such a boundary, like plain text, is cut
+// after `TEASER_LENGTH` characters, so a locked article never shows its full content.
+//
+// Parameters:
+//   - article (models.Article): The article.
+//
+// Returns:
+//   - string: The teaser HTML.
+func ArticleTeaser(article models.Article) string {
+	teaser, _ := appUtils.TeaserHTML(article.Content, utils.Getenv("TEASER_LENGTH", 600))
+
+	return teaser
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// isEntitlementActive checks that an entitlement hasn't expired (an entitlement without expiry never expires).
+func isEntitlementActive(entitlement models.UserEntitlement, now time.Time) bool {
+	return !entitlement.ExpiresAt.Valid || entitlement.ExpiresAt.Time.After(now)
+}
@@ -0,0 +1,455 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/events"
+	appUtils "gfly/app/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/try"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// ExperimentBuckets number of visitor buckets of A/B tests. Divisible by every number of variants (2 to 4),
+// so the variants of an article get the same share of visitors.
+const ExperimentBuckets = 12
+
+// controlVariant key of the control variant (the article as is)
+const controlVariant = "a"
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// FindArticleVariants retrieves the A/B test variants of an article, control first.
+//
+// Parameters:
+//   - articleID (int): The ID of the article.
+//
+// Returns:
+//   - ([]models.ArticleVariant, error): The variants ordered by key (none without running test) and any error encountered.
+func FindArticleVariants(articleID int) ([]models.ArticleVariant, error) {
+	var variants []models.ArticleVariant
+
+	_, err := mb.Instance().Select("*").
+		Where(models.TableArticleVariant+".article_id", qb.Eq, articleID).
+		OrderBy(models.TableArticleVariant+".key", qb.Asc).
+		Find(&variants)
+
+	return variants, err
+}
+
+// SaveArticleVariants starts an A/B test of an article, replacing the running one (counts are reset).
+// The control variant `a` serves the current title and cover image, alternatives get the keys `b` to `d`.
+//
+// Parameters:
+//   - variantsDto (dto.SaveArticleVariants): The alternatives of the article.
+//
+// Returns:
+//   - ([]models.ArticleVariant, error): The saved variants, control first, or an error if any step fails.
+//
+// Possible Errors:
+//   - "Article not found": Returned when the article doesn't exist.
+//   - "Variant %s must change the title or the cover image": Returned for an alternative without change.
+func SaveArticleVariants(variantsDto dto.SaveArticleVariants) ([]models.ArticleVariant, error) {
+	article, err := GetArticleByID(variantsDto.ArticleID)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := []models.ArticleVariant{{ArticleID: article.ID, Key: controlVariant, CreatedAt: time.Now()}}
+
+	for i, payload := range variantsDto.Variants {
+		variant := models.ArticleVariant{
+			ArticleID:  article.ID,
+			Key:        string(rune(controlVariant[0] + byte(i+1))),
+			Title:      optionalString(strings.TrimSpace(payload.Title)),
+			CoverImage: optionalString(strings.TrimSpace(payload.CoverImage)),
+			CreatedAt:  time.Now(),
+		}
+
+		if (!variant.Title.Valid || variant.Title.String == article.Title) &&
+			(!variant.CoverImage.Valid || variant.CoverImage.String == article.CoverImage.String) {
+			return nil, errors.New("Variant %s must change the title or the cover image", variant.Key)
+		}
+
+		variants = append(variants, variant)
+	}
+
+	if err = deleteArticleVariants(article.ID); err != nil {
+		return nil, err
+	}
+
+	for i := range variants {
+		if err = mb.CreateModel(&variants[i]); err != nil {
+			log.Errorf("Error while saving variant %s of article %d: %v", variants[i].Key, article.ID, err)
+
+			return nil, errors.New("Error occurs while saving article variants")
+		}
+	}
+
+	InvalidateArticleResponses(*article)
+
+	return variants, nil
+}
+
+// StopArticleExperiment removes the A/B test of an article, the article keeps its title and cover image.
+//
+// Parameters:
+//   - articleID (int): The ID of the article.
+//
+// Returns:
+//   - error: "Article not found", "Experiment not found" or an error if the variants can't be removed.
+func StopArticleExperiment(articleID int) error {
+	article, err := GetArticleByID(articleID)
+	if err != nil {
+		return err
+	}
+
+	if variants, err := FindArticleVariants(articleID); err != nil || len(variants) == 0 {
+		return errors.New("Experiment not found")
+	}
+
+	if err = deleteArticleVariants(articleID); err != nil {
+		return err
+	}
+
+	InvalidateArticleResponses(*article)
+
+	return nil
+}
+
+// DeclareVariantWinner ends the A/B test of an article and writes the title and cover image of the winning
+// variant to the article. Declaring the control keeps the article as is.
+//
+// Parameters:
+//   - articleID (int): The ID of the article.
+//   - key (string): The key of the winning variant.
+//
+// Returns:
+//   - (*models.Article, error): The updated article or an error if any step fails.
+//
+// Possible Errors:
+//   - "Article not found": Returned when the article doesn't exist.
+//   - "Variant not found": Returned when the article has no variant with the key.
+func DeclareVariantWinner(articleID int, key string) (*models.Article, error) {
+	article, err := GetArticleByID(articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := FindArticleVariants(articleID)
+	if err != nil {
+		return nil, errors.New("Variant not found")
+	}
+
+	var winner *models.ArticleVariant
+	for i := range variants {
+		if variants[i].Key == strings.ToLower(key) {
+			winner = &variants[i]
+		}
+	}
+
+	if winner == nil {
+		return nil, errors.New("Variant not found")
+	}
+
+	if winner.Title.Valid || winner.CoverImage.Valid {
+		*article = VariantArticle(*article, *winner)
+		article.UpdatedAt = dbNull.Time(time.Now())
+
+		if err = mb.UpdateModel(article); err != nil {
+			log.Errorf("Error while writing variant %s to article %d: %v", winner.Key, articleID, err)
+
+			return nil, errors.New("Error occurs while updating article")
+		}
+	}
+
+	if err = deleteArticleVariants(articleID); err != nil {
+		return nil, err
+	}
+
+	events.Dispatch(events.ArticleUpdated{Article: *article})
+
+	return article, nil
+}
+
+// FindVariantsOfArticles retrieves the A/B test variants of listed articles.
+//
+// Parameters:
+//   - articles ([]models.Article): The listed articles.
+//
+// Returns:
+//   - map[int][]models.ArticleVariant: The variants by article ID, control first (articles without test are left out).
+func FindVariantsOfArticles(articles []models.Article) map[int][]models.ArticleVariant {
+	variantsByArticle := map[int][]models.ArticleVariant{}
+	if len(articles) == 0 {
+		return variantsByArticle
+	}
+
+	ids := make([]int, len(articles))
+	for i, article := range articles {
+		ids[i] = article.ID
+	}
+
+	var variants []models.ArticleVariant
+	if _, err := mb.Instance().Select("*").
+		Where(models.TableArticleVariant+".article_id", qb.In, ids).
+		OrderBy(models.TableArticleVariant+".key", qb.Asc).
+		Find(&variants); err != nil {
+		log.Warnf("Failed to load article variants: %v", err)
+
+		return variantsByArticle
+	}
+
+	for _, variant := range variants {
+		variantsByArticle[variant.ArticleID] = append(variantsByArticle[variant.ArticleID], variant)
+	}
+
+	return variantsByArticle
+}
+
+// AssignVariant picks the variant of an article served to a visitor bucket (see ExperimentBuckets).
+// The article ID shifts the buckets, so a visitor doesn't get the same arm in every test.
+//
+// Parameters:
+//   - articleID (int): The ID of the article.
+//   - variants ([]models.ArticleVariant): The variants of the article, control first.
+//   - bucket (int): The bucket of the visitor.
+//
+// Returns:
+//   - (models.ArticleVariant, bool): The assigned variant, false when the article has no running test.
+func AssignVariant(articleID int, variants []models.ArticleVariant, bucket int) (models.ArticleVariant, bool) {
+	if len(variants) < 2 {
+		return models.ArticleVariant{}, false
+	}
+
+	return variants[(bucket+articleID)%len(variants)], true
+}
+
+// VariantArticle applies the title and cover image of a variant to an article.
+//
+// Parameters:
+//   - article (models.Article): The article.
+//   - variant (models.ArticleVariant): The served variant.
+//
+// Returns:
+//   - models.Article: The article with the title and cover image of the variant.
+func VariantArticle(article models.Article, variant models.ArticleVariant) models.Article {
+	if variant.Title.Valid {
+		article.Title = variant.Title.String
+	}
+
+	if variant.CoverImage.Valid {
+		article.CoverImage = variant.CoverImage
+	}
+
+	return article
+}
+
+// VariantTag builds the response cache tag of a served variant, used to count impressions of cached lists.
+//
+// Parameters:
+//   - variantID (int): The variant ID.
+//
+// Returns:
+//   - string: The tag (e.g. "variant:7").
+func VariantTag(variantID int) string {
+	return fmt.Sprintf("variant:%d", variantID)
+}
+
+// TaggedVariantIDs returns the variants of a response tagged with VariantTag.
+//
+// Parameters:
+//   - tags ([]string): The dependency tags of a cached response.
+//
+// Returns:
+//   - []int: The variant IDs.
+func TaggedVariantIDs(tags []string) []int {
+	var variantIDs []int
+
+	for _, tag := range tags {
+		var variantID int
+		if _, err := fmt.Sscanf(tag, "variant:%d", &variantID); err == nil && variantID > 0 {
+			variantIDs = append(variantIDs, variantID)
+		}
+	}
+
+	return variantIDs
+}
+
+// RecordVariantImpressions counts the impressions of variants once per visitor within the `VIEW_DEDUP_WINDOW`
+// (minutes, 30 by default). Counts are accumulated in Redis and written to the database by FlushVariantCounts.
+//
+// Parameters:
+//   - visitorID (string): The experiment identifier of the visitor (user ID or cookie).
+//   - variantIDs (...int): The variants listed to the visitor.
+func RecordVariantImpressions(visitorID string, variantIDs ...int) {
+	for _, variantID := range variantIDs {
+		recordVariantEvent(visitorID, variantID, "impressions")
+	}
+}
+
+// RecordVariantClick counts the click of a variant once per visitor within the `VIEW_DEDUP_WINDOW`.
+//
+// Parameters:
+//   - visitorID (string): The experiment identifier of the visitor (user ID or cookie).
+//   - variantID (int): The variant the visitor opened.
+func RecordVariantClick(visitorID string, variantID int) {
+	recordVariantEvent(visitorID, variantID, "clicks")
+}
+
+// FlushVariantCounts writes the impressions and clicks accumulated in Redis to the database
+// (same flow as FlushArticleViews).
+//
+// Returns:
+//   - (int, error): The number of variants updated and any error encountered.
+func FlushVariantCounts() (int, error) {
+	return flushRedisCounts("variants", func(field string, count int) error {
+		// Field `<variant ID>:impressions` or `<variant ID>:clicks`
+		idValue, column, _ := strings.Cut(field, ":")
+		variantID, _ := strconv.Atoi(idValue)
+
+		if variantID < 1 || (column != "impressions" && column != "clicks") {
+			return nil
+		}
+
+		if err := incrementVariantCount(variantID, column, count); err != nil {
+			log.Errorf("Failed to flush %d %s of variant %d: %v", count, column, variantID, err)
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+// ArticleExperimentStats computes the click-through rate of the variants of an article and the confidence
+// that each alternative performs differently from the control (two-proportion z-test).
+//
+// The leader is the variant with the best click-through rate. It is significant when an alternative leads
+// with `EXPERIMENT_CONFIDENCE` (0.95 by default), or when the control leads every alternative with it.
+//
+// Parameters:
+//   - articleID (int): The ID of the article.
+//
+// Returns:
+//   - (*dto.ArticleExperiment, error): The results, or "Article not found" / "Experiment not found".
+func ArticleExperimentStats(articleID int) (*dto.ArticleExperiment, error) {
+	if _, err := GetArticleByID(articleID); err != nil {
+		return nil, err
+	}
+
+	variants, err := FindArticleVariants(articleID)
+	if err != nil || len(variants) == 0 {
+		return nil, errors.New("Experiment not found")
+	}
+
+	experiment := &dto.ArticleExperiment{
+		ArticleID:  articleID,
+		Variants:   make([]dto.VariantStats, len(variants)),
+		Confidence: utils.Getenv("EXPERIMENT_CONFIDENCE", 0.95),
+	}
+
+	control := variants[0]
+	controlCTR := appUtils.ClickThroughRate(control.Clicks, control.Impressions)
+	leader := 0
+	controlSignificant := true
+
+	for i, variant := range variants {
+		stats := dto.VariantStats{
+			Variant: variant,
+			CTR:     appUtils.ClickThroughRate(variant.Clicks, variant.Impressions),
+		}
+
+		if i > 0 {
+			confidence := appUtils.ProportionConfidence(control.Clicks, control.Impressions, variant.Clicks, variant.Impressions)
+			stats.Confidence = &confidence
+			controlSignificant = controlSignificant && confidence >= experiment.Confidence
+
+			if controlCTR > 0 {
+				lift := stats.CTR/controlCTR - 1
+				stats.Lift = &lift
+			}
+
+			if stats.CTR > experiment.Variants[leader].CTR {
+				leader = i
+			}
+		}
+
+		experiment.Variants[i] = stats
+	}
+
+	if experiment.Variants[leader].CTR > 0 {
+		experiment.Leader = variants[leader].Key
+
+		if leader == 0 {
+			experiment.Significant = controlSignificant
+		} else {
+			experiment.Significant = *experiment.Variants[leader].Confidence >= experiment.Confidence
+		}
+	}
+
+	return experiment, nil
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// recordVariantEvent counts an impression or a click of a variant once per visitor.
+func recordVariantEvent(visitorID string, variantID int, column string) {
+	ctx := context.Background()
+	client := redisClient()
+	window := time.Duration(utils.Getenv("VIEW_DEDUP_WINDOW", 30)) * time.Minute
+
+	isNew, err := client.SetNX(ctx, redisKey("variants:seen:%s:%d:%s", column, variantID, visitorID), 1, window).Result()
+	if err == nil && isNew {
+		err = client.HIncrBy(ctx, redisKey("variants:pending"), fmt.Sprintf("%d:%s", variantID, column), 1).Err()
+	}
+
+	if err != nil {
+		log.Warnf("Failed to record %s of variant %d: %v", column, variantID, err)
+	}
+}
+
+// incrementVariantCount atomically adds impressions or clicks to a variant.
+func incrementVariantCount(variantID int, column string, count int) (err error) {
+	try.Perform(func() {
+		_ = mb.Instance().
+			Raw("UPDATE "+models.TableArticleVariant+" SET "+column+" = "+column+" + $1 WHERE id = $2", count, variantID).
+			Update(&models.ArticleVariant{})
+	}).Catch(func(e try.E) {
+		err = errors.New("%v", e)
+	})
+
+	return
+}
+
+// deleteArticleVariants removes the variants of an article.
+func deleteArticleVariants(articleID int) error {
+	variants, err := FindArticleVariants(articleID)
+	if err != nil {
+		return errors.New("Error occurs while removing article variants")
+	}
+
+	for i := range variants {
+		if err = mb.DeleteModel(&variants[i]); err != nil {
+			log.Errorf("Error while removing variant %s of article %d: %v", variants[i].Key, articleID, err)
+
+			return errors.New("Error occurs while removing article variants")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"time"
+
+	"github.com/gflydev/cache"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	"github.com/gflydev/storage"
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// FindFeedArticles retrieves the latest published articles to syndicate in a feed.
+//
+// Parameters:
+//   - scope (dto.FeedScope): Restrict the feed to an author or a category. Use the zero scope for the site-wide feed.
+//
+// Returns:
+//   - ([]models.Article, error): The latest published articles, newest first, and any error encountered.
+func FindFeedArticles(scope dto.FeedScope) ([]models.Article, error) {
+	filter := dto.ArticleFilter{
+		Filter: dto.Filter{
+			Page:    1,
+			PerPage: utils.Getenv("FEED_ITEMS", 20),
+			OrderBy: "-published_at",
+		},
+		Status:   types.ArticleStatusPublished,
+		AuthorID: scope.AuthorID,
+	}
+
+	if scope.Category != nil {
+		filter.CategoryID = scope.Category.ID
+	}
+
+	articles, _, err := FindArticles(filter)
+
+	return articles, err
+}
+
+// FeedCacheKey builds the cache key of a feed document.
+//
+// Parameters:
+//   - format (dto.FeedFormat): The feed format.
+//   - scope (dto.FeedScope): The author or the category of the feed. Use the zero scope for the site-wide feed.
+//
+// Returns:
+//   - string: The cache key (e.g. "feeds:articles:rss", "feeds:authors:1:articles:atom" or "feeds:categories:2:articles:rss").
+func FeedCacheKey(format dto.FeedFormat, scope dto.FeedScope) string {
+	if scope.Category != nil {
+		return fmt.Sprintf("feeds:categories:%d:articles:%s", scope.Category.ID, format)
+	}
+
+	if scope.AuthorID > 0 {
+		return fmt.Sprintf("feeds:authors:%d:articles:%s", scope.AuthorID, format)
+	}
+
+	return fmt.Sprintf("feeds:articles:%s", format)
+}
+
+// FeedMediaSize returns the size of a media file attached to a feed item (`length` of an RSS enclosure).
+//
+// Parameters:
+//   - mediaPath (string): The media reference of an article, e.g. its cover image.
+//
+// Returns:
+//   - int64: The size in bytes, 0 when it's unknown (remote URL or missing file).
+func FeedMediaSize(mediaPath string) int64 {
+	if !isStoragePath(mediaPath) {
+		return 0
+	}
+
+	fs := storage.Instance()
+	if !fs.Exists(mediaPath) {
+		return 0
+	}
+
+	return max(fs.Size(mediaPath), 0)
+}
+
+// FeedItems prepares articles to be syndicated. Feeds are public: age-rated stories are listed without
+// their content and members-only stories are cut to their teaser.
+//
+// Parameters:
+//   - articles ([]models.Article): The articles of the feed, newest first.
+//   - fullContent (bool): Embed the content of the articles, not only their excerpt.
+//
+// Returns:
+//   - []dto.FeedItem: The articles with their syndicated content and the size of their cover image.
+func FeedItems(articles []models.Article, fullContent bool) []dto.FeedItem {
+	items := make([]dto.FeedItem, 0, len(articles))
+
+	for _, article := range articles {
+		item := dto.FeedItem{Article: article}
+
+		if fullContent && article.AgeRating == 0 {
+			item.Content = article.Content
+			if IsLocked(article, 0) {
+				item.Content = ArticleTeaser(article)
+			}
+		}
+
+		if article.CoverImage.Valid && article.CoverImage.String != "" {
+			item.CoverSize = FeedMediaSize(article.CoverImage.String)
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// GetCachedFeed reads a rendered feed document from cache.
+//
+// Parameters:
+//   - key (string): The cache key built by FeedCacheKey.
+//
+// Returns:
+//   - (*dto.Feed, bool): The cached feed and true on a cache hit, otherwise nil and false.
+func GetCachedFeed(key string) (*dto.Feed, bool) {
+	val, err := cache.Get(key)
+	if err != nil || val == nil {
+		return nil, false
+	}
+
+	var feed dto.Feed
+	if err = json.Unmarshal([]byte(fmt.Sprint(val)), &feed); err != nil {
+		log.Warnf("Invalid cached feed `%s`: %v", key, err)
+
+		return nil, false
+	}
+
+	return &feed, true
+}
+
+// CacheFeed stores a rendered feed document in cache.
+// The TTL is taken from `FEED_CACHE_TTL` (minutes, 60 by default).
+//
+// Parameters:
+//   - key (string): The cache key built by FeedCacheKey.
+//   - feed (dto.Feed): The rendered feed.
+//
+// Returns:
+//   - error: An error if the feed could not be encoded or stored.
+func CacheFeed(key string, feed dto.Feed) error {
+	data, err := json.Marshal(feed)
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Duration(utils.Getenv("FEED_CACHE_TTL", 60)) * time.Minute
+
+	return cache.Set(key, string(data), ttl)
+}
+
+// InvalidateArticleFeeds removes every cached feed that may contain the given article:
+// the site-wide feeds, the feeds of the article's author and the category feeds. All the category feeds are removed,
+// the article may just have left a category.
+//
+// Parameters:
+//   - article (models.Article): The article that was published, updated or removed.
+func InvalidateArticleFeeds(article models.Article) {
+	scopes := []dto.FeedScope{{}, {AuthorID: article.AuthorID}}
+
+	categories, err := FindCategories()
+	if err != nil {
+		log.Warnf("Failed to load categories to invalidate feeds: %v", err)
+	}
+
+	for i := range categories {
+		scopes = append(scopes, dto.FeedScope{Category: &categories[i]})
+	}
+
+	deleteFeeds(scopes...)
+}
+
+// InvalidateCategoryFeeds removes the cached feeds of a category.
+//
+// Parameters:
+//   - category (models.Category): The category that was created or removed.
+func InvalidateCategoryFeeds(category models.Category) {
+	deleteFeeds(dto.FeedScope{Category: &category})
+}
+
+// deleteFeeds removes the cached RSS and Atom feeds of the given scopes.
+func deleteFeeds(scopes ...dto.FeedScope) {
+	for _, format := range []dto.FeedFormat{dto.FeedFormatRSS, dto.FeedFormatAtom} {
+		for _, scope := range scopes {
+			key := FeedCacheKey(format, scope)
+			if err := cache.Del(key); err != nil {
+				log.Warnf("Failed to invalidate feed `%s`: %v", key, err)
+			}
+		}
+	}
+}
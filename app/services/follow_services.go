@@ -0,0 +1,477 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/notifications"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gflydev/console"
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/try"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+	"github.com/gflydev/notification"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// NewStoryTask name of the queue task notifying followers of a new story (see queues.NewStoryTask)
+const NewStoryTask = "follows:new_story"
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// FindFollows retrieves the categories and authors followed by a user, latest first.
+//
+// Parameters:
+//   - userID (int): The ID of the user.
+//
+// Returns:
+//   - ([]dto.FollowItem, error): The follows with the names of their targets and any error encountered.
+func FindFollows(userID int) ([]dto.FollowItem, error) {
+	var follows []models.Follow
+
+	if _, err := mb.Instance().Select("*").
+		Where(models.TableFollow+".user_id", qb.Eq, userID).
+		OrderBy(models.TableFollow+".created_at", qb.Desc).
+		Find(&follows); err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.FollowItem, 0, len(follows))
+	for _, follow := range follows {
+		item, err := followItem(follow)
+		if err != nil {
+			// Deleted author
+			continue
+		}
+
+		items = append(items, *item)
+	}
+
+	return items, nil
+}
+
+// FollowTarget makes a user follow a category or an author. Following twice keeps the first follow.
+//
+// Parameters:
+//   - followDto (dto.Follow): The follower and the followed target.
+//
+// Returns:
+//   - (*dto.FollowItem, error): The follow or an error if any step fails.
+//
+// Possible Errors:
+//   - "Category not found" / "Author not found": Returned when the target doesn't exist.
+//   - "You can't follow yourself": Returned when a user follows themselves as an author.
+func FollowTarget(followDto dto.Follow) (*dto.FollowItem, error) {
+	if followDto.TargetType == types.FollowTypeAuthor && followDto.TargetID == followDto.UserID {
+		return nil, errors.New("You can't follow yourself")
+	}
+
+	follow, err := getFollow(followDto.UserID, followDto.TargetType, followDto.TargetID)
+	if err != nil {
+		follow = &models.Follow{
+			UserID:     followDto.UserID,
+			TargetType: followDto.TargetType,
+			TargetID:   followDto.TargetID,
+			CreatedAt:  time.Now(),
+		}
+	}
+
+	item, err := followItem(*follow)
+	if err != nil {
+		return nil, err
+	}
+
+	if follow.ID == 0 {
+		if err = mb.CreateModel(follow); err != nil {
+			log.Errorf("Error while following %s %d: %v", followDto.TargetType, followDto.TargetID, err)
+
+			return nil, errors.New("Error occurs while following")
+		}
+
+		item.Follow = *follow
+	}
+
+	return item, nil
+}
+
+// Unfollow removes a follow of a user.
+//
+// Parameters:
+//   - userID (int): The ID of the user.
+//   - targetType (types.FollowType): The type of the followed target.
+//   - targetID (int): The ID of the followed category or author.
+//
+// Returns:
+//   - error: "Follow not found" or an error if the follow can't be removed.
+func Unfollow(userID int, targetType types.FollowType, targetID int) error {
+	follow, err := getFollow(userID, targetType, targetID)
+	if err != nil {
+		return err
+	}
+
+	if err = mb.DeleteModel(follow); err != nil {
+		log.Errorf("Error while unfollowing %s %d: %v", targetType, targetID, err)
+
+		return errors.New("Error occurs while unfollowing")
+	}
+
+	return nil
+}
+
+// GetNotificationPreferences retrieves the notification channels of a user.
+// Users who never chose get both channels.
+//
+// Parameters:
+//   - userID (int): The ID of the user.
+//
+// Returns:
+//   - models.NotificationPreference: The preferences of the user.
+func GetNotificationPreferences(userID int) models.NotificationPreference {
+	preference, err := mb.GetModel[models.NotificationPreference](qb.Condition{
+		Field: models.TableNotificationPreference + ".user_id",
+		Opt:   qb.Eq,
+		Value: userID,
+	})
+	if err != nil || preference == nil {
+		return models.NotificationPreference{
+			UserID: userID,
+			Email:  true,
+			InApp:  true,
+		}
+	}
+
+	return *preference
+}
+
+// SaveNotificationPreferences updates the notification channels of a user.
+//
+// Parameters:
+//   - preferencesDto (dto.NotificationPreferences): The channels to change, missing ones are kept.
+//
+// Returns:
+//   - (*models.NotificationPreference, error): The saved preferences or an error if any step fails.
+func SaveNotificationPreferences(preferencesDto dto.NotificationPreferences) (*models.NotificationPreference, error) {
+	preference := GetNotificationPreferences(preferencesDto.UserID)
+
+	if preferencesDto.Email != nil {
+		preference.Email = *preferencesDto.Email
+	}
+
+	if preferencesDto.InApp != nil {
+		preference.InApp = *preferencesDto.InApp
+	}
+
+	var err error
+	if preference.ID == 0 {
+		preference.CreatedAt = time.Now()
+		err = mb.CreateModel(&preference)
+	} else {
+		preference.UpdatedAt = dbNull.Time(time.Now())
+		err = mb.UpdateModel(&preference)
+	}
+
+	if err != nil {
+		log.Errorf("Error while saving notification preferences of user %d: %v", preferencesDto.UserID, err)
+
+		return nil, errors.New("Error occurs while saving notification preferences")
+	}
+
+	return &preference, nil
+}
+
+// FindNotifications retrieves a page of the in-app notifications of a user, latest first.
+//
+// Parameters:
+//   - userID (int): The ID of the user.
+//   - filterDto (dto.Filter): The page and per-page details.
+//
+// Returns:
+//   - ([]dto.NotificationItem, int, error): The notifications with their stories, the total number of
+//     notifications and any error encountered.
+func FindNotifications(userID int, filterDto dto.Filter) ([]dto.NotificationItem, int, error) {
+	var notificationList []models.Notification
+	offset := 0
+
+	if filterDto.Page > 0 {
+		offset = (filterDto.Page - 1) * filterDto.PerPage
+	}
+
+	total, err := mb.Instance().Select("*").
+		Where(models.TableNotification+".user_id", qb.Eq, userID).
+		OrderBy(models.TableNotification+".created_at", qb.Desc).
+		OrderBy(models.TableNotification+".id", qb.Desc).
+		Limit(filterDto.PerPage, offset).
+		Find(&notificationList)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(notificationList) == 0 {
+		return []dto.NotificationItem{}, total, nil
+	}
+
+	ids := make([]int, len(notificationList))
+	for i, item := range notificationList {
+		ids[i] = item.ArticleID
+	}
+
+	var articles []models.Article
+	if _, err = mb.Instance().Select("*").
+		Where(models.TableArticle+".id", qb.In, ids).
+		Find(&articles); err != nil {
+		return nil, 0, err
+	}
+
+	items := make([]dto.NotificationItem, 0, len(notificationList))
+	for _, item := range notificationList {
+		index := slices.IndexFunc(articles, func(article models.Article) bool { return article.ID == item.ArticleID })
+		if index < 0 {
+			continue
+		}
+
+		items = append(items, dto.NotificationItem{
+			Notification: item,
+			Article:      articles[index],
+		})
+	}
+
+	return items, total, nil
+}
+
+// MarkNotificationsRead marks all in-app notifications of a user as read.
+//
+// Parameters:
+//   - userID (int): The ID of the user.
+//
+// Returns:
+//   - error: An error if the notifications can't be updated.
+func MarkNotificationsRead(userID int) (err error) {
+	try.Perform(func() {
+		_ = mb.Instance().
+			Raw("UPDATE "+models.TableNotification+" SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL", time.Now(), userID).
+			Update(&models.Notification{})
+	}).Catch(func(e try.E) {
+		err = errors.New("%v", e)
+	})
+
+	return
+}
+
+// NotifyNewStory notifies a batch of followers of a new story through the channels each one chose:
+// an in-app notification and/or an email. Notifying a follower twice (retried task) duplicates neither
+// the in-app notification nor the email: each email is recorded in Redis for `FOLLOW_EMAIL_DEDUP_DAYS`
+// days (7 by default) before it's sent.
+//
+// Parameters:
+//   - notice (dto.NewStoryNotice): The new story and the followers to notify.
+//
+// Returns:
+//   - error: An error when the story or the followers can't be loaded, or an in-app notification can't be saved.
+func NotifyNewStory(notice dto.NewStoryNotice) error {
+	article, err := GetArticleByID(notice.ArticleID)
+	if err != nil {
+		return err
+	}
+
+	// Unpublished or deleted since
+	if article.Status != types.ArticleStatusPublished || article.DeletedAt.Valid || len(notice.UserIDs) == 0 {
+		return nil
+	}
+
+	var users []models.User
+	if _, err = mb.Instance().Select("*").
+		Where(models.TableUser+".id", qb.In, notice.UserIDs).
+		Find(&users); err != nil {
+		return err
+	}
+
+	var preferences []models.NotificationPreference
+	if _, err = mb.Instance().Select("*").
+		Where(models.TableNotificationPreference+".user_id", qb.In, notice.UserIDs).
+		Find(&preferences); err != nil {
+		return err
+	}
+
+	storyURL := fmt.Sprintf("%s/truyen/%s", strings.TrimSuffix(core.AppURL, "/"), article.Slug)
+	var failed []int
+
+	for _, user := range users {
+		if user.DeletedAt.Valid || user.BlockedAt.Valid {
+			continue
+		}
+
+		preference := models.NotificationPreference{Email: true, InApp: true}
+		if index := slices.IndexFunc(preferences, func(p models.NotificationPreference) bool { return p.UserID == user.ID }); index >= 0 {
+			preference = preferences[index]
+		}
+
+		if preference.InApp {
+			if err = saveNewStoryNotification(user.ID, article.ID); err != nil {
+				log.Errorf("Failed to notify user %d of article %d: %v", user.ID, article.ID, err)
+				failed = append(failed, user.ID)
+
+				continue
+			}
+		}
+
+		if !preference.Email {
+			continue
+		}
+
+		// Already emailed by a previous attempt
+		isNew, err := markNewStoryEmail(user.ID, article.ID)
+		if err != nil {
+			log.Errorf("Failed to record the email of user %d about article %d: %v", user.ID, article.ID, err)
+			failed = append(failed, user.ID)
+
+			continue
+		}
+
+		if !isNew {
+			continue
+		}
+
+		if err = notification.Send(notifications.NewStory{
+			Email:      user.Email,
+			Fullname:   user.Fullname,
+			Title:      article.Title,
+			Excerpt:    article.Excerpt.String,
+			CoverImage: article.CoverImage.String,
+			URL:        storyURL,
+		}); err != nil {
+			log.Warnf("Failed to email user %d about article %d: %v", user.ID, article.ID, err)
+
+			// Let a retried task send it
+			unmarkNewStoryEmail(user.ID, article.ID)
+		}
+	}
+
+	if len(failed) > 0 {
+		return errors.New("Failed to notify %d followers of article %d", len(failed), article.ID)
+	}
+
+	return nil
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// markNewStoryEmail records the new story email of a follower, it returns false when it was already recorded.
+func markNewStoryEmail(userID, articleID int) (bool, error) {
+	window := time.Duration(utils.Getenv("FOLLOW_EMAIL_DEDUP_DAYS", 7)) * 24 * time.Hour
+
+	return redisClient().SetNX(context.Background(), redisKey("follows:emailed:%d:%d", articleID, userID), 1, window).Result()
+}
+
+// unmarkNewStoryEmail forgets the new story email of a follower which couldn't be sent.
+func unmarkNewStoryEmail(userID, articleID int) {
+	redisClient().Del(context.Background(), redisKey("follows:emailed:%d:%d", articleID, userID))
+}
+
+// getFollow retrieves a follow of a user.
+func getFollow(userID int, targetType types.FollowType, targetID int) (*models.Follow, error) {
+	var follows []models.Follow
+
+	_, err := mb.Instance().Select("*").
+		Where(models.TableFollow+".user_id", qb.Eq, userID).
+		Where(models.TableFollow+".target_type", qb.Eq, targetType).
+		Where(models.TableFollow+".target_id", qb.Eq, targetID).
+		Limit(1, 0).
+		Find(&follows)
+	if err != nil || len(follows) == 0 {
+		return nil, errors.New("Follow not found")
+	}
+
+	return &follows[0], nil
+}
+
+// followItem adds the name of the followed category or author to a follow.
+func followItem(follow models.Follow) (*dto.FollowItem, error) {
+	item := &dto.FollowItem{Follow: follow}
+
+	switch follow.TargetType {
+	case types.FollowTypeCategory:
+		category, err := GetCategoryByID(follow.TargetID)
+		if err != nil {
+			return nil, err
+		}
+
+		item.Name = category.Name
+		item.Slug = category.Slug
+	case types.FollowTypeAuthor:
+		author, err := mb.GetModelByID[models.User](follow.TargetID)
+		if err != nil || author == nil || author.DeletedAt.Valid {
+			return nil, errors.New("Author not found")
+		}
+
+		item.Name = author.Fullname
+	}
+
+	return item, nil
+}
+
+// QueueNewStoryNotifications queues the notification of the followers of the author and of the categories
+// of a story published for the first time, in batches of `FOLLOW_NOTIFY_BATCH_SIZE` followers (100 by default).
+func QueueNewStoryNotifications(article models.Article) {
+	var follows []models.Follow
+
+	try.Perform(func() {
+		_, _ = mb.Instance().Raw(
+			"SELECT DISTINCT ON (f.user_id) f.* FROM "+models.TableFollow+" f "+
+				"WHERE f.user_id <> $1 AND ("+
+				"(f.target_type = $2 AND f.target_id = $1) OR "+
+				"(f.target_type = $3 AND f.target_id IN (SELECT ac.category_id FROM "+models.TableArticleCategory+" ac WHERE ac.article_id = $4))"+
+				") ORDER BY f.user_id",
+			article.AuthorID, types.FollowTypeAuthor, types.FollowTypeCategory, article.ID,
+		).Find(&follows)
+	}).Catch(func(e try.E) {
+		log.Errorf("Failed to load the followers of article %d: %v", article.ID, e)
+	})
+
+	batchSize := max(utils.Getenv("FOLLOW_NOTIFY_BATCH_SIZE", 100), 1)
+
+	for start := 0; start < len(follows); start += batchSize {
+		batch := follows[start:min(start+batchSize, len(follows))]
+
+		userIDs := make([]int, len(batch))
+		for i, follow := range batch {
+			userIDs[i] = follow.UserID
+		}
+
+		console.DispatchTask(dto.NewStoryNotice{
+			ArticleID: article.ID,
+			UserIDs:   userIDs,
+		}, NewStoryTask)
+	}
+}
+
+// saveNewStoryNotification creates the in-app notification of a new story unless the user already has it.
+func saveNewStoryNotification(userID, articleID int) error {
+	existing, err := mb.GetModel[models.Notification](
+		qb.Condition{Field: models.TableNotification + ".user_id", Opt: qb.Eq, Value: userID},
+		qb.Condition{Field: models.TableNotification + ".type", Opt: qb.Eq, Value: types.NotificationTypeNewStory},
+		qb.Condition{Field: models.TableNotification + ".article_id", Opt: qb.Eq, Value: articleID},
+	)
+	if err == nil && existing != nil {
+		return nil
+	}
+
+	return mb.CreateModel(&models.Notification{
+		UserID:    userID,
+		Type:      types.NotificationTypeNewStory,
+		ArticleID: articleID,
+		CreatedAt: time.Now(),
+	})
+}
@@ -0,0 +1,724 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/domain/repository"
+	"gfly/app/dto"
+	"gfly/app/events"
+	"gfly/app/utils"
+	"io"
+	"net/mail"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gflydev/cache"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	coreUtils "github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	"github.com/gflydev/storage"
+	"github.com/gflydev/validation"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// ImportDir directory of uploaded import files waiting to be processed, relative to the storage root.
+const ImportDir = "app/imports"
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// ParseImportFile reads the article rows of an import file.
+//
+// Supported formats:
+//   - json: an array of articles (or an object with an `articles` array) using the CreateArticle field names
+//     plus `author_email`, `author_name`, `published_at`, `categories` and `tags`.
+//   - csv: a header row with the same field names. `categories` and `tags` are separated by `|`.
+//   - wxr: a WordPress eXtended RSS export. Only posts are imported; pages, attachments and trashed posts are skipped.
+//
+// Parameters:
+//   - format (dto.ImportFormat): The file format.
+//   - data ([]byte): The file content.
+//
+// Returns:
+//   - ([]dto.ImportArticle, error): The rows and any parsing error.
+func ParseImportFile(format dto.ImportFormat, data []byte) ([]dto.ImportArticle, error) {
+	switch format {
+	case dto.ImportFormatJSON:
+		return parseImportJSON(data)
+	case dto.ImportFormatCSV:
+		return parseImportCSV(data)
+	case dto.ImportFormatWXR:
+		return parseImportWXR(data)
+	}
+
+	return nil, errors.New("Unsupported import format %q", format)
+}
+
+// ImportArticles creates articles from import rows.
+//
+// For each row the slug is derived from the title when missing and suffixed (-2, -3...) when it collides with
+// an existing article or an earlier row. Authors are matched by `author_email` and created (pending, member role)
+// when missing; rows without author email use `options.AuthorID`. Categories are matched by the slug of their name
+// and created when missing. Tags aren't imported, stories have no tags: they are listed in the ignored fields.
+// Imported articles keep their publishing time and only dispatch ArticleCreated (Imported set), so followers
+// and webhooks aren't notified of old stories. Rows failing validation are logged in the report and skipped.
+// In dry-run mode nothing is written but the report describes what would happen.
+//
+// Parameters:
+//   - rows ([]dto.ImportArticle): The rows read by ParseImportFile.
+//   - options (dto.ImportOptions): The import options.
+//   - report (*dto.ImportReport): The report to fill in.
+//   - progress (func(dto.ImportReport)): Called periodically with the report in progress (optional).
+func ImportArticles(rows []dto.ImportArticle, options dto.ImportOptions, report *dto.ImportReport, progress func(dto.ImportReport)) {
+	report.DryRun = options.DryRun
+	report.Total = len(rows)
+	report.Status = dto.ImportStatusRunning
+	report.Errors = []dto.ImportRowError{}
+	report.CreatedAuthors = []string{}
+	report.Authors = []dto.ImportAuthor{}
+	report.CreatedCategories = []string{}
+
+	resolved := importResolution{
+		authors:    map[string]int{},
+		categories: map[string]int{},
+		usedSlugs:  map[string]bool{},
+	}
+
+	for i, row := range rows {
+		rowNumber := i + 1
+
+		if err := importArticle(row, options, report, resolved); err != nil {
+			report.Failed++
+			report.Errors = append(report.Errors, dto.ImportRowError{
+				Row:     rowNumber,
+				Slug:    row.Slug,
+				Message: err.Error(),
+			})
+		}
+
+		if len(row.Tags) > 0 && !slices.Contains(report.IgnoredFields, "tags") {
+			report.IgnoredFields = append(report.IgnoredFields, "tags")
+		}
+
+		report.Processed = rowNumber
+
+		if progress != nil && (rowNumber%50 == 0 || rowNumber == len(rows)) {
+			progress(*report)
+		}
+	}
+
+	report.Status = dto.ImportStatusCompleted
+}
+
+// RunImport parses an import file, imports its rows and keeps the stored report up to date.
+//
+// Parameters:
+//   - report (dto.ImportReport): The report created by NewImportReport.
+//   - options (dto.ImportOptions): The import options.
+//   - data ([]byte): The file content.
+//
+// Returns:
+//   - dto.ImportReport: The final report.
+func RunImport(report dto.ImportReport, options dto.ImportOptions, data []byte) dto.ImportReport {
+	saveProgress := func(progress dto.ImportReport) {
+		if err := SaveImportReport(progress); err != nil {
+			log.Warnf("Failed to save report of import %s: %v", progress.ID, err)
+		}
+	}
+
+	rows, err := ParseImportFile(options.Format, data)
+	if err != nil {
+		report.Status = dto.ImportStatusFailed
+		report.Message = err.Error()
+		saveProgress(report)
+
+		return report
+	}
+
+	ImportArticles(rows, options, &report, saveProgress)
+	saveProgress(report)
+
+	log.Infof("Import %s: %d created, %d failed of %d rows (dry run: %v)",
+		report.ID, report.Created, report.Failed, report.Total, report.DryRun)
+
+	return report
+}
+
+// StoreImportFile keeps an uploaded import file in storage until a queue worker processes it.
+//
+// Parameters:
+//   - id (string): The import ID.
+//   - format (dto.ImportFormat): The file format.
+//   - data ([]byte): The file content.
+//
+// Returns:
+//   - (string, error): The storage path of the file and any error encountered.
+func StoreImportFile(id string, format dto.ImportFormat, data []byte) (string, error) {
+	fs := storage.Instance()
+	fs.MakeDir(ImportDir)
+
+	path := fmt.Sprintf("%s/%s.%s", ImportDir, id, format)
+	if !fs.PutData(path, data) {
+		return "", errors.New("Unable to store import file %s", path)
+	}
+
+	return path, nil
+}
+
+// NewImportReport creates the report of a new import with a unique ID.
+//
+// Returns:
+//   - dto.ImportReport: A queued import report.
+func NewImportReport() dto.ImportReport {
+	return dto.ImportReport{
+		ID:                strconv.FormatInt(time.Now().UnixNano(), 10),
+		Status:            dto.ImportStatusQueued,
+		CreatedAuthors:    []string{},
+		Authors:           []dto.ImportAuthor{},
+		CreatedCategories: []string{},
+		Errors:            []dto.ImportRowError{},
+	}
+}
+
+// SaveImportReport stores the report of an import so that its progress can be followed. Reports expire after 7 days.
+//
+// Parameters:
+//   - report (dto.ImportReport): The import report.
+//
+// Returns:
+//   - error: An error if the report could not be stored.
+func SaveImportReport(report dto.ImportReport) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	return cache.Set("imports:"+report.ID, string(data), 7*24*time.Hour)
+}
+
+// GetImportReport reads the report of an import.
+//
+// Parameters:
+//   - id (string): The import ID.
+//
+// Returns:
+//   - (*dto.ImportReport, error): The report, or "Import not found".
+func GetImportReport(id string) (*dto.ImportReport, error) {
+	val, err := cache.Get("imports:" + id)
+	if err != nil || val == nil {
+		return nil, errors.New("Import not found")
+	}
+
+	var report dto.ImportReport
+	if err = json.Unmarshal([]byte(fmt.Sprint(val)), &report); err != nil {
+		return nil, errors.New("Import not found")
+	}
+
+	return &report, nil
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// importResolution authors, categories and slugs already resolved by an import, shared by its rows.
+type importResolution struct {
+	authors    map[string]int  // User ID by author email, 0 for an author a dry run would create
+	categories map[string]int  // Category ID by slug, 0 for a category a dry run would create
+	usedSlugs  map[string]bool // Article slugs taken by earlier rows
+}
+
+// importArticle validates and creates a single import row.
+func importArticle(row dto.ImportArticle, options dto.ImportOptions, report *dto.ImportReport, resolved importResolution) error {
+	article := row.CreateArticle
+
+	if article.Status == "" {
+		article.Status = types.ArticleStatusDraft
+	}
+
+	// Author
+	pendingAuthor := false
+	if row.AuthorEmail != "" {
+		authorID, err := resolveImportAuthor(row, options.DryRun, report, resolved.authors)
+		if err != nil {
+			return err
+		}
+		article.AuthorID = authorID
+		pendingAuthor = authorID == 0
+	} else {
+		article.AuthorID = options.AuthorID
+	}
+
+	// Slug
+	baseSlug := utils.Slugify(article.Slug)
+	if baseSlug == "" {
+		baseSlug = utils.Slugify(article.Title)
+	}
+
+	if baseSlug != "" {
+		article.Slug = uniqueImportSlug(baseSlug, resolved.usedSlugs)
+		if article.Slug != baseSlug {
+			report.RenamedSlugs++
+		}
+	}
+
+	// Categories
+	categories, err := importCategoryNames(row.Categories)
+	if err != nil {
+		return err
+	}
+
+	// Validation
+	if errorData, err := validation.Check(article, dto.MsgForTag); err != nil {
+		if len(errorData) == 0 {
+			return err
+		}
+
+		// An author created by the import has no ID yet in a dry run
+		if pendingAuthor {
+			delete(errorData, "author_id")
+		}
+
+		if len(errorData) > 0 {
+			var messages []string
+			for field, fieldMessages := range errorData {
+				fieldErrors, _ := fieldMessages.([]string)
+				messages = append(messages, fmt.Sprintf("%s: %s", field, strings.Join(fieldErrors, ", ")))
+			}
+			sort.Strings(messages)
+
+			return errors.New("%s", strings.Join(messages, "; "))
+		}
+	}
+
+	article.CategoryIDs, err = resolveImportCategories(categories, options.DryRun, report, resolved.categories)
+	if err != nil {
+		return err
+	}
+
+	if options.DryRun {
+		report.Created++
+
+		return nil
+	}
+
+	// Keep the original publishing time. Old stories don't notify followers nor webhooks.
+	created, err := createArticle(article, row.PublishedAt)
+	if err != nil {
+		return err
+	}
+
+	events.Dispatch(events.ArticleCreated{Article: *created, Imported: true})
+
+	report.Created++
+
+	return nil
+}
+
+// resolveImportAuthor finds the author of an import row by email and creates it when missing.
+// A dry run doesn't create the author, its ID is 0.
+func resolveImportAuthor(row dto.ImportArticle, dryRun bool, report *dto.ImportReport, authors map[string]int) (int, error) {
+	email := strings.ToLower(strings.TrimSpace(row.AuthorEmail))
+
+	if authorID, ok := authors[email]; ok {
+		return authorID, nil
+	}
+
+	if _, err := mail.ParseAddress(email); err != nil {
+		return 0, errors.New("author_email: invalid email %q", row.AuthorEmail)
+	}
+
+	if user := repository.Pool.GetUserByEmail(email); user != nil {
+		authors[email] = user.ID
+		report.Authors = append(report.Authors, dto.ImportAuthor{Email: email, UserID: user.ID})
+
+		return user.ID, nil
+	}
+
+	if dryRun {
+		authors[email] = 0
+		report.CreatedAuthors = append(report.CreatedAuthors, email)
+		report.Authors = append(report.Authors, dto.ImportAuthor{Email: email, Created: true})
+
+		return 0, nil
+	}
+
+	fullname := row.AuthorName
+	if fullname == "" {
+		fullname = strings.Split(email, "@")[0]
+	}
+
+	user, err := CreateUser(dto.CreateUser{
+		Email:    email,
+		Password: coreUtils.Token(),
+		Fullname: fullname,
+		Status:   string(types.UserStatusPending),
+		Roles:    []types.Role{types.RoleMember},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	authors[email] = user.ID
+	report.CreatedAuthors = append(report.CreatedAuthors, email)
+	report.Authors = append(report.Authors, dto.ImportAuthor{Email: email, UserID: user.ID, Created: true})
+
+	return user.ID, nil
+}
+
+// importCategoryNames checks the category names of an import row and returns them by slug, without duplicates.
+func importCategoryNames(names []string) (map[string]string, error) {
+	categories := map[string]string{}
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+
+		slug := utils.Slugify(name)
+		if slug == "" {
+			continue
+		}
+
+		if len([]rune(name)) > 100 || len(slug) > 120 {
+			return nil, errors.New("categories: %q is longer than 100 characters", name)
+		}
+
+		if _, ok := categories[slug]; !ok {
+			categories[slug] = name
+		}
+	}
+
+	if len(categories) > maxArticleCategories {
+		return nil, errors.New("categories: at most %d categories", maxArticleCategories)
+	}
+
+	return categories, nil
+}
+
+// resolveImportCategories finds the categories of an import row by slug and creates the missing ones.
+// A dry run doesn't create the categories, they are left out of the returned IDs.
+func resolveImportCategories(categories map[string]string, dryRun bool, report *dto.ImportReport, categoryIDs map[string]int) ([]int, error) {
+	slugs := make([]string, 0, len(categories))
+	for slug := range categories {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	var ids []int
+	for _, slug := range slugs {
+		categoryID, ok := categoryIDs[slug]
+		if !ok {
+			if category, err := GetCategoryBySlug(slug); err == nil {
+				categoryID = category.ID
+			} else if !dryRun {
+				category, err := CreateCategory(dto.CreateCategory{Name: categories[slug], Slug: slug})
+				if err != nil {
+					return nil, err
+				}
+
+				categoryID = category.ID
+				report.CreatedCategories = append(report.CreatedCategories, categories[slug])
+			} else {
+				report.CreatedCategories = append(report.CreatedCategories, categories[slug])
+			}
+
+			categoryIDs[slug] = categoryID
+		}
+
+		if categoryID > 0 {
+			ids = append(ids, categoryID)
+		}
+	}
+
+	return ids, nil
+}
+
+// uniqueImportSlug suffixes a slug until it collides neither with an existing article nor with an earlier row.
+func uniqueImportSlug(baseSlug string, usedSlugs map[string]bool) string {
+	slug := baseSlug
+
+	for suffix := 2; ; suffix++ {
+		if !usedSlugs[slug] {
+			existing, err := mb.GetModel[models.Article](qb.Condition{
+				Field: models.TableArticle + ".slug",
+				Opt:   qb.Eq,
+				Value: slug,
+			})
+
+			if err != nil || existing == nil {
+				usedSlugs[slug] = true
+
+				return slug
+			}
+		}
+
+		slug = fmt.Sprintf("%s-%d", baseSlug, suffix)
+	}
+}
+
+// parseImportTime parses the time formats found in import files.
+func parseImportTime(value string) time.Time {
+	value = strings.TrimSpace(value)
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// ---------------------- JSON ------------------------
+
+// importJSONRow struct to describe a JSON row, `published_at` is parsed like in the other formats.
+type importJSONRow struct {
+	dto.ImportArticle
+	PublishedAt string `json:"published_at"`
+}
+
+// parseImportJSON reads rows from a JSON array or an object with an `articles` array.
+func parseImportJSON(data []byte) ([]dto.ImportArticle, error) {
+	var jsonRows []importJSONRow
+
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		var wrapper struct {
+			Articles []importJSONRow `json:"articles"`
+		}
+
+		if err := json.Unmarshal(data, &wrapper); err != nil {
+			return nil, errors.New("Invalid JSON file: %v", err)
+		}
+
+		jsonRows = wrapper.Articles
+	} else if err := json.Unmarshal(data, &jsonRows); err != nil {
+		return nil, errors.New("Invalid JSON file: %v", err)
+	}
+
+	rows := make([]dto.ImportArticle, len(jsonRows))
+	for i, jsonRow := range jsonRows {
+		rows[i] = jsonRow.ImportArticle
+		rows[i].PublishedAt = parseImportTime(jsonRow.PublishedAt)
+	}
+
+	return rows, nil
+}
+
+// ---------------------- CSV ------------------------
+
+// parseImportCSV reads rows from a CSV file with a header row.
+func parseImportCSV(data []byte) ([]dto.ImportArticle, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("Invalid CSV file: %v", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	var rows []dto.ImportArticle
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, errors.New("Invalid CSV file at line %d: %v", line, err)
+		}
+
+		value := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+
+			return ""
+		}
+
+		list := func(name string) []string {
+			var items []string
+			for _, item := range strings.Split(value(name), "|") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+
+			return items
+		}
+
+		authorID, _ := strconv.Atoi(value("author_id"))
+
+		rows = append(rows, dto.ImportArticle{
+			CreateArticle: dto.CreateArticle{
+				Title:          value("title"),
+				Slug:           value("slug"),
+				Excerpt:        value("excerpt"),
+				Content:        value("content"),
+				CoverImage:     value("cover_image"),
+				Status:         types.ArticleStatus(value("status")),
+				SEODescription: value("seo_description"),
+				SEOKeywords:    value("seo_keywords"),
+				AuthorID:       authorID,
+				YouTubeURL:     value("youtube_url"),
+				TikTokURL:      value("tiktok_url"),
+			},
+			AuthorEmail: value("author_email"),
+			AuthorName:  value("author_name"),
+			PublishedAt: parseImportTime(value("published_at")),
+			Categories:  list("categories"),
+			Tags:        list("tags"),
+		})
+	}
+
+	return rows, nil
+}
+
+// ---------------------- WordPress WXR ------------------------
+
+// wxrDocument struct to describe a WordPress eXtended RSS export.
+type wxrDocument struct {
+	Channel struct {
+		Authors []wxrAuthor `xml:"author"`
+		Items   []wxrItem   `xml:"item"`
+	} `xml:"channel"`
+}
+
+// wxrAuthor struct to describe an author of a WordPress export (`wp:author`).
+type wxrAuthor struct {
+	Login       string `xml:"author_login"`
+	Email       string `xml:"author_email"`
+	DisplayName string `xml:"author_display_name"`
+}
+
+// wxrItem struct to describe a post, page or attachment of a WordPress export.
+type wxrItem struct {
+	Title         string        `xml:"title"`
+	Creator       string        `xml:"creator"`
+	Encoded       []wxrEncoded  `xml:"encoded"`
+	PostID        int           `xml:"post_id"`
+	PostName      string        `xml:"post_name"`
+	PostDateGMT   string        `xml:"post_date_gmt"`
+	Status        string        `xml:"status"`
+	PostType      string        `xml:"post_type"`
+	AttachmentURL string        `xml:"attachment_url"`
+	Categories    []wxrCategory `xml:"category"`
+	PostMeta      []wxrPostMeta `xml:"postmeta"`
+}
+
+// wxrEncoded struct to describe `content:encoded` and `excerpt:encoded` elements.
+type wxrEncoded struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// wxrCategory struct to describe a category or tag of a post.
+type wxrCategory struct {
+	Domain string `xml:"domain,attr"`
+	Name   string `xml:",chardata"`
+}
+
+// wxrPostMeta struct to describe a custom field of a post (`wp:postmeta`).
+type wxrPostMeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+// wxrStatuses maps WordPress post statuses to article statuses.
+var wxrStatuses = map[string]types.ArticleStatus{
+	"publish": types.ArticleStatusPublished,
+	"draft":   types.ArticleStatusDraft,
+	"pending": types.ArticleStatusDraft,
+	"future":  types.ArticleStatusDraft,
+	"private": types.ArticleStatusArchived,
+}
+
+// parseImportWXR reads the posts of a WordPress export.
+func parseImportWXR(data []byte) ([]dto.ImportArticle, error) {
+	var document wxrDocument
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+
+	if err := decoder.Decode(&document); err != nil {
+		return nil, errors.New("Invalid WXR file: %v", err)
+	}
+
+	authors := map[string]wxrAuthor{}
+	for _, author := range document.Channel.Authors {
+		authors[author.Login] = author
+	}
+
+	attachments := map[string]string{}
+	for _, item := range document.Channel.Items {
+		if item.PostType == "attachment" {
+			attachments[strconv.Itoa(item.PostID)] = item.AttachmentURL
+		}
+	}
+
+	var rows []dto.ImportArticle
+
+	for _, item := range document.Channel.Items {
+		status, ok := wxrStatuses[item.Status]
+		if item.PostType != "post" || !ok {
+			continue
+		}
+
+		row := dto.ImportArticle{
+			CreateArticle: dto.CreateArticle{
+				Title:  strings.TrimSpace(item.Title),
+				Slug:   item.PostName,
+				Status: status,
+			},
+			PublishedAt: parseImportTime(item.PostDateGMT),
+		}
+
+		for _, encoded := range item.Encoded {
+			switch {
+			case strings.Contains(encoded.XMLName.Space, "excerpt"):
+				row.Excerpt = strings.TrimSpace(encoded.Value)
+			case strings.Contains(encoded.XMLName.Space, "content"):
+				row.Content = encoded.Value
+			}
+		}
+
+		if author, ok := authors[item.Creator]; ok {
+			row.AuthorEmail = author.Email
+			row.AuthorName = author.DisplayName
+		}
+
+		for _, category := range item.Categories {
+			switch category.Domain {
+			case "category":
+				row.Categories = append(row.Categories, category.Name)
+			case "post_tag":
+				row.Tags = append(row.Tags, category.Name)
+			}
+		}
+
+		for _, meta := range item.PostMeta {
+			if meta.Key == "_thumbnail_id" && attachments[meta.Value] != "" {
+				row.CoverImage = attachments[meta.Value]
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,469 @@
+package services
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/notifications"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/try"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+	"github.com/gflydev/notification"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// confirmResendDelay minimum delay between two confirmation emails of a pending subscriber
+const confirmResendDelay = 10 * time.Minute
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// Subscribe registers an email address to the weekly digest and sends the confirmation link (double opt-in).
+// Subscribing an active address changes nothing, an unsubscribed address has to confirm again.
+//
+// Parameters:
+//   - subscribeDto (dto.Subscribe): The email address and the signed-in user, if any. The subscription is
+//     linked to the user only when the address is the user's own.
+//
+// Returns:
+//   - error: An error if the subscription can't be saved or the confirmation email can't be sent.
+func Subscribe(subscribeDto dto.Subscribe) error {
+	email := strings.ToLower(strings.TrimSpace(subscribeDto.Email))
+	now := time.Now()
+
+	subscriber, err := mb.GetModel[models.Subscriber](qb.Condition{
+		Field: models.TableSubscriber + ".email",
+		Opt:   qb.Eq,
+		Value: email,
+	})
+	if err != nil || subscriber == nil {
+		subscriber = &models.Subscriber{
+			Email:            email,
+			Status:           types.SubscriberStatusPending,
+			UnsubscribeToken: newsletterToken(),
+			CreatedAt:        now,
+		}
+	}
+
+	// Only the owner of the address links the subscription to their account
+	linked := subscribeDto.UserID > 0 && ownsEmail(subscribeDto.UserID, email)
+	if linked {
+		subscriber.UserID = dbNull.Int32(int32(subscribeDto.UserID))
+	}
+
+	switch {
+	case subscriber.Status == types.SubscriberStatusActive:
+		// Already confirmed
+		if subscriber.ID > 0 && linked {
+			subscriber.UpdatedAt = dbNull.Time(now)
+			if err = mb.UpdateModel(subscriber); err != nil {
+				log.Warnf("Failed to link subscriber %d to user %d: %v", subscriber.ID, subscribeDto.UserID, err)
+			}
+		}
+
+		return nil
+	case subscriber.Status == types.SubscriberStatusPending && subscriber.ConfirmSentAt.Valid &&
+		now.Sub(subscriber.ConfirmSentAt.Time) < confirmResendDelay:
+		// The confirmation link was just sent
+		return nil
+	}
+
+	subscriber.Status = types.SubscriberStatusPending
+	subscriber.ConfirmToken = dbNull.String(newsletterToken())
+	subscriber.ConfirmSentAt = dbNull.Time(now)
+	subscriber.UnsubscribedAt = sql.NullTime{}
+
+	if subscriber.ID == 0 {
+		err = mb.CreateModel(subscriber)
+	} else {
+		subscriber.UpdatedAt = dbNull.Time(now)
+		err = mb.UpdateModel(subscriber)
+	}
+
+	if err != nil {
+		log.Errorf("Error while saving subscriber %s: %v", email, err)
+
+		return errors.New("Error occurs while saving the subscription")
+	}
+
+	if err = notification.Send(notifications.ConfirmSubscription{
+		Email: email,
+		URL:   NewsletterURL("confirm", subscriber.ConfirmToken.String),
+	}); err != nil {
+		log.Errorf("Error while sending the confirmation email to %s: %v", email, err)
+
+		return errors.New("Error occurs while sending the confirmation email")
+	}
+
+	return nil
+}
+
+// ConfirmSubscription activates the subscription of a confirmation link.
+// Links expire after `NEWSLETTER_CONFIRM_TTL` hours (48 by default).
+//
+// Parameters:
+//   - token (string): The confirmation token.
+//
+// Returns:
+//   - (*models.Subscriber, error): The active subscriber, "Invalid confirmation link" or "Confirmation link expired".
+func ConfirmSubscription(token string) (*models.Subscriber, error) {
+	subscriber, err := subscriberByToken("confirm_token", token)
+	if err != nil {
+		return nil, errors.New("Invalid confirmation link")
+	}
+
+	ttl := time.Duration(utils.Getenv("NEWSLETTER_CONFIRM_TTL", 48)) * time.Hour
+	if !subscriber.ConfirmSentAt.Valid || time.Since(subscriber.ConfirmSentAt.Time) > ttl {
+		return nil, errors.New("Confirmation link expired")
+	}
+
+	now := time.Now()
+	subscriber.Status = types.SubscriberStatusActive
+	subscriber.ConfirmToken = sql.NullString{}
+	subscriber.ConfirmedAt = dbNull.Time(now)
+	subscriber.UpdatedAt = dbNull.Time(now)
+
+	if err = mb.UpdateModel(subscriber); err != nil {
+		log.Errorf("Error while confirming subscriber %d: %v", subscriber.ID, err)
+
+		return nil, errors.New("Error occurs while confirming the subscription")
+	}
+
+	return subscriber, nil
+}
+
+// GetSubscriberByUnsubscribeToken retrieves the subscriber of an unsubscribe link, without unsubscribing.
+//
+// Parameters:
+//   - token (string): The unsubscribe token.
+//
+// Returns:
+//   - (*models.Subscriber, error): The subscriber or "Invalid unsubscribe link".
+func GetSubscriberByUnsubscribeToken(token string) (*models.Subscriber, error) {
+	subscriber, err := subscriberByToken("unsubscribe_token", token)
+	if err != nil {
+		return nil, errors.New("Invalid unsubscribe link")
+	}
+
+	return subscriber, nil
+}
+
+// Unsubscribe ends the subscription of a one-click unsubscribe link. The link keeps working afterwards.
+//
+// Parameters:
+//   - token (string): The unsubscribe token.
+//
+// Returns:
+//   - (*models.Subscriber, error): The unsubscribed subscriber or "Invalid unsubscribe link".
+func Unsubscribe(token string) (*models.Subscriber, error) {
+	subscriber, err := subscriberByToken("unsubscribe_token", token)
+	if err != nil {
+		return nil, errors.New("Invalid unsubscribe link")
+	}
+
+	if subscriber.Status == types.SubscriberStatusUnsubscribed {
+		return subscriber, nil
+	}
+
+	now := time.Now()
+	subscriber.Status = types.SubscriberStatusUnsubscribed
+	subscriber.ConfirmToken = sql.NullString{}
+	subscriber.UnsubscribedAt = dbNull.Time(now)
+	subscriber.UpdatedAt = dbNull.Time(now)
+
+	if err = mb.UpdateModel(subscriber); err != nil {
+		log.Errorf("Error while unsubscribing subscriber %d: %v", subscriber.ID, err)
+
+		return nil, errors.New("Error occurs while unsubscribing")
+	}
+
+	return subscriber, nil
+}
+
+// NewsletterURL builds the link of a newsletter action sent by email.
+//
+// Parameters:
+//   - action (string): "confirm" or "unsubscribe".
+//   - token (string): The token of the subscriber.
+//
+// Returns:
+//   - string: The link (e.g. "https://example.com/newsletter/unsubscribe?token=...").
+func NewsletterURL(action, token string) string {
+	return fmt.Sprintf("%s/newsletter/%s?token=%s", strings.TrimSuffix(core.AppURL, "/"), action, url.QueryEscape(token))
+}
+
+// DigestKey returns the ISO week of a weekly digest, a subscriber gets one digest per week.
+//
+// Parameters:
+//   - t (time.Time): A time of the week.
+//
+// Returns:
+//   - string: The ISO week (e.g. "2026-W42").
+func DigestKey(t time.Time) string {
+	year, week := t.ISOWeek()
+
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// WeeklyDigestItems lists the most viewed stories published during the last 7 days
+// (`DIGEST_ITEMS`, 5 by default). Age-rated stories are left out of emails.
+//
+// Returns:
+//   - ([]dto.DigestItem, error): The stories of the digest and any error encountered.
+func WeeklyDigestItems() ([]dto.DigestItem, error) {
+	limit := utils.Getenv("DIGEST_ITEMS", 5)
+
+	articles, _, err := FindArticles(dto.ArticleFilter{
+		Filter: dto.Filter{
+			Page:    1,
+			PerPage: limit * 2,
+			OrderBy: "-view_count",
+		},
+		Status:        types.ArticleStatusPublished,
+		PublishedFrom: time.Now().AddDate(0, 0, -7).Format(time.DateOnly),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appURL := strings.TrimSuffix(core.AppURL, "/")
+	items := make([]dto.DigestItem, 0, limit)
+
+	for _, article := range articles {
+		if article.AgeRating > 0 || len(items) == limit {
+			continue
+		}
+
+		items = append(items, dto.DigestItem{
+			Title:      article.Title,
+			Excerpt:    article.Excerpt.String,
+			CoverImage: article.CoverImage.String,
+			URL:        fmt.Sprintf("%s/truyen/%s", appURL, article.Slug),
+		})
+	}
+
+	return items, nil
+}
+
+// QueueDigestDeliveries creates the deliveries of a digest for the active subscribers who don't have one yet,
+// in batches of `DIGEST_BATCH_SIZE` recipients (100 by default). Running it twice in a week doesn't send twice.
+//
+// Parameters:
+//   - digest (string): The ISO week of the digest (see DigestKey).
+//
+// Returns:
+//   - ([][]int, error): The delivery IDs by batch and any error encountered.
+func QueueDigestDeliveries(digest string) (batches [][]int, err error) {
+	batchSize := utils.Getenv("DIGEST_BATCH_SIZE", 100)
+	lastID := 0
+
+	var deliveryIDs []int
+
+	try.Perform(func() {
+		for {
+			var subscribers []models.Subscriber
+
+			_, _ = mb.Instance().Raw(
+				"SELECT s.* FROM "+models.TableSubscriber+" s "+
+					"WHERE s.status = $1 AND s.id > $2 AND NOT EXISTS ("+
+					"SELECT 1 FROM "+models.TableDigestDelivery+" d WHERE d.digest = $3 AND d.subscriber_id = s.id"+
+					") ORDER BY s.id LIMIT $4",
+				types.SubscriberStatusActive, lastID, digest, batchSize,
+			).Find(&subscribers)
+
+			if len(subscribers) == 0 {
+				return
+			}
+
+			for _, subscriber := range subscribers {
+				lastID = subscriber.ID
+
+				delivery := &models.DigestDelivery{
+					Digest:       digest,
+					SubscriberID: subscriber.ID,
+					Status:       types.DeliveryStatusQueued,
+					CreatedAt:    time.Now(),
+				}
+
+				if err := mb.CreateModel(delivery); err != nil {
+					log.Errorf("Error while queuing digest %s of subscriber %d: %v", digest, subscriber.ID, err)
+
+					continue
+				}
+
+				deliveryIDs = append(deliveryIDs, delivery.ID)
+			}
+		}
+	}).Catch(func(e try.E) {
+		log.Errorf("Error while queuing digest %s: %v", digest, e)
+		err = errors.New("Error occurs while queuing the digest")
+	})
+
+	// Deliveries which couldn't be created leave no empty batch
+	return DigestBatches(deliveryIDs, batchSize), err
+}
+
+// SendDigestDelivery sends the digest of a delivery and records the result. Deliveries already sent,
+// given up or of unsubscribed readers are skipped. A delivery is given up after `DIGEST_MAX_ATTEMPTS` (3 by default).
+//
+// Parameters:
+//   - deliveryID (int): The ID of the delivery.
+//   - items ([]dto.DigestItem): The stories of the digest.
+//
+// Returns:
+//   - error: The sending error when the delivery should be retried.
+func SendDigestDelivery(deliveryID int, items []dto.DigestItem) error {
+	delivery, err := mb.GetModelByID[models.DigestDelivery](deliveryID)
+	if err != nil || delivery == nil || !IsDigestDeliveryPending(*delivery) {
+		return nil
+	}
+
+	delivery.Attempts++
+	delivery.UpdatedAt = dbNull.Time(time.Now())
+
+	subscriber, err := mb.GetModelByID[models.Subscriber](delivery.SubscriberID)
+	if err != nil || subscriber == nil || subscriber.Status != types.SubscriberStatusActive {
+		delivery.Status = types.DeliveryStatusFailed
+		delivery.Error = dbNull.String("Subscriber is no longer active")
+
+		return saveDigestDelivery(delivery)
+	}
+
+	err = notification.Send(notifications.WeeklyDigest{
+		Email:          subscriber.Email,
+		Week:           delivery.Digest,
+		Items:          items,
+		UnsubscribeURL: NewsletterURL("unsubscribe", subscriber.UnsubscribeToken),
+	})
+
+	err = RecordDigestAttempt(delivery, err, utils.Getenv("DIGEST_MAX_ATTEMPTS", 3), time.Now())
+
+	if saveErr := saveDigestDelivery(delivery); saveErr != nil {
+		return saveErr
+	}
+
+	return err
+}
+
+// DigestBatches splits the queued deliveries of a digest into the batches of the digest tasks.
+//
+// Parameters:
+//   - deliveryIDs ([]int): The IDs of the queued deliveries.
+//   - batchSize (int): The maximum number of recipients of a batch.
+//
+// Returns:
+//   - [][]int: The delivery IDs by batch, none is empty.
+func DigestBatches(deliveryIDs []int, batchSize int) [][]int {
+	var batches [][]int
+
+	for batchSize > 0 && len(deliveryIDs) > 0 {
+		size := min(batchSize, len(deliveryIDs))
+		batches = append(batches, deliveryIDs[:size])
+		deliveryIDs = deliveryIDs[size:]
+	}
+
+	return batches
+}
+
+// IsDigestDeliveryPending checks that a delivery still has to be sent. Sent and given up deliveries are
+// skipped, so a retried batch doesn't send twice.
+//
+// Parameters:
+//   - delivery (models.DigestDelivery): The delivery.
+//
+// Returns:
+//   - bool: True when the delivery is queued.
+func IsDigestDeliveryPending(delivery models.DigestDelivery) bool {
+	return delivery.Status == types.DeliveryStatusQueued
+}
+
+// RecordDigestAttempt records the result of a sending attempt on a delivery. A failed delivery stays queued
+// to be retried, until it's given up after maxAttempts attempts.
+//
+// Parameters:
+//   - delivery (*models.DigestDelivery): The delivery, its attempt already counted.
+//   - sendErr (error): The sending error, nil when sent.
+//   - maxAttempts (int): The attempts before giving up (DIGEST_MAX_ATTEMPTS).
+//   - now (time.Time): The time of the attempt.
+//
+// Returns:
+//   - error: The sending error when the delivery should be retried, nil once sent or given up.
+func RecordDigestAttempt(delivery *models.DigestDelivery, sendErr error, maxAttempts int, now time.Time) error {
+	if sendErr == nil {
+		delivery.Status = types.DeliveryStatusSent
+		delivery.Error = sql.NullString{}
+		delivery.SentAt = dbNull.Time(now)
+
+		return nil
+	}
+
+	delivery.Error = dbNull.String(truncateText(sendErr.Error(), 255))
+	if delivery.Attempts >= maxAttempts {
+		delivery.Status = types.DeliveryStatusFailed
+
+		return nil
+	}
+
+	return sendErr
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// newsletterToken generates a random token of confirmation and unsubscribe links.
+func newsletterToken() string {
+	random := make([]byte, 32)
+	_, _ = rand.Read(random)
+
+	return hex.EncodeToString(random)
+}
+
+// ownsEmail checks that an email address is the address of a user account.
+func ownsEmail(userID int, email string) bool {
+	user, err := mb.GetModelByID[models.User](userID)
+
+	return err == nil && user != nil && strings.EqualFold(user.Email, email)
+}
+
+// subscriberByToken finds the subscriber of a confirmation or unsubscribe token.
+func subscriberByToken(column, token string) (*models.Subscriber, error) {
+	if token == "" {
+		return nil, errors.New("Subscriber not found")
+	}
+
+	subscriber, err := mb.GetModel[models.Subscriber](qb.Condition{
+		Field: models.TableSubscriber + "." + column,
+		Opt:   qb.Eq,
+		Value: token,
+	})
+	if err != nil || subscriber == nil {
+		return nil, errors.New("Subscriber not found")
+	}
+
+	return subscriber, nil
+}
+
+// saveDigestDelivery updates the state of a delivery.
+func saveDigestDelivery(delivery *models.DigestDelivery) error {
+	if err := mb.UpdateModel(delivery); err != nil {
+		log.Errorf("Error while updating digest delivery %d: %v", delivery.ID, err)
+
+		return errors.New("Error occurs while updating the digest delivery")
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"gfly/app/domain/models"
+	"slices"
+	"strings"
+
+	"github.com/gflydev/core/errors"
+)
+
+// fieldSet struct to describe the fields a list API can return.
+type fieldSet struct {
+	table    string              // Table of the columns
+	columns  map[string][]string // Response field => table columns needed to build it
+	card     []string            // Default fields of list APIs
+	required []string            // Columns always selected (ID, sort keys)
+}
+
+// articleFields fields of the article list APIs.
+var articleFields = fieldSet{
+	table: models.TableArticle,
+	columns: map[string][]string{
+		"id":               {"id"},
+		"title":            {"title"},
+		"slug":             {"slug"},
+		"excerpt":          {"excerpt"},
+		"content":          {"content"},
+		"cover_image":      {"cover_image"},
+		"status":           {"status"},
+		"seo_description":  {"seo_description"},
+		"seo_keywords":     {"seo_keywords"},
+		"author_id":        {"author_id"},
+		"published_at":     {"published_at"},
+		"youtube_url":      {"youtube_url"},
+		"tiktok_url":       {"tiktok_url"},
+		"videos":           {"youtube_url", "tiktok_url"},
+		"view_count":       {"view_count"},
+		"content_warnings": {"content_warnings"},
+		"age_rating":       {"age_rating"},
+		"age_gated":        {"age_rating"},
+		"access_level":     {"access_level"},
+		"locked":           {"access_level"},
+		"created_at":       {"created_at"},
+		"updated_at":       {"updated_at"},
+		"locale":           {},
+		"variant":          {},
+	},
+	card: []string{
+		"id", "title", "slug", "excerpt", "cover_image", "status", "author_id",
+		"published_at", "videos", "view_count", "content_warnings", "age_rating", "age_gated",
+		"access_level", "locked", "created_at", "updated_at", "locale", "variant",
+	},
+	// The age rating and the access level decide whether the content can be returned
+	required: []string{"id", "title", "slug", "status", "published_at", "age_rating", "access_level", "created_at"},
+}
+
+// userFields fields of the user list API.
+var userFields = fieldSet{
+	table: models.TableUser,
+	columns: map[string][]string{
+		"id":             {"id"},
+		"email":          {"email"},
+		"fullname":       {"fullname"},
+		"phone":          {"phone"},
+		"token":          {"token"},
+		"status":         {"status"},
+		"avatar":         {"avatar"},
+		"created_at":     {"created_at"},
+		"updated_at":     {"updated_at"},
+		"verified_at":    {"verified_at"},
+		"blocked_at":     {"blocked_at"},
+		"deleted_at":     {"deleted_at"},
+		"last_access_at": {"last_access_at"},
+		"birthdate":      {"birthdate"},
+		"roles":          {},
+	},
+	card: []string{
+		"id", "email", "fullname", "phone", "status", "avatar", "roles", "created_at", "last_access_at",
+	},
+	required: []string{"id", "email", "fullname", "phone", "status", "last_access_at"},
+}
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// ArticleListFields resolves the `fields` query parameter of the article list APIs.
+//
+// Parameters:
+//   - fields (string): Comma separated field names. Empty for the default card fields (everything but content and SEO).
+//
+// Returns:
+//   - ([]string, error): The field names, or an error naming an unknown field.
+func ArticleListFields(fields string) ([]string, error) {
+	return articleFields.resolve(fields)
+}
+
+// UserListFields resolves the `fields` query parameter of the user list API.
+//
+// Parameters:
+//   - fields (string): Comma separated field names. Empty for the default card fields.
+//
+// Returns:
+//   - ([]string, error): The field names, or an error naming an unknown field.
+func UserListFields(fields string) ([]string, error) {
+	return userFields.resolve(fields)
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// resolve parses comma separated field names. The card fields are used when there is no field.
+func (s fieldSet) resolve(fields string) ([]string, error) {
+	if strings.TrimSpace(fields) == "" {
+		return s.card, nil
+	}
+
+	var names []string
+
+	for _, name := range strings.Split(fields, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || slices.Contains(names, name) {
+			continue
+		}
+
+		if _, ok := s.columns[name]; !ok {
+			return nil, errors.New("Unknown field %s", name)
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// selectColumns lists the columns to select for the given fields (all columns when there is no field).
+func (s fieldSet) selectColumns(fields string) []any {
+	names, err := s.resolve(fields)
+	if strings.TrimSpace(fields) == "" || err != nil {
+		return []any{s.table + ".*"}
+	}
+
+	columns := slices.Clone(s.required)
+	for _, name := range names {
+		for _, column := range s.columns[name] {
+			if !slices.Contains(columns, column) {
+				columns = append(columns, column)
+			}
+		}
+	}
+
+	selected := make([]any, len(columns))
+	for i, column := range columns {
+		selected[i] = s.table + "." + column
+	}
+
+	return selected
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gflydev/cache"
+	"github.com/gflydev/core/utils"
+	"github.com/redis/go-redis/v9"
+)
+
+// ====================================================================
+// ========================= Redis connection =========================
+// ====================================================================
+
+var (
+	redisOnce     sync.Once
+	redisInstance *redis.Client
+)
+
+// redisClient returns a shared Redis client for the atomic operations (counters, sets, hashes)
+// which are not provided by the `cache` package. It uses the same connection settings as the Redis cache.
+func redisClient() *redis.Client {
+	redisOnce.Do(func() {
+		redisInstance = redis.NewClient(&redis.Options{
+			Addr: fmt.Sprintf(
+				"%s:%d",
+				utils.Getenv("REDIS_HOST", "localhost"),
+				utils.Getenv("REDIS_PORT", 6379),
+			),
+			Password: utils.Getenv("REDIS_PASSWORD", ""),
+			DB:       utils.Getenv("REDIS_DEFAULT_DB", 0),
+		})
+	})
+
+	return redisInstance
+}
+
+// redisKey prefixes a key with the application code, the same way as cache keys.
+func redisKey(format string, args ...any) string {
+	return cache.Key(fmt.Sprintf(format, args...))
+}
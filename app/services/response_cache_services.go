@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gflydev/cache"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+)
+
+// Response cache tags. A cached response is removed when one of its tags is invalidated.
+const (
+	TagArticleList  = "list:articles" // Article lists
+	TagTrendingList = "list:trending" // Lists ranked by ComputeTrendingScores
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// ArticleTag builds the response cache tag of an article.
+//
+// Parameters:
+//   - articleID (int): The article ID.
+//
+// Returns:
+//   - string: The tag (e.g. "article:42").
+func ArticleTag(articleID int) string {
+	return fmt.Sprintf("article:%d", articleID)
+}
+
+// CategoryTag builds the response cache tag of a category, set on the lists filtered by it.
+//
+// Parameters:
+//   - slug (string): The category slug.
+//
+// Returns:
+//   - string: The tag (e.g. "category:urban-legends").
+func CategoryTag(slug string) string {
+	return "category:" + slug
+}
+
+// ArticleListTags returns the response cache tags of an article list: TagArticleList, TagTrendingList for
+// the trending order, the tag of the category filter and the tags of the served A/B test variants.
+//
+// Parameters:
+//   - filter (dto.ArticleFilter): The filter of the list.
+//   - categorySlug (string): The slug of the filtered category, empty without category filter.
+//   - variantIDs ([]int): The served A/B test variants.
+//
+// Returns:
+//   - []string: The tags.
+func ArticleListTags(filter dto.ArticleFilter, categorySlug string, variantIDs []int) []string {
+	tags := []string{TagArticleList}
+	if strings.TrimPrefix(filter.OrderBy, "-") == "trending" {
+		tags = append(tags, TagTrendingList)
+	}
+
+	if categorySlug != "" {
+		tags = append(tags, CategoryTag(categorySlug))
+	}
+
+	for _, variantID := range variantIDs {
+		tags = append(tags, VariantTag(variantID))
+	}
+
+	return tags
+}
+
+// ArticleResponseTags returns the tags of the cached responses that may contain an article:
+// its detail and all article lists.
+//
+// Parameters:
+//   - article (models.Article): The article.
+//
+// Returns:
+//   - []string: The tags.
+func ArticleResponseTags(article models.Article) []string {
+	return []string{ArticleTag(article.ID), TagArticleList, TagTrendingList}
+}
+
+// ResponseCacheKey builds the response cache key of a request from its path and normalized query:
+// parameters are sorted, empty values and the ignored parameters are dropped. Variants are values
+// negotiated from headers (e.g. the locale).
+//
+// Parameters:
+//   - path (string): The request path.
+//   - query (url.Values): The query parameters.
+//   - ignored ([]string): The parameters left out of the key.
+//   - variants (...string): The negotiated values.
+//
+// Returns:
+//   - string: The cache key.
+func ResponseCacheKey(path string, query url.Values, ignored []string, variants ...string) string {
+	normalized := url.Values{}
+
+	for key, values := range query {
+		if slices.Contains(ignored, key) {
+			continue
+		}
+
+		for _, value := range values {
+			if value != "" {
+				normalized.Add(key, value)
+			}
+		}
+	}
+
+	return "responses:" + utils.Sha256(path+"?"+normalized.Encode()+"#"+strings.Join(variants, ","))
+}
+
+// IsNotModified evaluates the `If-None-Match`/`If-Modified-Since` request headers against the validators
+// of the current representation. If-None-Match takes precedence over If-Modified-Since (RFC 9110, 13.2.2).
+//
+// Parameters:
+//   - ifNoneMatch (string): The `If-None-Match` request header (empty when missing).
+//   - ifModifiedSince (string): The `If-Modified-Since` request header (empty when missing).
+//   - etag (string): The entity tag of the current representation (empty to skip).
+//   - lastModified (time.Time): The modification time of the current representation (zero to skip).
+//
+// Returns:
+//   - bool: True when the client copy is still fresh (304 Not Modified).
+func IsNotModified(ifNoneMatch, ifModifiedSince, etag string, lastModified time.Time) bool {
+	if ifNoneMatch != "" {
+		return etag != "" && matchETag(ifNoneMatch, etag)
+	}
+
+	if ifModifiedSince == "" || lastModified.IsZero() {
+		return false
+	}
+
+	since, err := http.ParseTime(ifModifiedSince)
+
+	return err == nil && !lastModified.Truncate(time.Second).After(since)
+}
+
+// TaggedArticleID returns the article of a response tagged with ArticleTag.
+//
+// Parameters:
+//   - tags ([]string): The dependency tags of a cached response.
+//
+// Returns:
+//   - int: The article ID, 0 when no tag is an article tag.
+func TaggedArticleID(tags []string) int {
+	for _, tag := range tags {
+		var articleID int
+		if _, err := fmt.Sscanf(tag, "article:%d", &articleID); err == nil && articleID > 0 {
+			return articleID
+		}
+	}
+
+	return 0
+}
+
+// GetCachedResponse reads a public API response from cache.
+//
+// Parameters:
+//   - key (string): The cache key of the route and its normalized query.
+//
+// Returns:
+//   - (*dto.CachedResponse, bool): The cached response and true on a cache hit, otherwise nil and false.
+func GetCachedResponse(key string) (*dto.CachedResponse, bool) {
+	if responseCacheTTL() <= 0 {
+		return nil, false
+	}
+
+	val, err := cache.Get(key)
+	if err != nil || val == nil {
+		return nil, false
+	}
+
+	var cached dto.CachedResponse
+	if err = json.Unmarshal([]byte(fmt.Sprint(val)), &cached); err != nil {
+		log.Warnf("Invalid cached response `%s`: %v", key, err)
+
+		return nil, false
+	}
+
+	return &cached, true
+}
+
+// CacheResponse stores a public API response in cache and registers it under its dependency tags.
+// The TTL is taken from `RESPONSE_CACHE_TTL` (seconds, 300 by default, 0 disables the cache).
+//
+// Parameters:
+//   - key (string): The cache key of the route and its normalized query.
+//   - response (dto.CachedResponse): The serialized response.
+//   - tags (...string): The dependency tags (e.g. TagArticleList, ArticleTag(42)).
+//
+// Returns:
+//   - error: An error if the response could not be encoded or stored.
+func CacheResponse(key string, response dto.CachedResponse, tags ...string) error {
+	ttl := responseCacheTTL()
+	if ttl <= 0 {
+		return nil
+	}
+
+	response.Tags = tags
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	if err = cache.Set(key, string(data), ttl); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	client := redisClient()
+
+	for _, tag := range tags {
+		tagKey := responseTagKey(tag)
+
+		// Tag sets live as long as their latest entry
+		if err = client.SAdd(ctx, tagKey, cache.Key(key)).Err(); err != nil {
+			return err
+		}
+
+		if err = client.Expire(ctx, tagKey, ttl).Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InvalidateResponseTags removes every cached response registered under one of the tags.
+//
+// Parameters:
+//   - tags (...string): The tags to invalidate.
+func InvalidateResponseTags(tags ...string) {
+	ctx := context.Background()
+	client := redisClient()
+
+	for _, tag := range tags {
+		tagKey := responseTagKey(tag)
+
+		keys, err := client.SMembers(ctx, tagKey).Result()
+		if err != nil {
+			log.Warnf("Failed to read cached responses of `%s`: %v", tag, err)
+
+			continue
+		}
+
+		if err = client.Del(ctx, append(keys, tagKey)...).Err(); err != nil {
+			log.Warnf("Failed to invalidate cached responses of `%s`: %v", tag, err)
+		}
+	}
+}
+
+// InvalidateArticleResponses removes every cached response that may contain the given article:
+// its detail and all article lists.
+//
+// Parameters:
+//   - article (models.Article): The article that was created, updated or removed.
+func InvalidateArticleResponses(article models.Article) {
+	InvalidateResponseTags(ArticleResponseTags(article)...)
+}
+
+// InvalidateCategoryResponses removes every cached article list filtered by a category.
+//
+// Parameters:
+//   - category (models.Category): The category that was created or removed.
+func InvalidateCategoryResponses(category models.Category) {
+	InvalidateResponseTags(CategoryTag(category.Slug))
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// responseCacheTTL returns the lifetime of cached responses.
+func responseCacheTTL() time.Duration {
+	return time.Duration(utils.Getenv("RESPONSE_CACHE_TTL", 300)) * time.Second
+}
+
+// responseTagKey returns the key of the Redis set holding the cache keys of a tag.
+func responseTagKey(tag string) string {
+	return redisKey("responses:tags:%s", tag)
+}
+
+// matchETag checks an `If-None-Match` header value against an entity tag using weak comparison.
+func matchETag(ifNoneMatch, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,321 @@
+package services
+
+import (
+	"encoding/xml"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	"github.com/gflydev/storage"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// SitemapDir directory of generated sitemap files, relative to the storage root.
+const SitemapDir = "app/public/sitemaps"
+
+// SitemapIndexFile file name of the sitemap index inside SitemapDir.
+const SitemapIndexFile = "sitemap.xml"
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// GenerateSitemaps builds the sitemap index and its child sitemaps then writes them to storage.
+//
+// Child sitemaps are:
+//   - `static.xml`: static pages listed in `SITEMAP_STATIC_PAGES` (comma separated paths).
+//   - `categories.xml`: the story category pages.
+//   - `articles-{page}.xml`: published articles, `SITEMAP_PAGE_SIZE` entries per file (5000 by default).
+//
+// Article sitemaps left by a previous run with more articles are removed.
+//
+// Returns:
+//   - ([]string, error): Storage paths of the written files and any error encountered.
+func GenerateSitemaps() ([]string, error) {
+	fs := storage.Instance()
+	fs.MakeDir(SitemapDir)
+
+	var refs []dto.SitemapRef
+	var files []string
+
+	// Static pages
+	staticURLs := StaticSitemapURLs()
+	if err := writeSitemap(fs, "static.xml", dto.SitemapURLSet{URLs: staticURLs}); err != nil {
+		return files, err
+	}
+	files = append(files, sitemapPath("static.xml"))
+	refs = append(refs, dto.SitemapRef{Loc: SitemapURL("static.xml")})
+
+	// Categories
+	categories, err := FindCategories()
+	if err != nil {
+		return files, err
+	}
+
+	if err = writeSitemap(fs, "categories.xml", dto.SitemapURLSet{URLs: categorySitemapURLs(categories)}); err != nil {
+		return files, err
+	}
+	files = append(files, sitemapPath("categories.xml"))
+	refs = append(refs, dto.SitemapRef{Loc: SitemapURL("categories.xml")})
+
+	// Articles
+	pageSize := utils.Getenv("SITEMAP_PAGE_SIZE", 5000)
+	pages := 0
+
+	for page := 1; ; page++ {
+		articles, total, err := findSitemapArticles(page, pageSize)
+		if err != nil {
+			return files, err
+		}
+
+		if len(articles) == 0 {
+			break
+		}
+
+		name := fmt.Sprintf("articles-%d.xml", page)
+		urlSet, lastMod := ArticleSitemapURLs(articles)
+
+		if err = writeSitemap(fs, name, dto.SitemapURLSet{URLs: urlSet}); err != nil {
+			return files, err
+		}
+		files = append(files, sitemapPath(name))
+		refs = append(refs, dto.SitemapRef{Loc: SitemapURL(name), LastMod: lastMod})
+		pages = page
+
+		if page*pageSize >= total {
+			break
+		}
+	}
+
+	PruneArticleSitemaps(fs, pages+1)
+
+	// Sitemap index
+	index, err := xml.MarshalIndent(dto.SitemapIndex{Sitemaps: refs}, "", "  ")
+	if err != nil {
+		return files, err
+	}
+
+	if !fs.Put(sitemapPath(SitemapIndexFile), xml.Header+string(index)) {
+		return files, errors.New("Unable to write sitemap index")
+	}
+	files = append(files, sitemapPath(SitemapIndexFile))
+
+	log.Infof("Generated %d sitemap files", len(files))
+
+	return files, nil
+}
+
+// GetSitemapFile reads a generated sitemap file from storage.
+//
+// Parameters:
+//   - name (string): File name inside SitemapDir (e.g. "sitemap.xml" or "articles-1.xml").
+//
+// Returns:
+//   - ([]byte, time.Time, error): The file content, its modification time and any error encountered.
+func GetSitemapFile(name string) ([]byte, time.Time, error) {
+	if strings.Contains(name, "/") || strings.Contains(name, "..") || !strings.HasSuffix(name, ".xml") {
+		return nil, time.Time{}, errors.New("Sitemap not found")
+	}
+
+	fs := storage.Instance()
+	path := sitemapPath(name)
+
+	if !fs.Exists(path) {
+		return nil, time.Time{}, errors.New("Sitemap not found")
+	}
+
+	content, err := fs.Get(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return content, fs.LastModified(path), nil
+}
+
+// SitemapURL builds the public URL of a generated sitemap file.
+// The base URL is taken from `SITEMAP_URL` (e.g. a CDN host), `APP_URL/sitemaps` by default.
+//
+// Parameters:
+//   - name (string): File name inside SitemapDir.
+//
+// Returns:
+//   - string: Absolute URL of the sitemap file.
+func SitemapURL(name string) string {
+	baseURL := utils.Getenv("SITEMAP_URL", strings.TrimSuffix(core.AppURL, "/")+"/sitemaps")
+
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(baseURL, "/"), name)
+}
+
+// ArticleSitemapURLs converts articles to the entries of an article sitemap. The `lastmod` of an entry is
+// the latest of the creation, publishing and update times of its article.
+//
+// Parameters:
+//   - articles ([]models.Article): The published articles of a sitemap page.
+//
+// Returns:
+//   - ([]dto.SitemapURL, string): The entries and the latest `lastmod` among them (empty without articles).
+func ArticleSitemapURLs(articles []models.Article) ([]dto.SitemapURL, string) {
+	appURL := strings.TrimSuffix(core.AppURL, "/")
+	urls := make([]dto.SitemapURL, 0, len(articles))
+
+	var latest time.Time
+
+	for _, article := range articles {
+		lastMod := article.CreatedAt
+		if article.PublishedAt.Valid && article.PublishedAt.Time.After(lastMod) {
+			lastMod = article.PublishedAt.Time
+		}
+		if article.UpdatedAt.Valid && article.UpdatedAt.Time.After(lastMod) {
+			lastMod = article.UpdatedAt.Time
+		}
+		if lastMod.After(latest) {
+			latest = lastMod
+		}
+
+		url := dto.SitemapURL{
+			Loc:        fmt.Sprintf("%s/truyen/%s", appURL, article.Slug),
+			LastMod:    lastMod.UTC().Format(time.RFC3339),
+			ChangeFreq: "weekly",
+			Priority:   "0.8",
+		}
+
+		if article.CoverImage.Valid && article.CoverImage.String != "" {
+			imageURL := article.CoverImage.String
+			if !strings.HasPrefix(imageURL, core.SchemaHTTP) {
+				imageURL = fmt.Sprintf("%s/%s", appURL, strings.TrimPrefix(imageURL, "/"))
+			}
+
+			url.Images = []dto.SitemapImage{{Loc: imageURL, Title: article.Title}}
+		}
+
+		urls = append(urls, url)
+	}
+
+	if latest.IsZero() {
+		return urls, ""
+	}
+
+	return urls, latest.UTC().Format(time.RFC3339)
+}
+
+// PruneArticleSitemaps removes the article sitemaps from the given page on, left by a run with more articles.
+// Pages are numbered without gaps, the first missing file ends the stale ones.
+//
+// Parameters:
+//   - fs (storage.IStorage): The storage of the sitemaps.
+//   - fromPage (int): The first stale page.
+//
+// Returns:
+//   - int: The number of removed sitemaps.
+func PruneArticleSitemaps(fs storage.IStorage, fromPage int) int {
+	removed := 0
+
+	for page := fromPage; ; page++ {
+		path := sitemapPath(fmt.Sprintf("articles-%d.xml", page))
+		if !fs.Exists(path) {
+			return removed
+		}
+
+		if !fs.Delete(path) {
+			log.Warnf("Unable to remove stale sitemap %s", path)
+
+			return removed
+		}
+
+		removed++
+	}
+}
+
+// StaticSitemapURLs lists the static pages configured in `SITEMAP_STATIC_PAGES` (comma separated paths,
+// the home page by default).
+//
+// Returns:
+//   - []dto.SitemapURL: The entries of the static pages.
+func StaticSitemapURLs() []dto.SitemapURL {
+	appURL := strings.TrimSuffix(core.AppURL, "/")
+	var urls []dto.SitemapURL
+
+	for _, page := range strings.Split(utils.Getenv("SITEMAP_STATIC_PAGES", "/"), ",") {
+		page = strings.TrimSpace(page)
+		if page == "" {
+			continue
+		}
+
+		priority := "0.5"
+		if page == "/" {
+			priority = "1.0"
+		}
+
+		urls = append(urls, dto.SitemapURL{
+			Loc:        appURL + "/" + strings.TrimPrefix(page, "/"),
+			ChangeFreq: "daily",
+			Priority:   priority,
+		})
+	}
+
+	return urls
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// sitemapPath returns the storage path of a sitemap file.
+func sitemapPath(name string) string {
+	return fmt.Sprintf("%s/%s", SitemapDir, name)
+}
+
+// writeSitemap marshals a URL set and writes it to storage.
+func writeSitemap(fs storage.IStorage, name string, urlSet dto.SitemapURLSet) error {
+	urlSet.XmlnsImage = "http://www.google.com/schemas/sitemap-image/1.1"
+
+	data, err := xml.MarshalIndent(urlSet, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if !fs.Put(sitemapPath(name), xml.Header+string(data)) {
+		return errors.New("Unable to write sitemap %s", name)
+	}
+
+	return nil
+}
+
+// findSitemapArticles queries a page of published articles with the columns needed by sitemaps.
+func findSitemapArticles(page, pageSize int) ([]models.Article, int, error) {
+	var articles []models.Article
+
+	total, err := mb.Instance().
+		Select("id", "title", "slug", "cover_image", "published_at", "created_at", "updated_at").
+		Where(models.TableArticle+".deleted_at", qb.Null, nil).
+		Where(models.TableArticle+".status", qb.Eq, types.ArticleStatusPublished).
+		OrderBy(models.TableArticle+".id", qb.Asc).
+		Limit(pageSize, (page-1)*pageSize).
+		Find(&articles)
+
+	return articles, total, err
+}
+
+// categorySitemapURLs converts story categories to sitemap entries of their pages.
+func categorySitemapURLs(categories []models.Category) []dto.SitemapURL {
+	appURL := strings.TrimSuffix(core.AppURL, "/")
+	urls := make([]dto.SitemapURL, 0, len(categories))
+
+	for _, category := range categories {
+		urls = append(urls, dto.SitemapURL{
+			Loc:        fmt.Sprintf("%s/the-loai/%s", appURL, category.Slug),
+			ChangeFreq: "daily",
+			Priority:   "0.6",
+		})
+	}
+
+	return urls
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	qb "github.com/jivegroup/fluentsql"
+	"github.com/redis/go-redis/v9"
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// ComputeTrendingScores ranks published articles by recent views decayed by age (Hacker News style)
+// and stores the ranking in a Redis sorted set.
+//
+//	score = views / (age_hours + TRENDING_AGE_OFFSET) ^ TRENDING_GRAVITY
+//
+// Views are the deduplicated views of the last `TRENDING_WINDOW` hours (24 by default).
+//
+// Returns:
+//   - (int, error): The number of ranked articles and any error encountered.
+func ComputeTrendingScores() (int, error) {
+	ctx := context.Background()
+	client := redisClient()
+
+	window := utils.Getenv("TRENDING_WINDOW", 24)
+	gravity := utils.Getenv("TRENDING_GRAVITY", 1.8)
+	ageOffset := utils.Getenv("TRENDING_AGE_OFFSET", 2.0)
+
+	// Sum recent views per article
+	views := map[int]int{}
+	now := time.Now()
+
+	for hour := 0; hour < window; hour++ {
+		counts, err := client.HGetAll(ctx, hourlyViewsKey(now.Add(-time.Duration(hour)*time.Hour))).Result()
+		if err != nil && err != redis.Nil {
+			return 0, err
+		}
+
+		for field, value := range counts {
+			articleID, _ := strconv.Atoi(field)
+			count, _ := strconv.Atoi(value)
+			views[articleID] += count
+		}
+	}
+
+	// Compute scores of published articles
+	var scores []redis.Z
+
+	if len(views) > 0 {
+		ids := make([]int, 0, len(views))
+		for articleID := range views {
+			ids = append(ids, articleID)
+		}
+
+		var articles []models.Article
+		if _, err := mb.Instance().
+			Select("id", "published_at", "created_at").
+			Where(models.TableArticle+".id", qb.In, ids).
+			Where(models.TableArticle+".status", qb.Eq, types.ArticleStatusPublished).
+			Where(models.TableArticle+".deleted_at", qb.Null, nil).
+			Limit(len(ids), 0).
+			Find(&articles); err != nil {
+			return 0, err
+		}
+
+		for _, article := range articles {
+			scores = append(scores, redis.Z{
+				Score:  TrendingScore(article, views[article.ID], now, ageOffset, gravity),
+				Member: article.ID,
+			})
+		}
+	}
+
+	// Replace the ranking atomically
+	trendingKey := redisKey("articles:trending")
+
+	// Trending lists are cached until the next ranking
+	defer InvalidateResponseTags(TagTrendingList)
+
+	if len(scores) == 0 {
+		return 0, client.Del(ctx, trendingKey).Err()
+	}
+
+	tempKey := redisKey("articles:trending:%d", now.UnixNano())
+	if err := client.ZAdd(ctx, tempKey, scores...).Err(); err != nil {
+		return 0, err
+	}
+
+	if err := client.Rename(ctx, tempKey, trendingKey).Err(); err != nil {
+		return 0, err
+	}
+
+	log.Infof("Ranked %d trending articles", len(scores))
+
+	return len(scores), nil
+}
+
+// TrendingScore computes the trending score of an article from its recent views, decayed by the hours since
+// it was published (since it was created when it has no publishing time). Articles published in the future
+// are as old as new ones.
+//
+// Parameters:
+//   - article (models.Article): The article.
+//   - views (int): The views of the article during the trending window.
+//   - now (time.Time): The time of the ranking.
+//   - ageOffset (float64): Hours added to the age (TRENDING_AGE_OFFSET), so new articles don't get an infinite score.
+//   - gravity (float64): How fast the score decays with age (TRENDING_GRAVITY).
+//
+// Returns:
+//   - float64: The score, views / (age_hours + ageOffset) ^ gravity.
+func TrendingScore(article models.Article, views int, now time.Time, ageOffset, gravity float64) float64 {
+	publishedAt := article.CreatedAt
+	if article.PublishedAt.Valid {
+		publishedAt = article.PublishedAt.Time
+	}
+
+	ageHours := math.Max(now.Sub(publishedAt).Hours(), 0)
+
+	return float64(views) / math.Pow(ageHours+ageOffset, gravity)
+}
+
+// TrendingArticleIDs returns the IDs of the trending articles, highest score first.
+//
+// Parameters:
+//   - limit (int): The maximum number of IDs to return.
+//
+// Returns:
+//   - []int: The article IDs. Empty when the ranking hasn't been computed yet.
+func TrendingArticleIDs(limit int) []int {
+	members, err := redisClient().ZRevRange(context.Background(), redisKey("articles:trending"), 0, int64(limit-1)).Result()
+	if err != nil {
+		log.Warnf("Failed to read trending articles: %v", err)
+
+		return nil
+	}
+
+	ids := make([]int, 0, len(members))
+	for _, member := range members {
+		if articleID, err := strconv.Atoi(member); err == nil {
+			ids = append(ids, articleID)
+		}
+	}
+
+	return ids
+}
+
+// FindTrendingArticles retrieves the published trending articles, highest score first.
+//
+// Parameters:
+//   - limit (int): The maximum number of articles to return.
+//
+// Returns:
+//   - ([]models.Article, error): The trending articles and any error encountered.
+func FindTrendingArticles(limit int) ([]models.Article, error) {
+	ids := TrendingArticleIDs(limit)
+	if len(ids) == 0 {
+		return []models.Article{}, nil
+	}
+
+	var articles []models.Article
+	_, err := mb.Instance().
+		Select("*").
+		Where(models.TableArticle+".id", qb.In, ids).
+		Where(models.TableArticle+".status", qb.Eq, types.ArticleStatusPublished).
+		Where(models.TableArticle+".deleted_at", qb.Null, nil).
+		OrderBy(trendingOrderExpression(ids), qb.Asc).
+		Limit(limit, 0).
+		Find(&articles)
+
+	return articles, err
+}
+
+// ====================================================================
+// ======================== Helper Functions ==========================
+// ====================================================================
+
+// hourlyViewsKey returns the key of the hash holding the views of each article during an hour.
+func hourlyViewsKey(t time.Time) string {
+	return redisKey("views:hourly:%s", t.UTC().Format("2006010215"))
+}
+
+// trendingOrderExpression builds an ORDER BY expression sorting articles in trending order.
+// Articles outside the ranking are sorted last.
+func trendingOrderExpression(ids []int) string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = strconv.Itoa(id)
+	}
+
+	return fmt.Sprintf(
+		"COALESCE(array_position(ARRAY[%s]::int[], %s.id), %d)",
+		strings.Join(values, ","),
+		models.TableArticle,
+		math.MaxInt32,
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"gfly/app/domain/models/types"
 	"gfly/app/domain/repository"
 	"gfly/app/dto"
+	"gfly/app/events"
 	"github.com/gflydev/core"
 	"github.com/gflydev/core/errors"
 	"github.com/gflydev/core/log"
@@ -36,8 +37,6 @@ const (
 //
 //	([]models.User, int, error): A list of user models, the total number of users, and any error encountered.
 func FindUsers(filterDto dto.Filter) ([]models.User, int, error) {
-	// DB Model instance
-	dbInstance := mb.Instance()
 	// Error variable
 	var err error
 
@@ -50,35 +49,7 @@ func FindUsers(filterDto dto.Filter) ([]models.User, int, error) {
 		offset = (filterDto.Page - 1) * filterDto.PerPage
 	}
 
-	builder := dbInstance.Select("DISTINCT users.id", "users.*").
-		Join(qb.LeftJoin, models.TableUserRole, qb.Condition{
-			Field: models.TableUserRole + ".user_id",
-			Opt:   qb.Eq,
-			Value: qb.ValueField(models.TableUser + ".id"),
-		}).
-		Join(qb.LeftJoin, models.TableRole, qb.Condition{
-			Field: models.TableRole + ".id",
-			Opt:   qb.Eq,
-			Value: qb.ValueField(models.TableUserRole + ".role_id"),
-		}).
-		Where(models.TableUser+".deleted_at", qb.Null, nil).
-		When(filterDto.Keyword != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
-			query.WhereGroup(func(queryGroup qb.WhereBuilder) *qb.WhereBuilder {
-				queryGroup.Where(models.TableRole+".name", qb.Like, "%"+filterDto.Keyword+"%").
-					WhereOr(models.TableRole+".slug", qb.Like, "%"+filterDto.Keyword+"%").
-					WhereOr(models.TableUser+".email", qb.Like, "%"+filterDto.Keyword+"%").
-					WhereOr(models.TableUser+".fullname", qb.Like, "%"+filterDto.Keyword+"%").
-					WhereOr(models.TableUser+".phone", qb.Like, "%"+filterDto.Keyword+"%")
-
-				if slices.Contains(types.UserStatusList, types.UserStatus(filterDto.Keyword)) {
-					queryGroup.WhereOr(models.TableUser+".status", qb.Eq, filterDto.Keyword)
-				}
-
-				return &queryGroup
-			})
-
-			return &query
-		}).
+	builder := userFilterQuery(filterDto).
 		Limit(filterDto.PerPage, offset)
 
 	if filterDto.OrderBy != "" {
@@ -112,6 +83,63 @@ func FindUsers(filterDto dto.Filter) ([]models.User, int, error) {
 	return users, total, err
 }
 
+// FindUsersByCursor retrieves a page of users using cursor (keyset) pagination.
+//
+// The order is `order_by` (`id` by default) with the user ID as tie breaker.
+// The `last_access` order isn't supported in this mode because the column may be empty.
+//
+// Parameters:
+//   - filterDto (dto.Filter): The filter containing search criteria, order by field, cursor and per-page details.
+//
+// Returns:
+//   - ([]models.User, dto.Cursors, int, error): The users, the cursors of the adjacent pages,
+//     the total number of matching users and any error encountered.
+func FindUsersByCursor(filterDto dto.Filter) ([]models.User, dto.Cursors, int, error) {
+	var users []models.User
+
+	orderBy := filterDto.OrderBy
+	if orderBy == "" {
+		orderBy = "id"
+	}
+
+	columns := map[string]cursorColumn{
+		"id":       {Expression: models.TableUser + ".id"},
+		"email":    {Expression: models.TableUser + ".email"},
+		"fullname": {Expression: models.TableUser + ".fullname"},
+		"phone":    {Expression: models.TableUser + ".phone"},
+		"status":   {Expression: models.TableUser + ".status"},
+	}
+
+	builder := userFilterQuery(filterDto)
+
+	cursor, err := applyCursor(builder, models.TableUser+".id", columns, orderBy, filterDto.Cursor, filterDto.PerPage)
+	if err != nil {
+		return nil, dto.Cursors{}, 0, err
+	}
+
+	total, err := builder.Find(&users)
+	if err != nil {
+		return nil, dto.Cursors{}, 0, err
+	}
+
+	users, cursors := cursorPage(users, orderBy, filterDto.PerPage, cursor, func(user models.User) (string, int) {
+		switch strings.TrimPrefix(orderBy, "-") {
+		case "email":
+			return user.Email, user.ID
+		case "fullname":
+			return user.Fullname, user.ID
+		case "phone":
+			return user.Phone, user.ID
+		case "status":
+			return string(user.Status), user.ID
+		}
+
+		return "", user.ID
+	})
+
+	return users, cursors, total, nil
+}
+
 // CreateUser creates a new user in the system.
 //
 // This function performs the following steps:
@@ -161,6 +189,8 @@ func CreateUser(createUserDto dto.CreateUser) (*models.User, error) {
 		return nil, errors.New("error occurs while syncing user roles")
 	}
 
+	events.Dispatch(events.UserCreated{User: *user})
+
 	return user, nil
 }
 
@@ -279,6 +309,26 @@ func DeleteUserByID(userID int) error {
 	return nil
 }
 
+// FindUsersByIDs retrieves the users with the given IDs (authors of a list of articles).
+//
+// Parameters:
+//   - userIDs ([]int): The IDs of the users.
+//
+// Returns:
+//   - ([]models.User, error): The users found and any error encountered.
+func FindUsersByIDs(userIDs []int) ([]models.User, error) {
+	var users []models.User
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+
+	_, err := mb.Instance().Select("*").
+		Where(models.TableUser+".id", qb.In, userIDs).
+		Find(&users)
+
+	return users, err
+}
+
 // UserHasRole checks if a user has any of the specified roles.
 //
 // Parameters:
@@ -331,7 +381,44 @@ func updateUserFromDto(user *models.User, updateUserDto dto.UpdateUser) *models.
 		user.Avatar = dbNull.String(updateUserDto.Avatar)
 	}
 
+	if birthdate, err := time.Parse(time.DateOnly, updateUserDto.Birthdate); err == nil {
+		user.Birthdate = dbNull.Time(birthdate)
+	}
+
 	user.UpdatedAt = time.Now()
 
 	return user
 }
+
+// userFilterQuery builds the user query matching the search criteria of a filter.
+func userFilterQuery(filterDto dto.Filter) *mb.DBModel {
+	return mb.Instance().Select(append([]any{"DISTINCT users.id"}, userFields.selectColumns(filterDto.Fields)...)...).
+		Join(qb.LeftJoin, models.TableUserRole, qb.Condition{
+			Field: models.TableUserRole + ".user_id",
+			Opt:   qb.Eq,
+			Value: qb.ValueField(models.TableUser + ".id"),
+		}).
+		Join(qb.LeftJoin, models.TableRole, qb.Condition{
+			Field: models.TableRole + ".id",
+			Opt:   qb.Eq,
+			Value: qb.ValueField(models.TableUserRole + ".role_id"),
+		}).
+		Where(models.TableUser+".deleted_at", qb.Null, nil).
+		When(filterDto.Keyword != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.WhereGroup(func(queryGroup qb.WhereBuilder) *qb.WhereBuilder {
+				queryGroup.Where(models.TableRole+".name", qb.Like, "%"+filterDto.Keyword+"%").
+					WhereOr(models.TableRole+".slug", qb.Like, "%"+filterDto.Keyword+"%").
+					WhereOr(models.TableUser+".email", qb.Like, "%"+filterDto.Keyword+"%").
+					WhereOr(models.TableUser+".fullname", qb.Like, "%"+filterDto.Keyword+"%").
+					WhereOr(models.TableUser+".phone", qb.Like, "%"+filterDto.Keyword+"%")
+
+				if slices.Contains(types.UserStatusList, types.UserStatus(filterDto.Keyword)) {
+					queryGroup.WhereOr(models.TableUser+".status", qb.Eq, filterDto.Keyword)
+				}
+
+				return &queryGroup
+			})
+
+			return &query
+		})
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	"github.com/redis/go-redis/v9"
+)
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// RecordArticleView counts a view of an article once per visitor within the `VIEW_DEDUP_WINDOW` (minutes, 30 by default).
+// Views are accumulated in Redis and written to the database by FlushArticleViews.
+//
+// Parameters:
+//   - articleID (int): The ID of the viewed article.
+//   - visitorID (string): A stable hash identifying the visitor (e.g. IP + User-Agent).
+//
+// Returns:
+//   - (bool, error): True if the view was counted, false if it is a duplicate, and any error encountered.
+func RecordArticleView(articleID int, visitorID string) (bool, error) {
+	ctx := context.Background()
+	client := redisClient()
+	window := time.Duration(utils.Getenv("VIEW_DEDUP_WINDOW", 30)) * time.Minute
+
+	isNew, err := client.SetNX(ctx, redisKey("views:seen:%d:%s", articleID, visitorID), 1, window).Result()
+	if err != nil || !isNew {
+		return false, err
+	}
+
+	// Pending views to flush into the database and hourly views used by trending scores
+	hourlyKey := hourlyViewsKey(time.Now())
+	trendingWindow := time.Duration(utils.Getenv("TRENDING_WINDOW", 24)+1) * time.Hour
+
+	if _, err = client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, redisKey("views:pending"), strconv.Itoa(articleID), 1)
+		pipe.HIncrBy(ctx, hourlyKey, strconv.Itoa(articleID), 1)
+		pipe.Expire(ctx, hourlyKey, trendingWindow)
+
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// FlushArticleViews writes the views accumulated in Redis to the database.
+//
+// Pending views are moved atomically to a flushing hash so that new views keep accumulating during the flush.
+// A flushing hash left over by an interrupted run is processed first. Each article is removed from the
+// flushing hash once its views are written, and a lock keeps overlapping runs out, so no view is counted twice.
+// Views that fail to be written are put back into the pending hash.
+//
+// Returns:
+//   - (int, error): The number of articles updated and any error encountered.
+func FlushArticleViews() (int, error) {
+	return flushRedisCounts("views", func(field string, count int) error {
+		articleID, _ := strconv.Atoi(field)
+		if articleID < 1 {
+			return nil
+		}
+
+		if err := IncrementArticleViewCount(articleID, count); err != nil {
+			log.Errorf("Failed to flush %d views of article %d: %v", count, articleID, err)
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// flushRedisCounts applies the counts of the `<name>:pending` hash (field => count) with the apply function,
+// see FlushArticleViews. A field whose count fails to be applied is moved back to the pending hash.
+func flushRedisCounts(name string, apply func(field string, count int) error) (int, error) {
+	ctx := context.Background()
+	client := redisClient()
+	pendingKey := redisKey("%s:pending", name)
+	flushingKey := redisKey("%s:flushing", name)
+	lockKey := redisKey("%s:flush:lock", name)
+
+	// Another run is flushing
+	locked, err := client.SetNX(ctx, lockKey, 1, 10*time.Minute).Result()
+	if err != nil || !locked {
+		return 0, err
+	}
+	defer client.Del(ctx, lockKey)
+
+	exists, err := client.Exists(ctx, flushingKey).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	if exists == 0 {
+		if exists, err = client.Exists(ctx, pendingKey).Result(); err != nil || exists == 0 {
+			return 0, err
+		}
+
+		if err = client.Rename(ctx, pendingKey, flushingKey).Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	counts, err := client.HGetAll(ctx, flushingKey).Result()
+	if err != nil && err != redis.Nil {
+		return 0, err
+	}
+
+	updated := 0
+
+	for field, value := range counts {
+		count, _ := strconv.Atoi(value)
+
+		if count > 0 {
+			if err = apply(field, count); err != nil {
+				// Keep the count for the next run
+				if _, err = client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+					pipe.HIncrBy(ctx, pendingKey, field, int64(count))
+					pipe.HDel(ctx, flushingKey, field)
+
+					return nil
+				}); err != nil {
+					return updated, err
+				}
+
+				continue
+			}
+
+			updated++
+		}
+
+		if err = client.HDel(ctx, flushingKey, field).Err(); err != nil {
+			return updated, err
+		}
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,472 @@
+package services
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	appUtils "gfly/app/utils"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gflydev/console"
+	"github.com/gflydev/core"
+	"github.com/gflydev/core/errors"
+	"github.com/gflydev/core/log"
+	"github.com/gflydev/core/utils"
+	mb "github.com/gflydev/db"
+	dbNull "github.com/gflydev/db/null"
+	qb "github.com/jivegroup/fluentsql"
+)
+
+// WebhookTask name of the queue task delivering a webhook (see queues.WebhookTask)
+const WebhookTask = "webhooks:deliver"
+
+// ====================================================================
+// ========================= Main functions ===========================
+// ====================================================================
+
+// FindWebhooks retrieves all webhook subscriptions, latest first.
+//
+// Returns:
+//   - ([]models.Webhook, error): The webhooks and any error encountered.
+func FindWebhooks() ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+
+	_, err := mb.Instance().Select("*").
+		OrderBy(models.TableWebhook+".id", qb.Desc).
+		Find(&webhooks)
+
+	return webhooks, err
+}
+
+// GetWebhookByID retrieves a webhook subscription by its ID.
+//
+// Parameters:
+//   - webhookID (int): The ID of the webhook.
+//
+// Returns:
+//   - (*models.Webhook, error): The webhook or "Webhook not found".
+func GetWebhookByID(webhookID int) (*models.Webhook, error) {
+	webhook, err := mb.GetModelByID[models.Webhook](webhookID)
+	if err != nil || webhook == nil {
+		return nil, errors.New("Webhook not found")
+	}
+
+	return webhook, nil
+}
+
+// CreateWebhook subscribes a URL to content lifecycle events. A signing secret is generated when none is given.
+//
+// Parameters:
+//   - createWebhookDto (dto.CreateWebhook): The receiver URL, its secret and the event types.
+//
+// Returns:
+//   - (*models.Webhook, error): The created webhook or an error if any step fails.
+//
+// Possible Errors:
+//   - "Webhook URL must use https": Returned for another scheme (http is accepted outside prod).
+//   - "Webhook URL must target a public host": Returned for a loopback, private or link-local host.
+func CreateWebhook(createWebhookDto dto.CreateWebhook) (*models.Webhook, error) {
+	if err := appUtils.CheckWebhookURL(strings.TrimSpace(createWebhookDto.URL), webhookAllowHTTP()); err != nil {
+		return nil, err
+	}
+
+	webhook := &models.Webhook{
+		URL:         strings.TrimSpace(createWebhookDto.URL),
+		Secret:      createWebhookDto.Secret,
+		Events:      webhookEventsColumn(createWebhookDto.Events),
+		Description: optionalString(strings.TrimSpace(createWebhookDto.Description)),
+		Active:      createWebhookDto.Active == nil || *createWebhookDto.Active,
+		CreatedAt:   time.Now(),
+	}
+
+	if webhook.Secret == "" {
+		webhook.Secret = "whsec_" + newsletterToken()
+	}
+
+	if err := mb.CreateModel(webhook); err != nil {
+		log.Errorf("Error while creating webhook: %v", err)
+
+		return nil, errors.New("Error occurs while creating webhook")
+	}
+
+	return webhook, nil
+}
+
+// UpdateWebhook updates a webhook subscription, missing fields are kept.
+//
+// Parameters:
+//   - updateWebhookDto (dto.UpdateWebhook): The fields to change.
+//
+// Returns:
+//   - (*models.Webhook, error): The updated webhook, "Webhook not found" or an error if the update fails.
+func UpdateWebhook(updateWebhookDto dto.UpdateWebhook) (*models.Webhook, error) {
+	webhook, err := GetWebhookByID(updateWebhookDto.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if updateWebhookDto.URL != "" {
+		if err = appUtils.CheckWebhookURL(strings.TrimSpace(updateWebhookDto.URL), webhookAllowHTTP()); err != nil {
+			return nil, err
+		}
+
+		webhook.URL = strings.TrimSpace(updateWebhookDto.URL)
+	}
+
+	if updateWebhookDto.Secret != "" {
+		webhook.Secret = updateWebhookDto.Secret
+	}
+
+	if len(updateWebhookDto.Events) > 0 {
+		webhook.Events = webhookEventsColumn(updateWebhookDto.Events)
+	}
+
+	if updateWebhookDto.Description != nil {
+		webhook.Description = optionalString(strings.TrimSpace(*updateWebhookDto.Description))
+	}
+
+	if updateWebhookDto.Active != nil {
+		webhook.Active = *updateWebhookDto.Active
+	}
+
+	webhook.UpdatedAt = dbNull.Time(time.Now())
+
+	if err = mb.UpdateModel(webhook); err != nil {
+		log.Errorf("Error while updating webhook %d: %v", webhook.ID, err)
+
+		return nil, errors.New("Error occurs while updating webhook")
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook deletes a webhook subscription and its delivery log.
+//
+// Parameters:
+//   - webhookID (int): The ID of the webhook.
+//
+// Returns:
+//   - error: "Webhook not found" or an error if the webhook can't be deleted.
+func DeleteWebhook(webhookID int) error {
+	webhook, err := GetWebhookByID(webhookID)
+	if err != nil {
+		return err
+	}
+
+	if err = mb.DeleteModel(webhook); err != nil {
+		log.Errorf("Error while deleting webhook %d: %v", webhookID, err)
+
+		return errors.New("Error occurs while deleting webhook")
+	}
+
+	return nil
+}
+
+// WebhookEvents lists the event types of a webhook.
+func WebhookEvents(webhook models.Webhook) []string {
+	return appUtils.SplitList(webhook.Events)
+}
+
+// FindWebhookDeliveries retrieves a page of the delivery log of a webhook, latest first.
+//
+// Parameters:
+//   - filterDto (dto.WebhookDeliveryFilter): The webhook, the optional status and event, and the page details.
+//
+// Returns:
+//   - ([]models.WebhookDelivery, int, error): The deliveries, the total number of matching deliveries and any error encountered.
+func FindWebhookDeliveries(filterDto dto.WebhookDeliveryFilter) ([]models.WebhookDelivery, int, error) {
+	var deliveries []models.WebhookDelivery
+	offset := 0
+
+	if filterDto.Page > 0 {
+		offset = (filterDto.Page - 1) * filterDto.PerPage
+	}
+
+	total, err := mb.Instance().Select("*").
+		Where(models.TableWebhookDelivery+".webhook_id", qb.Eq, filterDto.WebhookID).
+		When(filterDto.Status != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableWebhookDelivery+".status", qb.Eq, filterDto.Status)
+
+			return &query
+		}).
+		When(filterDto.Event != "", func(query qb.WhereBuilder) *qb.WhereBuilder {
+			query.Where(models.TableWebhookDelivery+".event", qb.Eq, filterDto.Event)
+
+			return &query
+		}).
+		OrderBy(models.TableWebhookDelivery+".id", qb.Desc).
+		Limit(filterDto.PerPage, offset).
+		Find(&deliveries)
+
+	return deliveries, total, err
+}
+
+// RedeliverWebhook sends the payload of a past delivery again. The attempt is logged as a new delivery.
+//
+// Parameters:
+//   - webhookID (int): The ID of the webhook.
+//   - deliveryID (int): The ID of the delivery to send again.
+//
+// Returns:
+//   - (*models.WebhookDelivery, error): The queued delivery or an error if any step fails.
+//
+// Possible Errors:
+//   - "Webhook not found" / "Delivery not found": Returned when the webhook or the delivery doesn't exist.
+func RedeliverWebhook(webhookID, deliveryID int) (*models.WebhookDelivery, error) {
+	if _, err := GetWebhookByID(webhookID); err != nil {
+		return nil, err
+	}
+
+	original, err := mb.GetModelByID[models.WebhookDelivery](deliveryID)
+	if err != nil || original == nil || original.WebhookID != webhookID {
+		return nil, errors.New("Delivery not found")
+	}
+
+	delivery := &models.WebhookDelivery{
+		WebhookID: webhookID,
+		Event:     original.Event,
+		Payload:   original.Payload,
+		Status:    types.WebhookDeliveryPending,
+		CreatedAt: time.Now(),
+	}
+
+	if err = mb.CreateModel(delivery); err != nil {
+		log.Errorf("Error while redelivering webhook delivery %d: %v", deliveryID, err)
+
+		return nil, errors.New("Error occurs while redelivering webhook")
+	}
+
+	console.DispatchTask(dto.WebhookNotice{DeliveryID: delivery.ID}, WebhookTask)
+
+	return delivery, nil
+}
+
+// DispatchWebhookEvent logs a delivery of an event for every active webhook subscribed to it
+// and queues the deliveries.
+//
+// Parameters:
+//   - event (types.WebhookEvent): The event type.
+//   - data (any): The article or user of the event (see ArticleWebhookData, UserWebhookData).
+func DispatchWebhookEvent(event types.WebhookEvent, data any) {
+	webhooks, err := FindWebhooks()
+	if err != nil {
+		log.Errorf("Failed to load webhooks of %s: %v", event, err)
+
+		return
+	}
+
+	webhooks = slices.DeleteFunc(webhooks, func(webhook models.Webhook) bool {
+		return !webhook.Active || !slices.Contains(WebhookEvents(webhook), string(event))
+	})
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(dto.WebhookPayload{
+		Event:     event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		log.Errorf("Failed to encode %s payload: %v", event, err)
+
+		return
+	}
+
+	for _, webhook := range webhooks {
+		delivery := &models.WebhookDelivery{
+			WebhookID: webhook.ID,
+			Event:     event,
+			Payload:   string(payload),
+			Status:    types.WebhookDeliveryPending,
+			CreatedAt: time.Now(),
+		}
+
+		if err = mb.CreateModel(delivery); err != nil {
+			log.Errorf("Failed to log %s delivery to webhook %d: %v", event, webhook.ID, err)
+
+			continue
+		}
+
+		console.DispatchTask(dto.WebhookNotice{DeliveryID: delivery.ID}, WebhookTask)
+	}
+}
+
+// DeliverWebhook posts a logged delivery to its receiver, signed with the secret of the webhook.
+// A 2xx answer delivers it. Otherwise, the delivery is retried with an exponential backoff
+// (`WEBHOOK_RETRY_BASE` minutes doubled after each attempt, at most `WEBHOOK_RETRY_MAX` minutes)
+// until `WEBHOOK_MAX_ATTEMPTS` attempts, see QueueWebhookRetries.
+//
+// Parameters:
+//   - deliveryID (int): The ID of the delivery.
+//
+// Returns:
+//   - error: An error when the delivery can't be loaded or saved.
+func DeliverWebhook(deliveryID int) error {
+	delivery, err := mb.GetModelByID[models.WebhookDelivery](deliveryID)
+	if err != nil || delivery == nil {
+		return errors.New("Delivery not found")
+	}
+
+	// Already handled by a previous run of the task
+	if delivery.Status == types.WebhookDeliveryDelivered || delivery.Status == types.WebhookDeliveryFailed {
+		return nil
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.UpdatedAt = dbNull.Time(now)
+	delivery.NextAttemptAt = sql.NullTime{}
+
+	webhook, err := GetWebhookByID(delivery.WebhookID)
+	if err != nil || !webhook.Active {
+		delivery.Status = types.WebhookDeliveryFailed
+		delivery.Error = dbNull.String("Webhook disabled")
+
+		return saveWebhookDelivery(delivery)
+	}
+
+	// Receivers saved before the URL rules, or with http outside prod, are never delivered in prod
+	if err = appUtils.CheckWebhookURL(webhook.URL, webhookAllowHTTP()); err != nil {
+		delivery.Status = types.WebhookDeliveryFailed
+		delivery.Error = dbNull.String(err.Error())
+
+		return saveWebhookDelivery(delivery)
+	}
+
+	client := appUtils.NewWebhookClient(time.Duration(utils.Getenv("WEBHOOK_TIMEOUT", 10)) * time.Second)
+	response, err := appUtils.SendWebhook(client, appUtils.WebhookRequest{
+		URL:        webhook.URL,
+		Secret:     webhook.Secret,
+		Event:      string(delivery.Event),
+		DeliveryID: strconv.Itoa(delivery.ID),
+		Body:       []byte(delivery.Payload),
+		Timestamp:  now,
+	})
+
+	delivery.Error = sql.NullString{}
+	if err != nil {
+		delivery.ResponseCode = sql.NullInt32{}
+		delivery.ResponseBody = sql.NullString{}
+		delivery.Error = dbNull.String(truncateText(err.Error(), 255))
+	} else {
+		delivery.ResponseCode = dbNull.Int32(int32(response.StatusCode))
+		delivery.ResponseBody = optionalString(response.Body)
+	}
+
+	switch {
+	case err == nil && response.StatusCode >= core.StatusOK && response.StatusCode < core.StatusMultipleChoices:
+		delivery.Status = types.WebhookDeliveryDelivered
+		delivery.DeliveredAt = dbNull.Time(now)
+	case delivery.Attempts >= utils.Getenv("WEBHOOK_MAX_ATTEMPTS", 8):
+		delivery.Status = types.WebhookDeliveryFailed
+	default:
+		delivery.Status = types.WebhookDeliveryRetrying
+		delivery.NextAttemptAt = dbNull.Time(now.Add(appUtils.WebhookBackoff(
+			delivery.Attempts,
+			time.Duration(utils.Getenv("WEBHOOK_RETRY_BASE", 1))*time.Minute,
+			time.Duration(utils.Getenv("WEBHOOK_RETRY_MAX", 360))*time.Minute,
+		)))
+	}
+
+	return saveWebhookDelivery(delivery)
+}
+
+// QueueWebhookRetries queues the failed deliveries whose retry time has come.
+//
+// Returns:
+//   - (int, error): The number of queued deliveries and any error encountered.
+func QueueWebhookRetries() (int, error) {
+	var deliveries []models.WebhookDelivery
+
+	if _, err := mb.Instance().Select("*").
+		Where(models.TableWebhookDelivery+".status", qb.Eq, types.WebhookDeliveryRetrying).
+		Where(models.TableWebhookDelivery+".next_attempt_at", qb.LeEq, time.Now()).
+		OrderBy(models.TableWebhookDelivery+".next_attempt_at", qb.Asc).
+		Find(&deliveries); err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for i := range deliveries {
+		// Pending until the worker attempts it, so the next run doesn't queue it twice
+		deliveries[i].Status = types.WebhookDeliveryPending
+		if err := saveWebhookDelivery(&deliveries[i]); err != nil {
+			log.Warnf("Failed to queue retry of webhook delivery %d: %v", deliveries[i].ID, err)
+
+			continue
+		}
+
+		console.DispatchTask(dto.WebhookNotice{DeliveryID: deliveries[i].ID}, WebhookTask)
+		queued++
+	}
+
+	return queued, nil
+}
+
+// ArticleWebhookData builds the data of an article event sent to webhooks.
+func ArticleWebhookData(article models.Article) core.Data {
+	return core.Data{
+		"id":           article.ID,
+		"title":        article.Title,
+		"slug":         article.Slug,
+		"excerpt":      article.Excerpt.String,
+		"cover_image":  article.CoverImage.String,
+		"status":       article.Status,
+		"author_id":    article.AuthorID,
+		"access_level": article.AccessLevel,
+		"age_rating":   article.AgeRating,
+		"published_at": dbNull.TimeVal(article.PublishedAt),
+		"updated_at":   dbNull.TimeVal(article.UpdatedAt),
+		"url":          fmt.Sprintf("%s/truyen/%s", strings.TrimSuffix(core.AppURL, "/"), article.Slug),
+	}
+}
+
+// UserWebhookData builds the data of a user event sent to webhooks.
+func UserWebhookData(user models.User) core.Data {
+	return core.Data{
+		"id":         user.ID,
+		"email":      user.Email,
+		"fullname":   user.Fullname,
+		"status":     user.Status,
+		"created_at": user.CreatedAt,
+	}
+}
+
+// ====================================================================
+// ========================= Helper functions =========================
+// ====================================================================
+
+// webhookEventsColumn stores event types as a comma separated list.
+func webhookEventsColumn(events []types.WebhookEvent) string {
+	list := make([]string, len(events))
+	for i, event := range events {
+		list[i] = string(event)
+	}
+
+	return strings.Join(list, ",")
+}
+
+// webhookAllowHTTP accepts plain http receivers outside prod, for local testing.
+func webhookAllowHTTP() bool {
+	return core.AppEnv != "prod"
+}
+
+// saveWebhookDelivery saves the state of a delivery.
+func saveWebhookDelivery(delivery *models.WebhookDelivery) error {
+	delivery.UpdatedAt = dbNull.Time(time.Now())
+
+	if err := mb.UpdateModel(delivery); err != nil {
+		log.Errorf("Error while saving webhook delivery %d: %v", delivery.ID, err)
+
+		return errors.New("Error occurs while saving webhook delivery")
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package utils
+
+import "time"
+
+// Age computes the age in full years of a person born on birthdate at the time now.
+// People born on February 29 get one year older on March 1 of non-leap years.
+func Age(birthdate, now time.Time) int {
+	if now.Before(birthdate) {
+		return 0
+	}
+
+	age := now.Year() - birthdate.Year()
+	if now.Month() < birthdate.Month() || (now.Month() == birthdate.Month() && now.Day() < birthdate.Day()) {
+		age--
+	}
+
+	return age
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// AuditChange struct to describe the values of a field before and after a change.
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditSnapshot normalizes the state of a target to its JSON values (numbers as float64, times as strings...),
+// so that two snapshots can be compared with AuditDiff. A nil state stays nil.
+func AuditSnapshot(state any) map[string]any {
+	if state == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil
+	}
+
+	var snapshot map[string]any
+	if err = json.Unmarshal(data, &snapshot); err != nil {
+		return nil
+	}
+
+	return snapshot
+}
+
+// AuditDiff lists the fields whose value differs between two snapshots. A field missing in a snapshot
+// (e.g. before a creation or after a deletion) is nil. Returns nil when nothing changed.
+func AuditDiff(before, after map[string]any) map[string]AuditChange {
+	var changes map[string]AuditChange
+
+	add := func(field string) {
+		if reflect.DeepEqual(before[field], after[field]) {
+			return
+		}
+
+		if changes == nil {
+			changes = map[string]AuditChange{}
+		}
+
+		changes[field] = AuditChange{Before: before[field], After: after[field]}
+	}
+
+	for field := range before {
+		add(field)
+	}
+
+	for field := range after {
+		if _, ok := before[field]; !ok {
+			add(field)
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// DeviceClass classifies a User-Agent as "bot", "tablet", "mobile" or "desktop".
+func DeviceClass(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+
+	switch {
+	case ua == "" || containsAny(ua, "bot", "crawler", "spider", "slurp", "facebookexternalhit", "curl/", "wget/"):
+		return "bot"
+	case containsAny(ua, "ipad", "tablet", "kindle", "silk/") || (strings.Contains(ua, "android") && !strings.Contains(ua, "mobile")):
+		return "tablet"
+	case containsAny(ua, "mobi", "iphone", "ipod", "android", "windows phone"):
+		return "mobile"
+	}
+
+	return "desktop"
+}
+
+// ReferrerHost extracts the host of a `Referer` header without the "www." prefix, e.g. "google.com".
+// It returns an empty string for direct visits and invalid referrers.
+func ReferrerHost(referrer string) string {
+	parsed, err := url.Parse(strings.TrimSpace(referrer))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ""
+	}
+
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
+
+// ClientIP resolves the IP of a client. The `X-Forwarded-For` and `X-Real-IP` headers are only honored when
+// the request comes from a trusted proxy (IPs or CIDR networks): `X-Forwarded-For` is read from the right,
+// skipping trusted proxies, so entries added by the client itself are ignored.
+func ClientIP(remoteIP, forwardedFor, realIP string, trustedProxies []string) string {
+	if !isTrustedProxy(remoteIP, trustedProxies) {
+		return remoteIP
+	}
+
+	if forwardedFor != "" {
+		hops := strings.Split(forwardedFor, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				break
+			}
+
+			if i == 0 || !isTrustedProxy(hop, trustedProxies) {
+				return hop
+			}
+		}
+	}
+
+	if realIP = strings.TrimSpace(realIP); net.ParseIP(realIP) != nil {
+		return realIP
+	}
+
+	return remoteIP
+}
+
+// isTrustedProxy checks whether an IP is one of the trusted proxies (IPs or CIDR networks).
+func isTrustedProxy(ip string, trustedProxies []string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+
+	for _, proxy := range trustedProxies {
+		proxy = strings.TrimSpace(proxy)
+
+		if _, network, err := net.ParseCIDR(proxy); err == nil {
+			if network.Contains(parsed) {
+				return true
+			}
+		} else if proxyIP := net.ParseIP(proxy); proxyIP != nil && proxyIP.Equal(parsed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsAny checks whether a text contains one of the given parts.
+func containsAny(text string, parts ...string) bool {
+	for _, part := range parts {
+		if strings.Contains(text, part) {
+			return true
+		}
+	}
+
+	return false
+}
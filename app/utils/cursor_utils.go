@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/gflydev/core/errors"
+)
+
+// Cursor position in a list paginated by keyset (cursor pagination).
+type Cursor struct {
+	OrderBy  string `json:"o"`           // Order of the list, e.g. "-published_at"
+	Value    string `json:"v,omitempty"` // Sort key of the boundary row
+	ID       int    `json:"i"`           // ID of the boundary row, breaks ties of the sort key
+	Backward bool   `json:"b,omitempty"` // True when the cursor points to the previous page
+}
+
+// EncodeCursor converts a cursor to an opaque URL-safe string.
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor reads a cursor created by EncodeCursor.
+func DecodeCursor(value string) (Cursor, error) {
+	var cursor Cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, errors.New("Invalid cursor")
+	}
+
+	if err = json.Unmarshal(data, &cursor); err != nil || cursor.ID < 1 {
+		return cursor, errors.New("Invalid cursor")
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// Bucket deterministically assigns an identifier (user or visitor) to one of n buckets.
+func Bucket(id string, n int) int {
+	if n < 1 {
+		return 0
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(id))
+
+	return int(hash.Sum32() % uint32(n))
+}
+
+// ClickThroughRate computes clicks / impressions, 0 without impressions.
+func ClickThroughRate(clicks, impressions int) float64 {
+	if impressions < 1 {
+		return 0
+	}
+
+	return float64(clicks) / float64(impressions)
+}
+
+// ProportionConfidence computes the confidence (0..1) that the click-through rates of a control and a variant
+// really differ, using a two-sided two-proportion z-test. It is 0 while a side has no impressions.
+func ProportionConfidence(controlClicks, controlImpressions, variantClicks, variantImpressions int) float64 {
+	if controlImpressions < 1 || variantImpressions < 1 {
+		return 0
+	}
+
+	pooled := float64(controlClicks+variantClicks) / float64(controlImpressions+variantImpressions)
+	stdErr := math.Sqrt(pooled * (1 - pooled) * (1/float64(controlImpressions) + 1/float64(variantImpressions)))
+	if stdErr == 0 {
+		return 0
+	}
+
+	z := (ClickThroughRate(variantClicks, variantImpressions) - ClickThroughRate(controlClicks, controlImpressions)) / stdErr
+
+	return math.Erf(math.Abs(z) / math.Sqrt2)
+}
@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// GraphQLSizeArguments arguments setting the number of items of a list field.
+var GraphQLSizeArguments = []string{"perPage", "limit"}
+
+// graphQLCostCap bounds sizes and complexities so that huge arguments can't overflow the count.
+const graphQLCostCap = 1 << 30
+
+// GraphQLCost struct to describe the size of a GraphQL operation before it runs.
+type GraphQLCost struct {
+	Depth      int // Deepest nesting of fields
+	Complexity int // Number of resolved fields, the fields under a list are counted once per item
+}
+
+// MeasureGraphQL computes the depth and the complexity of an operation of a GraphQL query.
+// A list field multiplies the complexity of its selection by its `perPage` or `limit` argument,
+// by its size in sizes without argument. Introspection fields (`__schema`, `__type`...) are free.
+//
+// An empty operation name measures the largest operation of the query.
+func MeasureGraphQL(query, operationName string, variables map[string]any, sizes map[string]int) (GraphQLCost, error) {
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return GraphQLCost{}, err
+	}
+
+	fragments := map[string]*ast.FragmentDefinition{}
+	var operations []*ast.OperationDefinition
+
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operationName == "" || (definition.Name != nil && definition.Name.Value == operationName) {
+				operations = append(operations, definition)
+			}
+		}
+	}
+
+	if len(operations) == 0 {
+		return GraphQLCost{}, errors.New("unknown operation " + operationName)
+	}
+
+	measure := graphQLMeasure{fragments: fragments, variables: variables, sizes: sizes}
+
+	var cost GraphQLCost
+	for _, operation := range operations {
+		operationCost := measure.selectionSet(operation.SelectionSet, map[string]bool{})
+		cost.Depth = max(cost.Depth, operationCost.Depth)
+		cost.Complexity = max(cost.Complexity, operationCost.Complexity)
+	}
+
+	return cost, nil
+}
+
+// graphQLMeasure walks the selections of an operation.
+type graphQLMeasure struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+	sizes     map[string]int
+}
+
+// selectionSet measures a selection set. Spread fragments are followed once per path (cycles are invalid queries).
+func (m graphQLMeasure) selectionSet(selectionSet *ast.SelectionSet, spread map[string]bool) GraphQLCost {
+	var cost GraphQLCost
+	if selectionSet == nil {
+		return cost
+	}
+
+	for _, selection := range selectionSet.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(selection.Name.Value, "__") {
+				continue
+			}
+
+			fieldCost := m.selectionSet(selection.SelectionSet, spread)
+			cost.Depth = max(cost.Depth, fieldCost.Depth+1)
+			cost.Complexity = min(cost.Complexity+1+m.size(selection)*fieldCost.Complexity, graphQLCostCap)
+		case *ast.InlineFragment:
+			fragmentCost := m.selectionSet(selection.SelectionSet, spread)
+			cost.Depth = max(cost.Depth, fragmentCost.Depth)
+			cost.Complexity = min(cost.Complexity+fragmentCost.Complexity, graphQLCostCap)
+		case *ast.FragmentSpread:
+			fragment, ok := m.fragments[selection.Name.Value]
+			if !ok || spread[fragment.Name.Value] {
+				continue
+			}
+
+			spread[fragment.Name.Value] = true
+			fragmentCost := m.selectionSet(fragment.SelectionSet, spread)
+			delete(spread, fragment.Name.Value)
+
+			cost.Depth = max(cost.Depth, fragmentCost.Depth)
+			cost.Complexity = min(cost.Complexity+fragmentCost.Complexity, graphQLCostCap)
+		}
+	}
+
+	return cost
+}
+
+// size number of items of a list field: its size argument, its default size, 1 for other fields.
+// Sizes are capped at 10000, the resolvers reject much smaller pages anyway.
+func (m graphQLMeasure) size(field *ast.Field) int {
+	for _, argument := range field.Arguments {
+		if !slices.Contains(GraphQLSizeArguments, argument.Name.Value) {
+			continue
+		}
+
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if size, err := strconv.Atoi(value.Value); err == nil || errors.Is(err, strconv.ErrRange) {
+				return min(max(size, 1), 10000)
+			}
+		case *ast.Variable:
+			switch size := m.variables[value.Name.Value].(type) {
+			case int:
+				return min(max(size, 1), 10000)
+			case float64:
+				return int(min(max(size, 1), 10000))
+			}
+		}
+	}
+
+	if size, ok := m.sizes[field.Name.Value]; ok {
+		return max(size, 1)
+	}
+
+	return 1
+}
@@ -0,0 +1,210 @@
+package utils
+
+import (
+	"html"
+	"net/url"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// allowedTags tags kept by SanitizeHTML with their allowed attributes.
+var allowedTags = map[string][]string{
+	"p": {}, "br": {}, "hr": {}, "strong": {}, "b": {}, "em": {}, "i": {}, "u": {}, "s": {},
+	"h2": {}, "h3": {}, "h4": {}, "blockquote": {}, "ul": {}, "ol": {}, "li": {},
+	"pre": {}, "code": {}, "figure": {}, "figcaption": {}, "span": {}, "div": {},
+	"a":   {"href", "title"},
+	"img": {"src", "alt", "title"},
+}
+
+// droppedTags tags removed by SanitizeHTML together with their content.
+var droppedTags = []string{"script", "style", "iframe", "object", "embed", "form", "noscript", "template", "svg", "math"}
+
+// voidTags tags without a closing tag.
+var voidTags = []string{"br", "hr", "img"}
+
+// blockTags tags after which TeaserHTML can cut a content.
+var blockTags = []string{"p", "h2", "h3", "h4", "blockquote", "ul", "ol", "li", "pre", "figure", "div", "hr"}
+
+// SanitizeHTML keeps the formatting tags of an HTML content and removes scripts, styles, embeds,
+// event handlers and unsafe links.
+func SanitizeHTML(content string) string {
+	teaser, _ := TeaserHTML(content, 0)
+
+	return teaser
+}
+
+// TeaserHTML sanitizes an HTML content (see SanitizeHTML) and cuts it after the first block
+// (paragraph, heading, list item...) at any depth which reaches maxChars characters of text, so no paragraph
+// is cut in the middle. A content longer than maxChars without such a boundary (plain text, a single block)
+// is cut after maxChars characters, at a word boundary. A maxChars lower than 1 keeps the whole content.
+//
+// Returns the teaser and true when the content was cut.
+func TeaserHTML(content string, maxChars int) (string, bool) {
+	var builder strings.Builder
+	var open []string // Open allowed tags
+	skipped := 0      // Depth inside dropped tags
+	chars := 0        // Characters of text written
+	boundary := false // The last written element is a complete block
+	hardCut := ""     // Teaser cut at maxChars characters, used when no block boundary is found
+
+	tokenizer := xhtml.NewTokenizer(strings.NewReader(content))
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == xhtml.ErrorToken {
+			// io.EOF at the end of the content
+			break
+		}
+
+		token := tokenizer.Token()
+
+		// The teaser is long enough and a block is complete: cut if anything is left
+		if maxChars > 0 && chars >= maxChars && boundary && skipped == 0 && isTeaserContent(tokenType, token) {
+			return strings.TrimSpace(builder.String() + closingTags(open)), true
+		}
+
+		switch tokenType {
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+			if slices.Contains(droppedTags, token.Data) {
+				if tokenType == xhtml.StartTagToken {
+					skipped++
+				}
+
+				continue
+			}
+
+			attributes, ok := allowedTags[token.Data]
+			if !ok || skipped > 0 {
+				continue
+			}
+
+			builder.WriteString("<" + token.Data)
+			for _, attribute := range token.Attr {
+				if !slices.Contains(attributes, attribute.Key) {
+					continue
+				}
+
+				if (attribute.Key == "href" || attribute.Key == "src") && !isSafeURL(attribute.Val) {
+					continue
+				}
+
+				builder.WriteString(" " + attribute.Key + `="` + html.EscapeString(attribute.Val) + `"`)
+			}
+			builder.WriteString(">")
+
+			if !slices.Contains(voidTags, token.Data) && tokenType == xhtml.StartTagToken {
+				open = append(open, token.Data)
+			}
+
+			boundary = token.Data == "hr"
+		case xhtml.EndTagToken:
+			if slices.Contains(droppedTags, token.Data) {
+				skipped = max(skipped-1, 0)
+
+				continue
+			}
+
+			if skipped > 0 {
+				continue
+			}
+
+			// Close up to the matching open tag, ignore stray closing tags
+			if index := lastIndex(open, token.Data); index >= 0 {
+				for i := len(open) - 1; i >= index; i-- {
+					builder.WriteString("</" + open[i] + ">")
+				}
+				open = open[:index]
+
+				if slices.Contains(blockTags, token.Data) {
+					boundary = true
+				}
+			}
+		case xhtml.TextToken:
+			if skipped > 0 {
+				continue
+			}
+
+			text := strings.TrimSpace(token.Data)
+			length := utf8.RuneCountInString(text)
+
+			// Keep the content cut at maxChars in case no block ends after it
+			if maxChars > 0 && hardCut == "" && chars+length > maxChars {
+				hardCut = builder.String() + html.EscapeString(cutWords(text, maxChars-chars)) + "…" + closingTags(open)
+			}
+
+			builder.WriteString(html.EscapeString(token.Data))
+			chars += length
+
+			if text != "" {
+				boundary = false
+			}
+		}
+	}
+
+	if hardCut != "" {
+		return strings.TrimSpace(hardCut), true
+	}
+
+	return strings.TrimSpace(builder.String() + closingTags(open)), false
+}
+
+// isTeaserContent checks whether a token adds content to a teaser: text, or an element which isn't dropped.
+func isTeaserContent(tokenType xhtml.TokenType, token xhtml.Token) bool {
+	switch tokenType {
+	case xhtml.TextToken:
+		return strings.TrimSpace(token.Data) != ""
+	case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+		return !slices.Contains(droppedTags, token.Data)
+	}
+
+	return false
+}
+
+// closingTags closes the open tags, the innermost first.
+func closingTags(open []string) string {
+	var builder strings.Builder
+	for i := len(open) - 1; i >= 0; i-- {
+		builder.WriteString("</" + open[i] + ">")
+	}
+
+	return builder.String()
+}
+
+// cutWords keeps the first maxRunes characters of a text, without cutting a word when it has several.
+func cutWords(text string, maxRunes int) string {
+	runes := []rune(text)
+	if len(runes) <= maxRunes {
+		return text
+	}
+
+	kept := string(runes[:max(maxRunes, 0)])
+	if index := strings.LastIndexAny(kept, " \t\n"); index > 0 {
+		kept = kept[:index]
+	}
+
+	return strings.TrimSpace(kept)
+}
+
+// isSafeURL checks that a link is relative or uses the http, https or mailto scheme.
+func isSafeURL(rawURL string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return false
+	}
+
+	return parsed.Scheme == "" || slices.Contains([]string{"http", "https", "mailto"}, strings.ToLower(parsed.Scheme))
+}
+
+// lastIndex returns the index of the last occurrence of a tag in the open tags.
+func lastIndex(open []string, tag string) int {
+	for i := len(open) - 1; i >= 0; i-- {
+		if open[i] == tag {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// NegotiateLocale picks the locale of a response among the supported locales.
+// The explicit `lang` wins, then the `Accept-Language` preferences (highest quality first),
+// then the fallback. Regional variants match their base language, e.g. "en-US" selects "en".
+func NegotiateLocale(lang, acceptLanguage string, supported []string, fallback string) string {
+	if locale := matchLocale(lang, supported); locale != "" {
+		return locale
+	}
+
+	// Tags are sorted by quality, tags with q=0 are dropped
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return fallback
+	}
+
+	for _, tag := range tags {
+		if locale := matchLocale(tag.String(), supported); locale != "" {
+			return locale
+		}
+	}
+
+	return fallback
+}
+
+// matchLocale returns the supported locale matching a language tag (exact, then base language).
+func matchLocale(tag string, supported []string) string {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	if tag == "" {
+		return ""
+	}
+
+	if slices.Contains(supported, tag) {
+		return tag
+	}
+
+	base, _, _ := strings.Cut(tag, "-")
+	if slices.Contains(supported, base) {
+		return base
+	}
+
+	return ""
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Slugify converts a text to a URL-friendly slug (lowercase ASCII letters, digits and hyphens).
+// Diacritics are removed, e.g. "Chuyện ma đêm khuya" becomes "chuyen-ma-dem-khuya".
+func Slugify(text string) string {
+	var builder strings.Builder
+	hyphen := false
+
+	for _, r := range norm.NFD.String(strings.ToLower(text)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Skip combining marks (accents)
+			continue
+		case r == 'đ':
+			r = 'd'
+		}
+
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			hyphen = false
+		} else if !hyphen && builder.Len() > 0 {
+			builder.WriteRune('-')
+			hyphen = true
+		}
+	}
+
+	return strings.TrimSuffix(builder.String(), "-")
+}
+
+// SplitList splits a comma separated list, trimming spaces and dropping empty and duplicated items,
+// e.g. "violence, gore,,violence" becomes ["violence", "gore"].
+func SplitList(value string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" && !slices.Contains(items, item) {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
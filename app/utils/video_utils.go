@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Video providers
+const (
+	VideoProviderYouTube = "youtube"
+	VideoProviderTikTok  = "tiktok"
+)
+
+// VideoEmbed structured metadata of a video link.
+type VideoEmbed struct {
+	Provider     string // Video provider (youtube or tiktok)
+	VideoID      string // Provider video ID. Empty for TikTok short links which can't be resolved offline.
+	ShortCode    string // TikTok short link code (vm.tiktok.com/{code})
+	CanonicalURL string // Normalized watch URL
+	EmbedURL     string // URL to use in an iframe. Empty when the video ID is unknown.
+	StartTime    int    // Start offset in seconds
+}
+
+var (
+	youTubeIDRegex    = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+	tikTokIDRegex     = regexp.MustCompile(`^[0-9]{8,25}$`)
+	tikTokCodeRegex   = regexp.MustCompile(`^[A-Za-z0-9]{5,20}$`)
+	durationPartRegex = regexp.MustCompile(`^(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s?)?$`)
+)
+
+// ParseYouTubeURL parses any known YouTube link shape.
+//
+// Supported shapes:
+//   - https://www.youtube.com/watch?v={id} (also m., music. and no subdomain)
+//   - https://youtu.be/{id}
+//   - https://www.youtube.com/shorts/{id}, /embed/{id}, /v/{id}, /live/{id}
+//   - https://www.youtube-nocookie.com/embed/{id}
+//
+// The start time is read from the `t` or `start` query parameter (e.g. 90, 90s, 1m30s, 1h2m3s).
+//
+// Parameters:
+//   - rawURL: The link to parse
+//
+// Returns:
+//   - *VideoEmbed: The video metadata
+//   - bool: False if the link is not a YouTube video link
+func ParseYouTubeURL(rawURL string) (*VideoEmbed, bool) {
+	u, ok := parseVideoURL(rawURL)
+	if !ok {
+		return nil, false
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	segments := pathSegments(u.Path)
+
+	var videoID string
+
+	switch host {
+	case "youtu.be":
+		if len(segments) > 0 {
+			videoID = segments[0]
+		}
+	case "youtube.com", "m.youtube.com", "music.youtube.com", "youtube-nocookie.com":
+		switch {
+		case len(segments) == 1 && segments[0] == "watch":
+			videoID = u.Query().Get("v")
+		case len(segments) >= 2 && (segments[0] == "shorts" || segments[0] == "embed" || segments[0] == "v" || segments[0] == "live"):
+			videoID = segments[1]
+		}
+	default:
+		return nil, false
+	}
+
+	if !youTubeIDRegex.MatchString(videoID) {
+		return nil, false
+	}
+
+	startTime := parseStartTime(u.Query().Get("t"))
+	if startTime == 0 {
+		startTime = parseStartTime(u.Query().Get("start"))
+	}
+
+	embedURL := "https://www.youtube.com/embed/" + videoID
+	if startTime > 0 {
+		embedURL = fmt.Sprintf("%s?start=%d", embedURL, startTime)
+	}
+
+	return &VideoEmbed{
+		Provider:     VideoProviderYouTube,
+		VideoID:      videoID,
+		CanonicalURL: "https://www.youtube.com/watch?v=" + videoID,
+		EmbedURL:     embedURL,
+		StartTime:    startTime,
+	}, true
+}
+
+// ParseTikTokURL parses any known TikTok link shape.
+//
+// Supported shapes:
+//   - https://www.tiktok.com/@{user}/video/{id} (also m. and no subdomain)
+//   - https://m.tiktok.com/v/{id}.html
+//   - https://www.tiktok.com/embed/{id}, /embed/v2/{id}, /player/v1/{id}
+//   - https://vm.tiktok.com/{code}/, https://vt.tiktok.com/{code}/, https://www.tiktok.com/t/{code}/
+//
+// Short links (`vm.`, `vt.`, `/t/`) need a network redirect to resolve the video ID, so only their code is kept.
+//
+// Parameters:
+//   - rawURL: The link to parse
+//
+// Returns:
+//   - *VideoEmbed: The video metadata
+//   - bool: False if the link is not a TikTok video link
+func ParseTikTokURL(rawURL string) (*VideoEmbed, bool) {
+	u, ok := parseVideoURL(rawURL)
+	if !ok {
+		return nil, false
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	segments := pathSegments(u.Path)
+
+	var videoID, username, shortCode string
+
+	switch host {
+	case "vm.tiktok.com", "vt.tiktok.com":
+		if len(segments) > 0 {
+			shortCode = segments[0]
+		}
+	case "tiktok.com", "m.tiktok.com":
+		switch {
+		case len(segments) == 3 && strings.HasPrefix(segments[0], "@") && segments[1] == "video":
+			username, videoID = segments[0], segments[2]
+		case len(segments) == 2 && segments[0] == "v":
+			videoID = strings.TrimSuffix(segments[1], ".html")
+		case len(segments) == 2 && segments[0] == "embed":
+			videoID = segments[1]
+		case len(segments) == 3 && segments[0] == "embed" && segments[1] == "v2":
+			videoID = segments[2]
+		case len(segments) == 3 && segments[0] == "player" && segments[1] == "v1":
+			videoID = segments[2]
+		case len(segments) == 2 && segments[0] == "t":
+			shortCode = segments[1]
+		}
+	default:
+		return nil, false
+	}
+
+	if shortCode != "" {
+		if !tikTokCodeRegex.MatchString(shortCode) {
+			return nil, false
+		}
+
+		return &VideoEmbed{
+			Provider:     VideoProviderTikTok,
+			ShortCode:    shortCode,
+			CanonicalURL: fmt.Sprintf("https://vm.tiktok.com/%s/", shortCode),
+		}, true
+	}
+
+	if !tikTokIDRegex.MatchString(videoID) {
+		return nil, false
+	}
+
+	canonicalURL := fmt.Sprintf("https://www.tiktok.com/video/%s", videoID)
+	if username != "" {
+		canonicalURL = fmt.Sprintf("https://www.tiktok.com/%s/video/%s", username, videoID)
+	}
+
+	return &VideoEmbed{
+		Provider:     VideoProviderTikTok,
+		VideoID:      videoID,
+		CanonicalURL: canonicalURL,
+		EmbedURL:     "https://www.tiktok.com/embed/v2/" + videoID,
+	}, true
+}
+
+// IsValidYouTubeURL checks if a string is a supported YouTube video link
+func IsValidYouTubeURL(rawURL string) bool {
+	_, ok := ParseYouTubeURL(rawURL)
+	return ok
+}
+
+// IsValidTikTokURL checks if a string is a supported TikTok video link
+func IsValidTikTokURL(rawURL string) bool {
+	_, ok := ParseTikTokURL(rawURL)
+	return ok
+}
+
+// parseVideoURL parses a link, accepting links without scheme (e.g. youtu.be/{id}).
+func parseVideoURL(rawURL string) (*url.URL, bool) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return nil, false
+	}
+
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, false
+	}
+
+	return u, true
+}
+
+// pathSegments splits a URL path into its non-empty segments.
+func pathSegments(path string) []string {
+	var segments []string
+
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments
+}
+
+// parseStartTime converts a start time (e.g. 90, 90s, 1m30s, 1h2m3s) to seconds.
+func parseStartTime(value string) int {
+	if value == "" {
+		return 0
+	}
+
+	matches := durationPartRegex.FindStringSubmatch(strings.ToLower(value))
+	if matches == nil {
+		return 0
+	}
+
+	seconds := 0
+	for i, unit := range []int{3600, 60, 1} {
+		if n, err := strconv.Atoi(matches[i+1]); err == nil {
+			seconds += n * unit
+		}
+	}
+
+	return seconds
+}
@@ -0,0 +1,228 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gflydev/core/errors"
+)
+
+// Headers of webhook requests
+const (
+	WebhookSignatureHeader = "X-Webhook-Signature" // t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>">
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// nonPublicNetworks reserved IPv4 ranges not covered by the net.IP helpers.
+var nonPublicNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),     // "This" network
+	mustParseCIDR("100.64.0.0/10"), // Carrier-grade NAT
+	mustParseCIDR("192.0.0.0/24"),  // IETF protocol assignments
+	mustParseCIDR("198.18.0.0/15"), // Benchmarking
+	mustParseCIDR("240.0.0.0/4"),   // Reserved and broadcast
+}
+
+// WebhookRequest struct to describe a signed webhook request.
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID string
+	Body       []byte // JSON payload
+	Timestamp  time.Time
+}
+
+// WebhookResponse struct to describe the answer of a webhook receiver.
+type WebhookResponse struct {
+	StatusCode int
+	Body       string // Truncated to 1000 bytes
+}
+
+// SignWebhook computes the signature header of a webhook payload: `t=<unix time>,v1=<hex HMAC-SHA256>`.
+// The HMAC covers the timestamp and the body, so a captured request can't be replayed later with another timestamp.
+func SignWebhook(secret string, timestamp time.Time, body []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", timestamp.Unix(), webhookHMAC(secret, timestamp.Unix(), body))
+}
+
+// VerifyWebhook checks the signature header of a webhook payload and that it was signed within the tolerance.
+// A tolerance lower than 1 doesn't check the age.
+func VerifyWebhook(secret, header string, body []byte, tolerance time.Duration, now time.Time) bool {
+	var timestamp int64
+	var signature string
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+
+		switch key {
+		case "t":
+			timestamp, _ = strconv.ParseInt(value, 10, 64)
+		case "v1":
+			signature = value
+		}
+	}
+
+	if timestamp == 0 || signature == "" {
+		return false
+	}
+
+	if tolerance > 0 && now.Sub(time.Unix(timestamp, 0)).Abs() > tolerance {
+		return false
+	}
+
+	return hmac.Equal([]byte(signature), []byte(webhookHMAC(secret, timestamp, body)))
+}
+
+// SendWebhook posts a signed webhook request. A response is returned for any HTTP status,
+// the error is set when the receiver can't be reached.
+func SendWebhook(client *http.Client, request WebhookRequest) (*WebhookResponse, error) {
+	httpRequest, err := http.NewRequest(http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("User-Agent", "gFly-Webhook/1.0")
+	httpRequest.Header.Set(WebhookEventHeader, request.Event)
+	httpRequest.Header.Set(WebhookDeliveryHeader, request.DeliveryID)
+	httpRequest.Header.Set(WebhookSignatureHeader, SignWebhook(request.Secret, request.Timestamp, request.Body))
+
+	httpResponse, err := client.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	body, _ := io.ReadAll(io.LimitReader(httpResponse.Body, 1000))
+
+	return &WebhookResponse{
+		StatusCode: httpResponse.StatusCode,
+		Body:       string(body),
+	}, nil
+}
+
+// CheckWebhookURL checks the receiver URL of a webhook: an absolute https URL, or http when allowHTTP is set.
+// A host given as a loopback, private or link-local IP is refused. Host names are checked when delivering,
+// see NewWebhookClient.
+func CheckWebhookURL(rawURL string, allowHTTP bool) error {
+	target, err := url.Parse(rawURL)
+	if err != nil || target.Hostname() == "" {
+		return errors.New("Invalid webhook URL")
+	}
+
+	if target.Scheme != "https" && (target.Scheme != "http" || !allowHTTP) {
+		return errors.New("Webhook URL must use https")
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(target.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errors.New("Webhook URL must target a public host")
+	}
+
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIP(ip) {
+		return errors.New("Webhook URL must target a public host")
+	}
+
+	return nil
+}
+
+// IsPublicIP reports whether an IP is routable on the internet: loopback, private, link-local,
+// multicast, unspecified and reserved addresses are not.
+func IsPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WebhookDialControl refuses to connect to a non-public IP. It runs after the host name is resolved,
+// so a name pointing (or rebound) to an internal address is refused too.
+func WebhookDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+		return errors.New("Webhook target %s is not a public address", host)
+	}
+
+	return nil
+}
+
+// NewWebhookClient creates the HTTP client delivering webhooks. Connections to non-public IPs are refused
+// (see WebhookDialControl), proxies of the environment are ignored and redirects aren't followed:
+// the 3xx answer is returned as is.
+func NewWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: WebhookDialControl,
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+			ForceAttemptHTTP2:   true,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// WebhookBackoff computes the delay before the next attempt of a failed delivery: base, 2 x base, 4 x base...
+// capped at maxDelay.
+func WebhookBackoff(attempt int, base, maxDelay time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
+
+// webhookHMAC computes the hex HMAC-SHA256 of `<timestamp>.<body>`.
+func webhookHMAC(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%d.", timestamp)
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// mustParseCIDR parses a CIDR network, panics when it's invalid.
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+
+	return network
+}
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_article_translations_locale_slug;
+DROP INDEX IF EXISTS idx_article_translations_article_locale;
+
+-- Drop table
+DROP TABLE IF EXISTS article_translations;
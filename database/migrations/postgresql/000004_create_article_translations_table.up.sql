@@ -0,0 +1,19 @@
+CREATE TABLE article_translations (
+    id SERIAL PRIMARY KEY,
+    article_id INT NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) NOT NULL,
+    excerpt TEXT,
+    content TEXT NOT NULL,
+    seo_description VARCHAR(300),
+    seo_keywords TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    CONSTRAINT fk_article_translations_article
+        FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+-- One translation per locale, slugs are unique per locale
+CREATE UNIQUE INDEX idx_article_translations_article_locale ON article_translations(article_id, locale);
+CREATE UNIQUE INDEX idx_article_translations_locale_slug ON article_translations(locale, slug);
@@ -0,0 +1,7 @@
+-- Drop the index first
+DROP INDEX IF EXISTS idx_articles_age_rating;
+
+-- Remove the age gate columns
+ALTER TABLE users DROP COLUMN IF EXISTS birthdate;
+ALTER TABLE articles DROP COLUMN IF EXISTS age_rating;
+ALTER TABLE articles DROP COLUMN IF EXISTS content_warnings;
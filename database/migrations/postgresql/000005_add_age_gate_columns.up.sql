@@ -0,0 +1,8 @@
+-- Content warning labels (comma separated, e.g. 'violence,gore') and minimum reader age (0, 13, 16 or 18)
+ALTER TABLE articles ADD COLUMN content_warnings VARCHAR(255) NULL;
+ALTER TABLE articles ADD COLUMN age_rating SMALLINT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_articles_age_rating ON articles(age_rating);
+
+-- Birthdate confirmed by the user to read age-gated stories
+ALTER TABLE users ADD COLUMN birthdate DATE NULL;
@@ -0,0 +1,6 @@
+-- Drop the entitlements
+DROP TABLE IF EXISTS user_entitlements;
+
+-- Remove the access level of articles
+DROP INDEX IF EXISTS idx_articles_access_level;
+ALTER TABLE articles DROP COLUMN IF EXISTS access_level;
@@ -0,0 +1,19 @@
+-- Access level of articles: public, members or premium
+ALTER TABLE articles ADD COLUMN access_level VARCHAR(20) NOT NULL DEFAULT 'public';
+
+CREATE INDEX idx_articles_access_level ON articles(access_level);
+
+-- Paid plans of users (one entitlement per user)
+CREATE TABLE user_entitlements (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    plan VARCHAR(20) NOT NULL,
+    expires_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    CONSTRAINT fk_user_entitlements_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_user_entitlements_user ON user_entitlements(user_id);
+CREATE INDEX idx_user_entitlements_expires_at ON user_entitlements(expires_at);
@@ -0,0 +1,2 @@
+-- Drop the reading events
+DROP TABLE IF EXISTS article_view_events;
@@ -0,0 +1,16 @@
+-- Reading events of articles used by the analytics API
+CREATE TABLE article_view_events (
+    id BIGSERIAL PRIMARY KEY,
+    article_id INT NOT NULL,
+    event VARCHAR(20) NOT NULL,          -- view or progress
+    progress SMALLINT NULL,              -- Scroll depth in percent of progress events
+    visitor_id VARCHAR(64) NOT NULL,     -- Anonymous visitor hash (IP + User-Agent)
+    referrer_host VARCHAR(255) NULL,     -- NULL for direct visits
+    device VARCHAR(20) NOT NULL,         -- desktop, mobile, tablet or bot
+    authenticated BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_article_view_events_article
+        FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_article_view_events_article_created ON article_view_events(article_id, event, created_at);
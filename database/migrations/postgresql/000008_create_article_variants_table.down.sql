@@ -0,0 +1,2 @@
+-- Drop the A/B test variants
+DROP TABLE IF EXISTS article_variants;
@@ -0,0 +1,17 @@
+-- A/B test variants of article titles and cover images.
+-- Variant `a` is the control (the article itself), the others override the title and/or the cover image.
+CREATE TABLE article_variants (
+    id SERIAL PRIMARY KEY,
+    article_id INT NOT NULL,
+    key VARCHAR(1) NOT NULL,
+    title VARCHAR(255) NULL,            -- NULL keeps the title of the article
+    cover_image VARCHAR(255) NULL,      -- NULL keeps the cover image of the article
+    impressions INT NOT NULL DEFAULT 0, -- Unique list impressions
+    clicks INT NOT NULL DEFAULT 0,      -- Unique detail opens from a list
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    CONSTRAINT fk_article_variants_article
+        FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_article_variants_article_key ON article_variants(article_id, key);
@@ -0,0 +1,3 @@
+-- Drop the newsletter tables
+DROP TABLE IF EXISTS digest_deliveries;
+DROP TABLE IF EXISTS subscribers;
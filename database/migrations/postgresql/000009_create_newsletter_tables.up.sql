@@ -0,0 +1,39 @@
+-- Newsletter subscribers (double opt-in, with or without an account)
+CREATE TABLE subscribers (
+    id SERIAL PRIMARY KEY,
+    email VARCHAR(255) NOT NULL,
+    user_id INT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, active or unsubscribed
+    confirm_token VARCHAR(64) NULL,               -- Cleared once the subscription is confirmed
+    unsubscribe_token VARCHAR(64) NOT NULL,       -- One-click unsubscribe link of every email
+    confirm_sent_at TIMESTAMP NULL,
+    confirmed_at TIMESTAMP NULL,
+    unsubscribed_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    CONSTRAINT fk_subscribers_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX idx_subscribers_email ON subscribers(email);
+CREATE UNIQUE INDEX idx_subscribers_confirm_token ON subscribers(confirm_token);
+CREATE UNIQUE INDEX idx_subscribers_unsubscribe_token ON subscribers(unsubscribe_token);
+CREATE INDEX idx_subscribers_status ON subscribers(status);
+
+-- Delivery state of the weekly digest per recipient
+CREATE TABLE digest_deliveries (
+    id SERIAL PRIMARY KEY,
+    digest VARCHAR(10) NOT NULL,                 -- ISO week of the digest, e.g. 2026-W42
+    subscriber_id INT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'queued', -- queued, sent or failed
+    attempts INT NOT NULL DEFAULT 0,
+    error VARCHAR(255) NULL,                      -- Last sending error
+    sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    CONSTRAINT fk_digest_deliveries_subscriber
+        FOREIGN KEY (subscriber_id) REFERENCES subscribers(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_digest_deliveries_digest_subscriber ON digest_deliveries(digest, subscriber_id);
+CREATE INDEX idx_digest_deliveries_status ON digest_deliveries(digest, status);
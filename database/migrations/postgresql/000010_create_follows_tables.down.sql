@@ -0,0 +1,6 @@
+-- Drop the follow and notification tables
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS follows;
+DROP TABLE IF EXISTS article_categories;
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,70 @@
+-- Story categories (e.g. "Truyện ma có thật")
+CREATE TABLE categories (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(120) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+CREATE UNIQUE INDEX idx_categories_slug ON categories(slug);
+
+-- Categories of articles
+CREATE TABLE article_categories (
+    id SERIAL PRIMARY KEY,
+    article_id INT NOT NULL,
+    category_id INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_article_categories_article
+        FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_categories_category
+        FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_article_categories_article_category ON article_categories(article_id, category_id);
+CREATE INDEX idx_article_categories_category ON article_categories(category_id);
+
+-- Categories and authors followed by users
+CREATE TABLE follows (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    target_type VARCHAR(20) NOT NULL, -- category or author
+    target_id INT NOT NULL,           -- ID of the category or of the author (user)
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_follows_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_follows_user_target ON follows(user_id, target_type, target_id);
+CREATE INDEX idx_follows_target ON follows(target_type, target_id);
+
+-- Notification channels of users (both channels are enabled without a row)
+CREATE TABLE notification_preferences (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    email BOOLEAN NOT NULL DEFAULT TRUE,
+    in_app BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    CONSTRAINT fk_notification_preferences_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_notification_preferences_user ON notification_preferences(user_id);
+
+-- In-app notifications
+CREATE TABLE notifications (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    type VARCHAR(30) NOT NULL, -- new_story
+    article_id INT NOT NULL,
+    read_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_notifications_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_notifications_article
+        FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_notifications_user_type_article ON notifications(user_id, type, article_id);
+CREATE INDEX idx_notifications_user_created_at ON notifications(user_id, created_at);
@@ -0,0 +1,33 @@
+-- Outbound webhook subscriptions
+CREATE TABLE webhooks (
+    id SERIAL PRIMARY KEY,
+    url VARCHAR(500) NOT NULL,
+    secret VARCHAR(100) NOT NULL,        -- HMAC-SHA256 key of the `X-Webhook-Signature` header
+    events VARCHAR(255) NOT NULL,        -- Comma separated event types, e.g. article.published,article.deleted
+    description VARCHAR(255) NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+-- Delivery log of webhooks
+CREATE TABLE webhook_deliveries (
+    id SERIAL PRIMARY KEY,
+    webhook_id INT NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,                         -- JSON body sent to the receiver
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, delivered, retrying or failed
+    attempts INT NOT NULL DEFAULT 0,
+    response_code INT NULL,                        -- HTTP status of the last attempt
+    response_body VARCHAR(1000) NULL,              -- Beginning of the body of the last response
+    error VARCHAR(255) NULL,                       -- Last connection error
+    next_attempt_at TIMESTAMP NULL,                -- Retry time of a failed attempt (exponential backoff)
+    delivered_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    CONSTRAINT fk_webhook_deliveries_webhook
+        FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhook_deliveries_webhook_created_at ON webhook_deliveries(webhook_id, created_at);
+CREATE INDEX idx_webhook_deliveries_status_next_attempt_at ON webhook_deliveries(status, next_attempt_at);
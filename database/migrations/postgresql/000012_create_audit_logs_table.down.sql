@@ -0,0 +1,2 @@
+-- Drop the audit log table
+DROP TABLE IF EXISTS audit_logs;
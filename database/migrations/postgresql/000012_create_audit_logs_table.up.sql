@@ -0,0 +1,20 @@
+-- Audit trail of the privileged (admin) mutations
+CREATE TABLE audit_logs (
+    id SERIAL PRIMARY KEY,
+    actor_id INT NOT NULL,                  -- User who made the change
+    actor_email VARCHAR(255) NOT NULL,      -- Kept when the actor is deleted
+    action VARCHAR(50) NOT NULL,            -- e.g. user.status, article.update
+    target_type VARCHAR(20) NOT NULL,       -- user, article, category, webhook...
+    target_id INT NULL,
+    changes TEXT NULL,                      -- JSON {"<field>": {"before": ..., "after": ...}} of the changed fields
+    method VARCHAR(10) NOT NULL,
+    path VARCHAR(255) NOT NULL,
+    status_code INT NOT NULL,
+    ip VARCHAR(45) NOT NULL,
+    user_agent VARCHAR(500) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_audit_logs_created_at ON audit_logs(created_at);
+CREATE INDEX idx_audit_logs_actor_id ON audit_logs(actor_id, created_at);
+CREATE INDEX idx_audit_logs_target ON audit_logs(target_type, target_id, created_at);
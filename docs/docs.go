@@ -23,14 +23,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/admin/articles/{id}": {
-            "put": {
+        "/admin/articles": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function allows users to update an existing article",
+                "description": "Returns a paginated list of articles that can be filtered and sorted",
                 "consumes": [
                     "application/json"
                 ],
@@ -40,30 +40,134 @@ const docTemplate = `{
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Update an existing article",
+                "summary": "List articles with pagination and filtering",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Article ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "description": "UpdateArticle payload",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdateArticle"
-                        }
+                        "type": "integer",
+                        "description": "Items per page (default: 10)",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword in title, slug, excerpt, and content",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field to order by (prefix with '-' for descending, e.g. '-created_at')",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by article status (draft, published, archived)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by author ID",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Published on or after this date (YYYY-MM-DD)",
+                        "name": "published_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Published on or before this date (YYYY-MM-DD)",
+                        "name": "published_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or after this date (YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or before this date (YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "With (true) or without (false) a YouTube or TikTok video",
+                        "name": "has_video",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "With (true) or without (false) a cover image",
+                        "name": "has_cover",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum view count",
+                        "name": "min_views",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated content warnings to exclude (violence, gore, suicide, self_harm, sexual_content, abuse, drugs)",
+                        "name": "exclude_warnings",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by access level (public, members, premium)",
+                        "name": "access_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Soft deleted articles (e.g. by a bulk delete): only (only them) or with (included), left out by default",
+                        "name": "deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination mode: page (default) or cursor",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor or prev_cursor (cursor mode)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return (default: card fields without content and SEO)",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Article"
+                            "$ref": "#/definitions/response.PaginatedResponse"
                         }
                     },
                     "400": {
@@ -77,22 +181,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.Unauthorized"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.Error"
-                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/articles/bulk": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function allows users to delete an article",
+                "description": "Function applies an action to up to 100 articles: publish, archive, delete, restore or assign_categories.\nArticles are changed one by one, the result of each one is in ` + "`" + `results` + "`" + `; failed items are left unchanged.\n` + "`" + `delete` + "`" + ` hides the articles (soft delete, unlike ` + "`" + `DELETE /admin/articles/{id}` + "`" + ` which is permanent) and ` + "`" + `restore` + "`" + ` brings them back.\nDeleted articles are listed with ` + "`" + `GET /admin/articles?deleted=only` + "`" + ` and found in the audit log (` + "`" + `article.bulk_delete` + "`" + `).\n` + "`" + `assign_categories` + "`" + ` adds ` + "`" + `category_ids` + "`" + ` to the categories of each article (max 10 per article).",
                 "consumes": [
                     "application/json"
                 ],
@@ -102,45 +202,50 @@ const docTemplate = `{
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Delete an article",
+                "summary": "Bulk update articles",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Article ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Article IDs and action",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BulkArticles"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Unauthorized"
+                            "$ref": "#/definitions/dto.BulkResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
                     }
                 }
             }
         },
-        "/admin/articles/{id}/status": {
-            "put": {
+        "/admin/articles/import": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function updates an article's status (draft, published, archived)",
+                "description": "Function imports articles from a WordPress export (WXR), CSV or JSON file.\nFiles up to ` + "`" + `IMPORT_SYNC_LIMIT` + "`" + ` rows are imported right away; larger files are queued and return 202 with the import ID.\nCategories in the file are matched by slug and created when missing (` + "`" + `created_categories` + "`" + `); tags are reported in ` + "`" + `ignored_fields` + "`" + ` and not stored.\nImported stories keep their ` + "`" + `published_at` + "`" + ` and don't notify followers nor webhooks.\n` + "`" + `authors` + "`" + ` tells how each author email was resolved; a dry run reports ` + "`" + `user_id` + "`" + ` 0 for the authors it would create.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
@@ -148,30 +253,46 @@ const docTemplate = `{
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Update article status",
+                "summary": "Import articles",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Article ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "Import file",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
                     },
                     {
-                        "description": "Update article status data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdateArticleStatus"
-                        }
+                        "type": "string",
+                        "description": "File format (wxr, csv, json)",
+                        "name": "format",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report without writing",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Author of rows without author email (current user by default)",
+                        "name": "author_id",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Article"
+                            "$ref": "#/definitions/dto.ImportReport"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportReport"
                         }
                     },
                     "400": {
@@ -185,77 +306,39 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.Unauthorized"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.Error"
-                        }
                     }
                 }
             }
         },
-        "/articles": {
+        "/admin/articles/imports/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Returns a paginated list of articles that can be filtered and sorted",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Function returns the progress and per-row error log of an article import",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Articles"
                 ],
-                "summary": "List articles with pagination and filtering",
+                "summary": "Get article import report",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Items per page (default: 10)",
-                        "name": "per_page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search keyword in title, slug, excerpt, and content",
-                        "name": "keyword",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Field to order by (prefix with '-' for descending, e.g. '-created_at')",
-                        "name": "order_by",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Filter by article status (draft, published, archived)",
-                        "name": "status",
-                        "in": "query"
+                        "description": "Import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.PaginatedResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/response.Error"
+                            "$ref": "#/definitions/dto.ImportReport"
                         }
                     },
                     "401": {
@@ -263,16 +346,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.Unauthorized"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/admin/articles/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function allows users to create a new article",
+                "description": "Function allows users to update an existing article",
                 "consumes": [
                     "application/json"
                 ],
@@ -282,21 +373,28 @@ const docTemplate = `{
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Create a new article",
+                "summary": "Update an existing article",
                 "parameters": [
                     {
-                        "description": "CreateArticle payload",
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdateArticle payload",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateArticle"
+                            "$ref": "#/definitions/request.UpdateArticle"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/response.Article"
                         }
@@ -312,18 +410,22 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.Unauthorized"
                         }
-                    }
-                }
-            }
-        },
-        "/articles/slug/{slug}": {
-            "get": {
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function gets article by slug. If article doesn't exist, returns not found status.",
+                "description": "Function allows users to delete an article\nThe article is deleted permanently with its translations and statistics. To hide articles\nand keep them restorable, use the ` + "`" + `delete` + "`" + ` action of ` + "`" + `POST /admin/articles/bulk` + "`" + `.",
                 "consumes": [
                     "application/json"
                 ],
@@ -333,22 +435,19 @@ const docTemplate = `{
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Get article by slug",
+                "summary": "Delete an article",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Article Slug",
-                        "name": "slug",
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.Article"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -365,24 +464,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/articles/{id}": {
+        "/admin/articles/{id}/stats": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function gets article by given id. If article doesn't exist, returns not found status.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Function gets the views over time, top referrers, devices, unique visitors and read-through rate of an article.\nThe read-through rate is null when no reading progress was reported in the period.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Get article by given id",
+                "summary": "Get article analytics",
                 "parameters": [
                     {
                         "type": "integer",
@@ -392,9 +488,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Whether to count this view",
-                        "name": "count_view",
+                        "type": "string",
+                        "description": "First day of the period (YYYY-MM-DD, default: 30 days ago)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the period (YYYY-MM-DD, default: today)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket of the views over time: hour, day (default), week or month",
+                        "name": "granularity",
                         "in": "query"
                     }
                 ],
@@ -402,7 +510,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Article"
+                            "$ref": "#/definitions/response.ArticleStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
                         }
                     },
                     "401": {
@@ -420,14 +534,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/refresh": {
+        "/admin/articles/{id}/status": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Refresh user token",
+                "description": "Function updates an article's status (draft, published, archived)",
                 "consumes": [
                     "application/json"
                 ],
@@ -435,17 +549,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Articles"
                 ],
-                "summary": "refresh user token",
+                "summary": "Update article status",
                 "parameters": [
                     {
-                        "description": "RefreshToken payload",
-                        "name": "data",
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update article status data",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.RefreshToken"
+                            "$ref": "#/definitions/request.UpdateArticleStatus"
                         }
                     }
                 ],
@@ -453,7 +574,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.SignIn"
+                            "$ref": "#/definitions/response.Article"
                         }
                     },
                     "400": {
@@ -467,43 +588,58 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.Unauthorized"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
                     }
                 }
             }
         },
-        "/auth/signin": {
-            "post": {
-                "description": "Authenticating user's credentials then return access and refresh token if valid. Otherwise, return an error message.",
-                "consumes": [
-                    "application/json"
+        "/admin/articles/{id}/translations": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Function lists the translations of an article. The article itself holds the default locale.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Articles"
                 ],
-                "summary": "authenticating user's credentials",
+                "summary": "List article translations",
                 "parameters": [
                     {
-                        "description": "Signin payload",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.SignIn"
-                        }
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.SignIn"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.ArticleTranslation"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -511,14 +647,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/signout": {
-            "delete": {
+        "/admin/articles/{id}/translations/{locale}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "De-authorize user and delete refresh token from Redis.",
+                "description": "Function creates or replaces the translation of an article in a locale of SUPPORTED_LOCALES (except the default locale).",
                 "consumes": [
                     "application/json"
                 ],
@@ -526,12 +662,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Articles"
+                ],
+                "summary": "Save article translation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale (e.g. en)",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SaveArticleTranslation payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SaveArticleTranslation"
+                        }
+                    }
                 ],
-                "summary": "de-authorize user and delete refresh token from Redis",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ArticleTranslation"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -544,43 +708,57 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.Unauthorized"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
                     }
                 }
-            }
-        },
-        "/auth/signup": {
-            "post": {
-                "description": "Create a new user with ` + "`" + `request.SignUp` + "`" + ` body then add ` + "`" + `role id` + "`" + ` to table ` + "`" + `user_roles` + "`" + ` with current ` + "`" + `user id` + "`" + `",
-                "consumes": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Function deletes the translation of an article in a locale",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Articles"
                 ],
-                "summary": "Sign up a new user",
+                "summary": "Delete article translation",
                 "parameters": [
                     {
-                        "description": "Signup payload",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.SignUp"
-                        }
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale (e.g. en)",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/response.User"
+                            "$ref": "#/definitions/response.Unauthorized"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -588,32 +766,58 @@ const docTemplate = `{
                 }
             }
         },
-        "/info": {
+        "/admin/articles/{id}/variants": {
             "get": {
-                "description": "Get API server information",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Function gets the impressions, clicks, click-through rate, lift and confidence of each variant of an article.\nThe leader is ` + "`" + `significant` + "`" + ` when it differs from the control with ` + "`" + `required_confidence` + "`" + `; declare it the winner then.\nCounts are written every minute (VIEW_FLUSH_SCHEDULE).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Misc"
+                    "Articles"
+                ],
+                "summary": "Get article A/B test results",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get API info",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ServerInfo"
+                            "$ref": "#/definitions/response.ArticleExperiment"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
                         }
                     }
                 }
-            }
-        },
-        "/password/forgot": {
-            "post": {
-                "description": "Forgot password.",
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function starts the A/B test of an article with 1 to 3 alternative titles and/or cover images, replacing the running test.\nVariant ` + "`" + `a` + "`" + ` is the control (the current title and cover image), the alternatives get the keys ` + "`" + `b` + "`" + ` to ` + "`" + `d` + "`" + `.\nReaders are bucketed by user ID or by the ` + "`" + `ab_visitor` + "`" + ` cookie.",
                 "consumes": [
                     "application/json"
                 ],
@@ -621,63 +825,142 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Password"
+                    "Articles"
                 ],
-                "summary": "Forgot password",
+                "summary": "Start article A/B test",
                 "parameters": [
                     {
-                        "description": "Forgot password payload",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SaveArticleVariants payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ForgotPassword"
+                            "$ref": "#/definitions/request.SaveArticleVariants"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ArticleExperiment"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function stops the A/B test of an article, the article keeps its title and cover image",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Stop article A/B test",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
                     }
                 }
             }
         },
-        "/password/reset": {
+        "/admin/articles/{id}/variants/{key}/winner": {
             "post": {
-                "description": "Reset password.",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Function writes the title and cover image of the winning variant to the article and ends its A/B test.\nDeclaring the control ` + "`" + `a` + "`" + ` keeps the article as is.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Password"
+                    "Articles"
                 ],
-                "summary": "Reset password",
+                "summary": "Declare article A/B test winner",
                 "parameters": [
                     {
-                        "description": "Reset password payload",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.ResetPassword"
-                        }
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Variant key (a to d)",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Article"
+                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -685,14 +968,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/admin/audit-logs": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function list all users data\n\u003cb\u003eKeyword fields:\u003c/b\u003e roles.name, roles.slug, users.email, users.fullname, users.phone, user.status\n\u003cb\u003eOrder_by fields:\u003c/b\u003e users.email, users.fullname, users.phone, users.status, users.last_access_at",
+                "description": "Function lists the mutations made by administrators, latest first, with the fields they changed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -700,20 +983,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Audit"
                 ],
-                "summary": "Function list all users data",
+                "summary": "List audit logs",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Filter by administrator ID",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Keyword",
-                        "name": "keyword",
+                        "description": "Filter by action (e.g. user.status, article.update)",
+                        "name": "action",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Order By",
-                        "name": "order_by",
+                        "description": "Filter by target type (e.g. user, article, category, webhook)",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by target ID",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Recorded on or after this date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Recorded on or before this date (YYYY-MM-DD)",
+                        "name": "to",
                         "in": "query"
                     },
                     {
@@ -733,7 +1040,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ListUser"
+                            "$ref": "#/definitions/response.ListAuditLog"
                         }
                     },
                     "400": {
@@ -749,14 +1056,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/categories": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function allows Administrator create a new user with specific roles",
+                "description": "Function creates a story category. Articles get their categories with ` + "`" + `category_ids` + "`" + `.",
                 "consumes": [
                     "application/json"
                 ],
@@ -764,17 +1073,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Categories"
                 ],
-                "summary": "Create a new user for Administrator",
+                "summary": "Create a category",
                 "parameters": [
                     {
-                        "description": "CreateUser payload",
+                        "description": "CreateCategory payload",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CreateUser"
+                            "$ref": "#/definitions/request.CreateCategory"
                         }
                     }
                 ],
@@ -782,7 +1091,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/response.User"
+                            "$ref": "#/definitions/response.Category"
                         }
                     },
                     "400": {
@@ -800,14 +1109,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/profile": {
-            "get": {
+        "/admin/categories/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get user profile",
+                "description": "Function deletes a story category. Its articles are kept, its follows are removed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -815,18 +1124,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Categories"
+                ],
+                "summary": "Delete a category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get user profile",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/response.User"
+                            "$ref": "#/definitions/response.Unauthorized"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -834,14 +1155,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
-            "get": {
+        "/admin/exports": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function get user by given id. If user not exists, return not found status.",
+                "description": "Function queues an export of all articles and their authors (JSON Lines or zip bundle with media).\nA download link is emailed to the current user when the export is ready.",
                 "consumes": [
                     "application/json"
                 ],
@@ -849,46 +1170,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Backups"
                 ],
-                "summary": "Get user by given id",
+                "summary": "Export content",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "CreateExport payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateExport"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/response.User"
+                            "$ref": "#/definitions/response.ExportQueued"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/response.Unauthorized"
+                            "$ref": "#/definitions/response.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/response.Error"
+                            "$ref": "#/definitions/response.Unauthorized"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/users/{id}/entitlement": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function allows Administrator update users table or authorize user roles.",
+                "description": "Function grants a members or premium plan to a user, replacing the current plan.",
                 "consumes": [
                     "application/json"
                 ],
@@ -898,7 +1223,7 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "Function allows Administrator update an existing user",
+                "summary": "Grant a plan to a user",
                 "parameters": [
                     {
                         "type": "integer",
@@ -908,12 +1233,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "UpdateUser payload",
+                        "description": "GrantEntitlement payload",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateUser"
+                            "$ref": "#/definitions/request.GrantEntitlement"
                         }
                     }
                 ],
@@ -921,7 +1246,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.User"
+                            "$ref": "#/definitions/response.Entitlement"
                         }
                     },
                     "400": {
@@ -935,6 +1260,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.Unauthorized"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
                     }
                 }
             },
@@ -944,7 +1275,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Function hard-delete user with its roles by given userID.",
+                "description": "Function revokes the plan of a user, members-only and premium stories are locked again.",
                 "consumes": [
                     "application/json"
                 ],
@@ -954,7 +1285,7 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "Delete user by given userID",
+                "summary": "Revoke the plan of a user",
                 "parameters": [
                     {
                         "type": "integer",
@@ -965,11 +1296,8 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.User"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -986,14 +1314,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}/status": {
-            "put": {
+        "/admin/webhooks": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update user's status by ID. \u003cb\u003eAdministrator privilege required\u003c/b\u003e",
+                "description": "Function lists the webhook subscriptions, latest first.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1001,37 +1329,293 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
-                ],
-                "summary": "Update user's status by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update user status data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UpdateUserStatus"
-                        }
-                    }
+                    "Webhooks"
                 ],
+                "summary": "List webhooks",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.Webhook"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function subscribes a URL to content lifecycle events. Events are posted as JSON\nwith an ` + "`" + `X-Webhook-Signature: t=\u003cunix time\u003e,v1=\u003chex HMAC-SHA256 of \"\u003cunix time\u003e.\u003cbody\u003e\"\u003e` + "`" + ` header\ncomputed with the secret of the webhook (generated when missing). The secret is only returned here,\nset a new one with an update if it's lost.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create a webhook",
+                "parameters": [
+                    {
+                        "description": "CreateWebhook payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function gets a webhook subscription. Its signing secret is only returned on creation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Webhook"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function updates the URL, secret, events, description or state of a webhook. Missing fields are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdateWebhook payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function deletes a webhook subscription and its delivery log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function lists the deliveries of a webhook, latest first, with the response code of their last attempt.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, delivered, retrying, failed)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by event (article.published, article.updated, article.deleted, user.created)",
+                        "name": "event",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items Per Page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ListWebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
                     },
@@ -1040,510 +1624,3888 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.Unauthorized"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}/deliveries/{delivery_id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
                     }
+                ],
+                "description": "Function queues the payload of a past delivery again. The attempt is logged as a new delivery.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/response.WebhookDelivery"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/articles": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns a paginated list of published articles that can be filtered and sorted\nArticles in an A/B test return the title and cover image of the reader's variant (` + "`" + `variant` + "`" + `),\nsend it as ` + "`" + `?variant=` + "`" + ` when opening the article to count the click.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "List articles with pagination and filtering",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 10)",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword in title, slug, excerpt, and content",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field to order by (prefix with '-' for descending, e.g. '-created_at', or 'trending')",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by author ID",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Published on or after this date (YYYY-MM-DD)",
+                        "name": "published_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Published on or before this date (YYYY-MM-DD)",
+                        "name": "published_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or after this date (YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or before this date (YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "With (true) or without (false) a YouTube or TikTok video",
+                        "name": "has_video",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "With (true) or without (false) a cover image",
+                        "name": "has_cover",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum view count",
+                        "name": "min_views",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated content warnings to exclude (violence, gore, suicide, self_harm, sexual_content, abuse, drugs)",
+                        "name": "exclude_warnings",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by access level (public, members, premium)",
+                        "name": "access_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination mode: page (default) or cursor",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor or prev_cursor (cursor mode)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale of translated articles (default: Accept-Language, then the default locale)",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return (default: card fields without content and SEO)",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PaginatedResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function allows users to create a new article",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Create a new article",
+                "parameters": [
+                    {
+                        "description": "CreateArticle payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateArticle"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.Article"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        },
+        "/articles/age-confirmation": {
+            "post": {
+                "description": "Function signs an age confirmation token to send in the ` + "`" + `X-Age-Token` + "`" + ` header of the article detail API.\nThe birthdate of a signed-in user who has none yet is saved to the profile.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Confirm the age of a reader",
+                "parameters": [
+                    {
+                        "description": "ConfirmAge payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ConfirmAge"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.AgeToken"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/articles/slug/{slug}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function gets a published article by slug. Drafts return not found, archived and deleted articles return gone.\nThe content and videos of an age-rated article are withheld (` + "`" + `age_gated: true` + "`" + `) unless the reader\nis signed in with a confirmed birthdate or sends an age confirmation token old enough for the rating.\nMembers-only and premium articles return a teaser (` + "`" + `locked: true` + "`" + `) unless the reader is signed in with the plan.\nArticles in an A/B test return the title and cover image of the reader's variant (` + "`" + `variant` + "`" + `).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Get article by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Article slug (base or translated slug)",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale (default: Accept-Language, then the default locale)",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred locales",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Age confirmation token (POST /articles/age-confirmation)",
+                        "name": "X-Age-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Age confirmation token, for links where the header can't be set",
+                        "name": "age_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "A/B test variant of the list item the reader clicked (counts the click)",
+                        "name": "variant",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Article"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/articles/trending": {
+            "get": {
+                "description": "Function lists the trending articles ranked by recent views with time decay.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "List trending articles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of articles (1..50, default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale of translated articles (default: Accept-Language, then the default locale)",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return (default: card fields without content and SEO)",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.Article"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/articles/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function gets article by given id. If article doesn't exist, returns not found status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Get article by given id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether to count this view",
+                        "name": "count_view",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Article"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/articles/{slug}/progress": {
+            "post": {
+                "description": "Function collects how far a reader scrolled an article. Reports are accepted once per visitor and 10% step,\nthe event is saved by the queue worker. Progress of age-gated stories and teasers isn't collected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Report the reading progress of an article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Article slug (base or translated slug)",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ReadingProgress payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ReadingProgress"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Refresh user token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "refresh user token",
+                "parameters": [
+                    {
+                        "description": "RefreshToken payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RefreshToken"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SignIn"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/signin": {
+            "post": {
+                "description": "Authenticating user's credentials then return access and refresh token if valid. Otherwise, return an error message.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "authenticating user's credentials",
+                "parameters": [
+                    {
+                        "description": "Signin payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SignIn"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SignIn"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/signout": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "De-authorize user and delete refresh token from Redis.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "de-authorize user and delete refresh token from Redis",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/signup": {
+            "post": {
+                "description": "Create a new user with ` + "`" + `request.SignUp` + "`" + ` body then add ` + "`" + `role id` + "`" + ` to table ` + "`" + `user_roles` + "`" + ` with current ` + "`" + `user id` + "`" + `",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Sign up a new user",
+                "parameters": [
+                    {
+                        "description": "Signup payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SignUp"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "Function lists the story categories ordered by name. Members can follow them (` + "`" + `/me/follows` + "`" + `).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "List categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.Category"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/exports/{name}": {
+            "get": {
+                "description": "Function downloads a content export from the signed link emailed when the export is ready.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Backups"
+                ],
+                "summary": "Download content export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export file name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Link expiry (unix seconds)",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link signature",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/articles.atom": {
+            "get": {
+                "description": "Function renders the latest published articles as an RSS 2.0 or Atom 1.0 feed.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Feed of published articles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RSS"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/articles.rss": {
+            "get": {
+                "description": "Function renders the latest published articles as an RSS 2.0 or Atom 1.0 feed.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Feed of published articles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RSS"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/authors/{id}/articles.atom": {
+            "get": {
+                "description": "Function renders the latest published articles as an RSS 2.0 or Atom 1.0 feed.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Feed of published articles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Author ID",
+                        "name": "id",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RSS"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/authors/{id}/articles.rss": {
+            "get": {
+                "description": "Function renders the latest published articles as an RSS 2.0 or Atom 1.0 feed.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Feed of published articles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Author ID",
+                        "name": "id",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RSS"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/categories/{slug}/articles.atom": {
+            "get": {
+                "description": "Function renders the latest published articles as an RSS 2.0 or Atom 1.0 feed.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Feed of published articles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category slug",
+                        "name": "slug",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RSS"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/categories/{slug}/articles.rss": {
+            "get": {
+                "description": "Function renders the latest published articles as an RSS 2.0 or Atom 1.0 feed.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Feed of published articles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category slug",
+                        "name": "slug",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RSS"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "post": {
+                "description": "Read-only GraphQL API of the published stories, their authors and categories.\nArticle lists accept the filters of ` + "`" + `/articles` + "`" + ` and return card fields, the full content is served by ` + "`" + `/articles/{slug}` + "`" + `.\nQueries deeper than GRAPHQL_MAX_DEPTH or more complex than GRAPHQL_MAX_COMPLEXITY (fields, multiplied by the page size under lists) are rejected.\nA GraphiQL page is served by GET on the same path outside production.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GraphQL"
+                ],
+                "summary": "Run a GraphQL query",
+                "parameters": [
+                    {
+                        "description": "GraphQL query",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GraphQLQuery"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale of translated articles (default: Accept-Language, then the default locale)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data and errors of the query",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "errors of a rejected query",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/info": {
+            "get": {
+                "description": "Get API server information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Misc"
+                ],
+                "summary": "Get API info",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ServerInfo"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/follows": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function lists the categories and authors followed by the current user, latest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Follows"
+                ],
+                "summary": "List follows",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.Follow"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function makes the current user follow a category or an author.\nFollowers are notified of new stories by email and/or in the app (` + "`" + `/me/notification-preferences` + "`" + `).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Follows"
+                ],
+                "summary": "Follow a category or an author",
+                "parameters": [
+                    {
+                        "description": "Follow payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.Follow"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.Follow"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/follows/{type}/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function stops following a category or an author.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Follows"
+                ],
+                "summary": "Unfollow a category or an author",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Followed target (category or author)",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Category or author ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notification-preferences": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function gets how the current user is notified of new stories of followed categories and authors.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get notification preferences",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.NotificationPreferences"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function chooses how the current user is notified of new stories of followed categories and authors:\nby email and/or in the app. Missing channels are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Update notification preferences",
+                "parameters": [
+                    {
+                        "description": "NotificationPreferences payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.NotificationPreferences"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.NotificationPreferences"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function lists the in-app notifications of the current user, latest first\n(new stories of followed categories and authors).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "List notifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items Per Page",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ListNotification"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications/read": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function marks all in-app notifications of the current user as read.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark notifications as read",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        },
+        "/newsletter/subscriptions": {
+            "post": {
+                "description": "Function subscribes an email address to the \"Top ghost stories\" weekly digest, with or without an account.\nA confirmation link is emailed first (double opt-in). The response doesn't tell whether the address was already subscribed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Newsletter"
+                ],
+                "summary": "Subscribe to the weekly digest",
+                "parameters": [
+                    {
+                        "description": "Subscribe payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.Subscribe"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/forgot": {
+            "post": {
+                "description": "Forgot password.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Password"
+                ],
+                "summary": "Forgot password",
+                "parameters": [
+                    {
+                        "description": "Forgot password payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ForgotPassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/password/reset": {
+            "post": {
+                "description": "Reset password.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Password"
+                ],
+                "summary": "Reset password",
+                "parameters": [
+                    {
+                        "description": "Reset password payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ResetPassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemap.xml": {
+            "get": {
+                "description": "Function serves a sitemap file generated by ` + "`" + `sitemap:generate` + "`" + `.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Sitemaps"
+                ],
+                "summary": "Get sitemap",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemaps/{name}": {
+            "get": {
+                "description": "Function serves a sitemap file generated by ` + "`" + `sitemap:generate` + "`" + `.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Sitemaps"
+                ],
+                "summary": "Get sitemap",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sitemap file name (e.g. articles-1.xml)",
+                        "name": "name",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function list all users data\n\u003cb\u003eKeyword fields:\u003c/b\u003e roles.name, roles.slug, users.email, users.fullname, users.phone, user.status\n\u003cb\u003eOrder_by fields:\u003c/b\u003e users.email, users.fullname, users.phone, users.status, users.last_access_at",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Function list all users data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keyword",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order By",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items Per Page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination mode: page (default) or cursor",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor or prev_cursor (cursor mode)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return (default: id, email, fullname, phone, status, avatar, roles, created_at, last_access_at)",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ListUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function allows Administrator create a new user with specific roles",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create a new user for Administrator",
+                "parameters": [
+                    {
+                        "description": "CreateUser payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CreateUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Apply an action to up to 100 users: activate, block, delete or set_roles. \u003cb\u003eAdministrator privilege required\u003c/b\u003e\nUsers are changed one by one, the result of each one is in ` + "`" + `results` + "`" + `. Your own account is never changed (failed item).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Bulk update users",
+                "parameters": [
+                    {
+                        "description": "User IDs and action",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BulkUsers"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/profile": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get user profile",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function get user by given id. If user not exists, return not found status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user by given id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.User"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function allows Administrator update users table or authorize user roles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Function allows Administrator update an existing user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdateUser payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Function hard-delete user with its roles by given userID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete user by given userID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.User"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update user's status by ID. \u003cb\u003eAdministrator privilege required\u003c/b\u003e",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update user's status by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update user status data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateUserStatus"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Unauthorized"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "core.Data": {
+            "type": "object",
+            "additionalProperties": {}
+        },
+        "dto.ArticleVariantPayload": {
+            "type": "object",
+            "properties": {
+                "cover_image": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://example.com/images/cover-b.jpg"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "The House That Breathes at Night"
+                }
+            }
+        },
+        "dto.BackupFormat": {
+            "type": "string",
+            "enum": [
+                "jsonl",
+                "zip"
+            ],
+            "x-enum-comments": {
+                "BackupFormatJSONL": "JSON Lines file",
+                "BackupFormatZip": "Zip bundle with the JSON Lines file and the referenced media"
+            },
+            "x-enum-varnames": [
+                "BackupFormatJSONL",
+                "BackupFormatZip"
+            ]
+        },
+        "dto.BulkItemResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Article not found"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "dto.BulkResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "publish"
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BulkItemResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.GraphQLQuery": {
+            "description": "Request payload for running a GraphQL query on the story catalogue.",
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "operationName": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": ""
+                },
+                "query": {
+                    "type": "string",
+                    "maxLength": 20000,
+                    "example": "{ articles(perPage: 5) { items { title slug author { fullname } } } }"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "dto.ImportAuthor": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "email": {
+                    "type": "string",
+                    "example": "writer@example.com"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.ImportReport": {
+            "type": "object",
+            "properties": {
+                "authors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImportAuthor"
+                    }
+                },
+                "created": {
+                    "type": "integer",
+                    "example": 3990
+                },
+                "created_authors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "writer@example.com"
+                    ]
+                },
+                "created_categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Urban legend"
+                    ]
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImportRowError"
+                    }
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "id": {
+                    "type": "string",
+                    "example": "1718000000000000000"
+                },
+                "ignored_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "categories",
+                        "tags"
+                    ]
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Invalid file"
+                },
+                "processed": {
+                    "type": "integer",
+                    "example": 4000
+                },
+                "renamed_slugs": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ImportStatus"
+                        }
+                    ],
+                    "example": "completed"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 4000
+                }
+            }
+        },
+        "dto.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "title: required"
+                },
+                "row": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "ghost-story"
+                }
+            }
+        },
+        "dto.ImportStatus": {
+            "type": "string",
+            "enum": [
+                "queued",
+                "running",
+                "completed",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "ImportStatusQueued",
+                "ImportStatusRunning",
+                "ImportStatusCompleted",
+                "ImportStatusFailed"
+            ]
+        },
+        "dto.Meta": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJvIjoiaWQiLCJpIjoxMH0"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "per_page": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "prev_cursor": {
+                    "type": "string",
+                    "example": "eyJvIjoiaWQiLCJpIjoxLCJiIjp0cnVlfQ"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 1354
+                }
+            }
+        },
+        "request.BulkArticles": {
+            "type": "object",
+            "required": [
+                "action",
+                "ids"
+            ],
+            "properties": {
+                "action": {
+                    "enum": [
+                        "publish",
+                        "archive",
+                        "delete",
+                        "restore",
+                        "assign_categories"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.BulkArticleAction"
+                        }
+                    ],
+                    "example": "publish"
+                },
+                "category_ids": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        2,
+                        5
+                    ]
+                },
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        12,
+                        13,
+                        14
+                    ]
+                }
+            }
+        },
+        "request.BulkUsers": {
+            "type": "object",
+            "required": [
+                "action",
+                "ids"
+            ],
+            "properties": {
+                "action": {
+                    "enum": [
+                        "activate",
+                        "block",
+                        "delete",
+                        "set_roles"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.BulkUserAction"
+                        }
+                    ],
+                    "example": "block"
+                },
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        21,
+                        22
+                    ]
+                },
+                "roles": {
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/types.Role"
+                    },
+                    "example": [
+                        "member"
+                    ]
+                }
+            }
+        },
+        "request.ConfirmAge": {
+            "type": "object",
+            "required": [
+                "birthdate"
+            ],
+            "properties": {
+                "birthdate": {
+                    "type": "string",
+                    "example": "2000-01-31"
+                }
+            }
+        },
+        "request.CreateArticle": {
+            "type": "object",
+            "required": [
+                "author_id",
+                "content",
+                "slug",
+                "title"
+            ],
+            "properties": {
+                "access_level": {
+                    "enum": [
+                        "public",
+                        "members",
+                        "premium"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.AccessLevel"
+                        }
+                    ],
+                    "example": "premium"
+                },
+                "age_rating": {
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        13,
+                        16,
+                        18
+                    ],
+                    "example": 16
+                },
+                "author_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category_ids": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2
+                    ]
+                },
+                "content": {
+                    "type": "string",
+                    "example": "\u003cp\u003eThis is the full content of the article...\u003c/p\u003e"
+                },
+                "content_warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.ContentWarning"
+                    },
+                    "example": [
+                        "violence",
+                        "gore"
+                    ]
+                },
+                "cover_image": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://example.com/images/cover.jpg"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "Learn how to build a web application using Go"
+                },
+                "seo_description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "Comprehensive guide to building Go web applications"
+                },
+                "seo_keywords": {
+                    "type": "string",
+                    "example": "golang,web development,tutorial"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "how-to-build-go-web-application"
+                },
+                "status": {
+                    "enum": [
+                        "draft",
+                        "published",
+                        "archived"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ArticleStatus"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "tiktok_url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://www.tiktok.com/@user/video/6718335390845095173"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "How to Build a Go Web Application"
+                },
+                "youtube_url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://youtube.com/watch?v=dQw4w9WgXcQ"
+                }
+            }
+        },
+        "request.CreateCategory": {
+            "type": "object",
+            "required": [
+                "name",
+                "slug"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Truyện ma có thật"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 120,
+                    "example": "truyen-ma-co-that"
+                }
+            }
+        },
+        "request.CreateExport": {
+            "type": "object",
+            "required": [
+                "format"
+            ],
+            "properties": {
+                "format": {
+                    "enum": [
+                        "jsonl",
+                        "zip"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.BackupFormat"
+                        }
+                    ],
+                    "example": "zip"
+                }
+            }
+        },
+        "request.CreateUser": {
+            "type": "object",
+            "required": [
+                "email",
+                "fullname",
+                "password",
+                "phone"
+            ],
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://i.pravatar.cc/32"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "john@jivecode.com"
+                },
+                "fullname": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "John Doe"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "M1PassW@s"
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "0989831911"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.Role"
+                    },
+                    "example": [
+                        "admin",
+                        "user"
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                }
+            }
+        },
+        "request.CreateWebhook": {
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Discord bot"
+                },
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/types.WebhookEvent"
+                    },
+                    "example": [
+                        "article.published",
+                        "article.deleted"
+                    ]
+                },
+                "secret": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 16,
+                    "example": "whsec_9f8e7d6c5b4a39281706f5e4d3c2b1a0"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://bot.example.com/hooks/stories"
+                }
+            }
+        },
+        "request.Follow": {
+            "type": "object",
+            "required": [
+                "id",
+                "type"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "type": {
+                    "enum": [
+                        "category",
+                        "author"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.FollowType"
+                        }
+                    ],
+                    "example": "category"
+                }
+            }
+        },
+        "request.ForgotPassword": {
+            "type": "object",
+            "required": [
+                "username"
+            ],
+            "properties": {
+                "username": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "john@jivecode.com"
+                }
+            }
+        },
+        "request.GrantEntitlement": {
+            "type": "object",
+            "required": [
+                "plan"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2026-12-31T23:59:59Z"
+                },
+                "plan": {
+                    "enum": [
+                        "members",
+                        "premium"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.Plan"
+                        }
+                    ],
+                    "example": "premium"
+                }
+            }
+        },
+        "request.NotificationPreferences": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "in_app": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "request.ReadingProgress": {
+            "type": "object",
+            "required": [
+                "progress"
+            ],
+            "properties": {
+                "progress": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 50
+                }
+            }
+        },
+        "request.RefreshToken": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "d1a4216a226cbf75eaefc9107c2c64b6b2c0f18cd8634e3a6f495146c38e1324.1747914602"
+                }
+            }
+        },
+        "request.ResetPassword": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "M1PassW@s"
+                },
+                "token": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "293r823or832eioj2eo9282o423"
+                }
+            }
+        },
+        "request.SaveArticleTranslation": {
+            "type": "object",
+            "required": [
+                "content",
+                "slug",
+                "title"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "\u003cp\u003eStory\u003c/p\u003e"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "A short summary"
+                },
+                "seo_description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "A ghost story"
+                },
+                "seo_keywords": {
+                    "type": "string",
+                    "example": "ghost,horror"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "the-ghost-of-the-old-house"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "The ghost of the old house"
+                }
+            }
+        },
+        "request.SaveArticleVariants": {
+            "type": "object",
+            "required": [
+                "variants"
+            ],
+            "properties": {
+                "variants": {
+                    "type": "array",
+                    "maxItems": 3,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.ArticleVariantPayload"
+                    }
+                }
+            }
+        },
+        "request.SignIn": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "P@seWor9"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "admin@gfly.dev"
+                }
+            }
+        },
+        "request.SignUp": {
+            "type": "object",
+            "required": [
+                "email",
+                "fullname",
+                "password",
+                "phone"
+            ],
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://i.pravatar.cc/32"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "john@jivecode.com"
+                },
+                "fullname": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "John Doe"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "M1PassW@s"
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "0989831911"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                }
+            }
+        },
+        "request.Subscribe": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "reader@example.com"
+                }
+            }
+        },
+        "request.UpdateArticle": {
+            "type": "object",
+            "properties": {
+                "access_level": {
+                    "enum": [
+                        "public",
+                        "members",
+                        "premium"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.AccessLevel"
+                        }
+                    ],
+                    "example": "members"
+                },
+                "age_rating": {
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        13,
+                        16,
+                        18
+                    ],
+                    "example": 18
+                },
+                "category_ids": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1
+                    ]
+                },
+                "content": {
+                    "type": "string",
+                    "example": "\u003cp\u003eUpdated content of the article...\u003c/p\u003e"
+                },
+                "content_warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.ContentWarning"
+                    },
+                    "example": [
+                        "violence"
+                    ]
+                },
+                "cover_image": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://example.com/images/updated-cover.jpg"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "Updated summary of building a Go web application"
+                },
+                "seo_description": {
+                    "type": "string",
+                    "maxLength": 300,
+                    "example": "Updated guide to building Go web applications"
+                },
+                "seo_keywords": {
+                    "type": "string",
+                    "example": "updated,golang,web development"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "updated-how-to-build-go-web-application"
+                },
+                "status": {
+                    "enum": [
+                        "draft",
+                        "published",
+                        "archived"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ArticleStatus"
+                        }
+                    ],
+                    "example": "published"
+                },
+                "tiktok_url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://vm.tiktok.com/ZMeAbCdEf/"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Updated: How to Build a Go Web Application"
+                },
+                "youtube_url": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://youtu.be/dQw4w9WgXcQ?t=90"
+                }
+            }
+        },
+        "request.UpdateArticleStatus": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "enum": [
+                        "draft",
+                        "published",
+                        "archived"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ArticleStatus"
+                        }
+                    ],
+                    "example": "published"
+                }
+            }
+        },
+        "request.UpdateUser": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://i.pravatar.cc/32"
+                },
+                "birthdate": {
+                    "type": "string",
+                    "example": "2000-01-31"
+                },
+                "fullname": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "John Doe"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "M1PassW@s"
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "0989831911"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.Role"
+                    },
+                    "example": [
+                        "admin",
+                        "user"
+                    ]
+                }
+            }
+        },
+        "request.UpdateUserStatus": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "enum": [
+                        "active",
+                        "pending",
+                        "blocked"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.UserStatus"
+                        }
+                    ],
+                    "example": "active"
+                }
+            }
+        },
+        "request.UpdateWebhook": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Mobile app backend"
+                },
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/types.WebhookEvent"
+                    },
+                    "example": [
+                        "article.published"
+                    ]
+                },
+                "secret": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 16,
+                    "example": "whsec_0a1b2c3d4e5f60718293a4b5c6d7e8f9"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://bot.example.com/hooks/v2/stories"
+                }
+            }
+        },
+        "response.AgeToken": {
+            "type": "object",
+            "properties": {
+                "age_rating": {
+                    "description": "Highest age rating the reader may open",
+                    "type": "integer",
+                    "example": 18
+                },
+                "expires_at": {
+                    "description": "Expiry of the token",
+                    "type": "string"
+                },
+                "token": {
+                    "description": "Sent back in the ` + "`" + `X-Age-Token` + "`" + ` header",
+                    "type": "string",
+                    "example": "18.1767225600.5d41402abc4b2a76b9719d911017c592"
+                }
+            }
+        },
+        "response.Alternate": {
+            "type": "object",
+            "properties": {
+                "hreflang": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "the-ghost-of-the-old-house"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/truyen/the-ghost-of-the-old-house"
+                }
+            }
+        },
+        "response.Article": {
+            "type": "object",
+            "properties": {
+                "access_level": {
+                    "type": "string"
+                },
+                "age_gated": {
+                    "description": "Content and videos are withheld until the reader confirms their age",
+                    "type": "boolean"
+                },
+                "age_rating": {
+                    "type": "integer"
+                },
+                "alternates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Alternate"
+                    }
+                },
+                "author_id": {
+                    "type": "integer"
+                },
+                "category_ids": {
+                    "description": "Story categories (admin API)",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "content": {
+                    "type": "string"
+                },
+                "content_warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cover_image": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "excerpt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "locked": {
+                    "description": "Content is a teaser, the full story needs a members or premium plan",
+                    "type": "boolean"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "seo_description": {
+                    "type": "string"
+                },
+                "seo_keywords": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tiktok_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "variant": {
+                    "description": "A/B test variant of the title and cover image served to the reader",
+                    "type": "string"
+                },
+                "videos": {
+                    "$ref": "#/definitions/response.Videos"
+                },
+                "view_count": {
+                    "type": "integer"
+                },
+                "youtube_url": {
+                    "type": "string"
                 }
             }
-        }
-    },
-    "definitions": {
-        "core.Data": {
+        },
+        "response.ArticleExperiment": {
             "type": "object",
-            "additionalProperties": {}
+            "properties": {
+                "article_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "leader": {
+                    "description": "Variant with the best click-through rate",
+                    "type": "string",
+                    "example": "b"
+                },
+                "required_confidence": {
+                    "description": "Confidence needed to declare a winner",
+                    "type": "number",
+                    "example": 0.95
+                },
+                "significant": {
+                    "description": "The leader can be declared the winner",
+                    "type": "boolean"
+                },
+                "variants": {
+                    "description": "Control first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ArticleVariant"
+                    }
+                }
+            }
         },
-        "dto.Meta": {
+        "response.ArticleStats": {
             "type": "object",
             "properties": {
-                "page": {
+                "article_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "per_page": {
+                "authenticated_views": {
                     "type": "integer",
-                    "example": 10
+                    "example": 310
                 },
-                "total": {
+                "devices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.StatsCount"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2024-01-01"
+                },
+                "granularity": {
+                    "type": "string",
+                    "example": "day"
+                },
+                "read_through_rate": {
+                    "description": "Null without progress data",
+                    "type": "number",
+                    "example": 0.42
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.StatsPoint"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-01-31"
+                },
+                "top_referrers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.StatsCount"
+                    }
+                },
+                "unique_visitors": {
                     "type": "integer",
-                    "example": 1354
+                    "example": 980
+                },
+                "views": {
+                    "type": "integer",
+                    "example": 1250
                 }
             }
         },
-        "request.CreateArticle": {
+        "response.ArticleTranslation": {
             "type": "object",
-            "required": [
-                "author_id",
-                "content",
-                "slug",
-                "title"
-            ],
             "properties": {
-                "author_id": {
+                "article_id": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "excerpt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "seo_description": {
+                    "type": "string"
+                },
+                "seo_keywords": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ArticleVariant": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer",
+                    "example": 324
+                },
+                "confidence": {
+                    "description": "Confidence that the CTR differs from the control",
+                    "type": "number",
+                    "example": 0.97
+                },
+                "control": {
+                    "description": "The article as is",
+                    "type": "boolean"
+                },
+                "cover_image": {
+                    "type": "string",
+                    "example": "https://example.com/images/cover-b.jpg"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "ctr": {
+                    "type": "number",
+                    "example": 0.06
+                },
+                "impressions": {
+                    "type": "integer",
+                    "example": 5400
+                },
+                "key": {
+                    "type": "string",
+                    "example": "b"
+                },
+                "lift": {
+                    "description": "Relative CTR change against the control",
+                    "type": "number",
+                    "example": 0.2
+                },
+                "title": {
+                    "type": "string",
+                    "example": "The House That Breathes at Night"
+                }
+            }
+        },
+        "response.AuditActor": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "admin@example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "response.AuditLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "user.status"
+                },
+                "actor": {
+                    "$ref": "#/definitions/response.AuditActor"
+                },
+                "changes": {
+                    "description": "{\"\u003cfield\u003e\": {\"before\": ..., \"after\": ...}}, null when nothing changed",
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "content": {
+                "ip": {
+                    "description": "Client IP, forwarded headers are only honored from TRUSTED_PROXIES",
                     "type": "string",
-                    "example": "\u003cp\u003eThis is the full content of the article...\u003c/p\u003e"
+                    "example": "203.0.113.7"
                 },
-                "cover_image": {
+                "method": {
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "https://example.com/images/cover.jpg"
+                    "example": "PUT"
                 },
-                "excerpt": {
+                "path": {
                     "type": "string",
-                    "example": "Learn how to build a web application using Go"
+                    "example": "/api/v1/admin/users/12/status"
                 },
-                "seo_description": {
+                "status_code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "target_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "target_type": {
                     "type": "string",
-                    "maxLength": 300,
-                    "example": "Comprehensive guide to building Go web applications"
+                    "example": "user"
                 },
-                "seo_keywords": {
+                "user_agent": {
                     "type": "string",
-                    "example": "golang,web development,tutorial"
+                    "example": "Mozilla/5.0"
+                }
+            }
+        },
+        "response.CDATA": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.Category": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Truyện ma có thật"
                 },
                 "slug": {
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "how-to-build-go-web-application"
+                    "example": "truyen-ma-co-that"
+                }
+            }
+        },
+        "response.Entitlement": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "status": {
-                    "enum": [
-                        "draft",
-                        "published",
-                        "archived"
-                    ],
+                "expires_at": {
+                    "type": "string",
+                    "example": "2026-12-31T23:59:59Z"
+                },
+                "plan": {
+                    "type": "string",
+                    "example": "premium"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "response.Error": {
+            "description": "Generic error response structure",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "HTTP status code",
+                    "type": "integer",
+                    "example": 400
+                },
+                "data": {
+                    "description": "Useful for validation's errors",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/types.ArticleStatus"
+                            "$ref": "#/definitions/core.Data"
                         }
-                    ],
-                    "example": "draft"
+                    ]
                 },
-                "tiktok_url": {
+                "message": {
+                    "description": "Error message description",
+                    "type": "string"
+                }
+            }
+        },
+        "response.ExportQueued": {
+            "description": "Queued content export response",
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Recipient of the download link",
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "https://tiktok.com/@user/video/123456"
+                    "example": "admin@gfly.dev"
                 },
-                "title": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "How to Build a Go Web Application"
+                "format": {
+                    "description": "Export format",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.BackupFormat"
+                        }
+                    ],
+                    "example": "zip"
                 },
-                "youtube_url": {
+                "status": {
+                    "description": "Export status",
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "https://youtube.com/watch?v=abcdef"
+                    "example": "queued"
                 }
             }
         },
-        "request.CreateUser": {
+        "response.FeedLink": {
             "type": "object",
-            "required": [
-                "email",
-                "fullname",
-                "password",
-                "phone"
-            ],
             "properties": {
-                "avatar": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "https://i.pravatar.cc/32"
+                "href": {
+                    "type": "string"
                 },
-                "email": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "john@jivecode.com"
+                "rel": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.Follow": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "fullname": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "John Doe"
+                "id": {
+                    "description": "ID of the category or of the author",
+                    "type": "integer",
+                    "example": 1
                 },
-                "password": {
+                "name": {
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "M1PassW@s"
+                    "example": "Truyện ma có thật"
                 },
-                "phone": {
+                "slug": {
+                    "description": "Categories only",
                     "type": "string",
-                    "maxLength": 20,
-                    "example": "0989831911"
-                },
-                "roles": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/types.Role"
-                    },
-                    "example": [
-                        "admin",
-                        "user"
-                    ]
+                    "example": "truyen-ma-co-that"
                 },
-                "status": {
+                "type": {
+                    "description": "category or author",
                     "type": "string",
-                    "example": "pending"
+                    "example": "category"
                 }
             }
         },
-        "request.ForgotPassword": {
+        "response.ListAuditLog": {
             "type": "object",
-            "required": [
-                "username"
-            ],
             "properties": {
-                "username": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "john@jivecode.com"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AuditLog"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/dto.Meta"
                 }
             }
         },
-        "request.RefreshToken": {
+        "response.ListNotification": {
             "type": "object",
-            "required": [
-                "token"
-            ],
             "properties": {
-                "token": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "d1a4216a226cbf75eaefc9107c2c64b6b2c0f18cd8634e3a6f495146c38e1324.1747914602"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Notification"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/dto.Meta"
                 }
             }
         },
-        "request.ResetPassword": {
+        "response.ListUser": {
             "type": "object",
-            "required": [
-                "password",
-                "token"
-            ],
             "properties": {
-                "password": {
-                    "type": "string",
-                    "minLength": 6,
-                    "example": "M1PassW@s"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.User"
+                    }
                 },
-                "token": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "293r823or832eioj2eo9282o423"
+                "meta": {
+                    "$ref": "#/definitions/dto.Meta"
                 }
             }
         },
-        "request.SignIn": {
+        "response.ListWebhookDelivery": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "P@seWor9"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.WebhookDelivery"
+                    }
                 },
-                "username": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "admin@gfly.dev"
+                "meta": {
+                    "$ref": "#/definitions/dto.Meta"
                 }
             }
         },
-        "request.SignUp": {
+        "response.Notification": {
             "type": "object",
-            "required": [
-                "email",
-                "fullname",
-                "password",
-                "phone"
-            ],
             "properties": {
-                "avatar": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "https://i.pravatar.cc/32"
-                },
-                "email": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "john@jivecode.com"
+                "article": {
+                    "$ref": "#/definitions/response.NotificationArticle"
                 },
-                "fullname": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "John Doe"
+                "created_at": {
+                    "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "M1PassW@s"
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "phone": {
-                    "type": "string",
-                    "maxLength": 20,
-                    "example": "0989831911"
+                "read": {
+                    "type": "boolean"
                 },
-                "status": {
+                "type": {
                     "type": "string",
-                    "example": "pending"
+                    "example": "new_story"
                 }
             }
         },
-        "request.UpdateArticle": {
+        "response.NotificationArticle": {
             "type": "object",
             "properties": {
-                "content": {
-                    "type": "string",
-                    "example": "\u003cp\u003eUpdated content of the article...\u003c/p\u003e"
-                },
                 "cover_image": {
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "https://example.com/images/updated-cover.jpg"
-                },
-                "excerpt": {
-                    "type": "string",
-                    "example": "Updated summary of building a Go web application"
-                },
-                "seo_description": {
-                    "type": "string",
-                    "maxLength": 300,
-                    "example": "Updated guide to building Go web applications"
+                    "example": "https://example.com/images/cover.jpg"
                 },
-                "seo_keywords": {
-                    "type": "string",
-                    "example": "updated,golang,web development"
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "slug": {
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "updated-how-to-build-go-web-application"
-                },
-                "status": {
-                    "enum": [
-                        "draft",
-                        "published",
-                        "archived"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/types.ArticleStatus"
-                        }
-                    ],
-                    "example": "published"
-                },
-                "tiktok_url": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "https://tiktok.com/@user/video/updated"
+                    "example": "the-ghost-of-the-old-house"
                 },
                 "title": {
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "Updated: How to Build a Go Web Application"
+                    "example": "The Ghost of the Old House"
                 },
-                "youtube_url": {
+                "url": {
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "https://youtube.com/watch?v=updated"
-                }
-            }
-        },
-        "request.UpdateArticleStatus": {
-            "type": "object",
-            "required": [
-                "status"
-            ],
-            "properties": {
-                "status": {
-                    "enum": [
-                        "draft",
-                        "published",
-                        "archived"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/types.ArticleStatus"
-                        }
-                    ],
-                    "example": "published"
+                    "example": "https://example.com/truyen/the-ghost-of-the-old-house"
                 }
             }
         },
-        "request.UpdateUser": {
+        "response.NotificationPreferences": {
             "type": "object",
             "properties": {
-                "avatar": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "https://i.pravatar.cc/32"
-                },
-                "fullname": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "John Doe"
-                },
-                "password": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "M1PassW@s"
-                },
-                "phone": {
-                    "type": "string",
-                    "maxLength": 20,
-                    "example": "0989831911"
+                "email": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "roles": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/types.Role"
-                    },
-                    "example": [
-                        "admin",
-                        "user"
-                    ]
+                "in_app": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "request.UpdateUserStatus": {
+        "response.PaginatedResponse": {
             "type": "object",
-            "required": [
-                "status"
-            ],
             "properties": {
-                "status": {
-                    "enum": [
-                        "active",
-                        "pending",
-                        "blocked"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/types.UserStatus"
-                        }
-                    ],
-                    "example": "active"
+                "data": {},
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
                 }
             }
         },
-        "response.Article": {
+        "response.Pagination": {
             "type": "object",
             "properties": {
-                "author_id": {
+                "current_page": {
                     "type": "integer"
                 },
-                "content": {
-                    "type": "string"
+                "has_more": {
+                    "type": "boolean"
                 },
-                "cover_image": {
+                "next_cursor": {
+                    "description": "Cursor of the next page (cursor mode)",
                     "type": "string"
                 },
-                "created_at": {
-                    "type": "string"
+                "per_page": {
+                    "type": "integer"
                 },
-                "excerpt": {
+                "prev_cursor": {
+                    "description": "Cursor of the previous page (cursor mode)",
                     "type": "string"
                 },
-                "id": {
+                "total": {
                     "type": "integer"
                 },
-                "published_at": {
-                    "type": "string"
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.RSS": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "$ref": "#/definitions/response.RSSChannel"
                 },
-                "seo_description": {
+                "version": {
                     "type": "string"
                 },
-                "seo_keywords": {
-                    "type": "string"
+                "xmlname": {
+                    "$ref": "#/definitions/xml.Name"
                 },
-                "slug": {
+                "xmlnsAtom": {
                     "type": "string"
                 },
-                "status": {
+                "xmlnsContent": {
                     "type": "string"
+                }
+            }
+        },
+        "response.RSSChannel": {
+            "type": "object",
+            "properties": {
+                "atomLink": {
+                    "$ref": "#/definitions/response.FeedLink"
                 },
-                "tiktok_url": {
+                "description": {
                     "type": "string"
                 },
-                "title": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.RSSItem"
+                    }
+                },
+                "language": {
                     "type": "string"
                 },
-                "updated_at": {
+                "lastBuildDate": {
                     "type": "string"
                 },
-                "view_count": {
-                    "type": "integer"
+                "link": {
+                    "type": "string"
                 },
-                "youtube_url": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "response.Error": {
-            "description": "Generic error response structure",
+        "response.RSSEnclosure": {
             "type": "object",
             "properties": {
-                "code": {
-                    "description": "HTTP status code",
-                    "type": "integer",
-                    "example": 400
+                "length": {
+                    "type": "integer"
                 },
-                "data": {
-                    "description": "Useful for validation's errors",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/core.Data"
-                        }
-                    ]
+                "type": {
+                    "type": "string"
                 },
-                "message": {
-                    "description": "Error message description",
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "response.ListUser": {
+        "response.RSSGUID": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/response.User"
-                    }
+                "isPermaLink": {
+                    "type": "boolean"
                 },
-                "meta": {
-                    "$ref": "#/definitions/dto.Meta"
-                }
-            }
-        },
-        "response.PaginatedResponse": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "pagination": {
-                    "$ref": "#/definitions/response.Pagination"
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "response.Pagination": {
+        "response.RSSItem": {
             "type": "object",
             "properties": {
-                "current_page": {
-                    "type": "integer"
+                "content": {
+                    "$ref": "#/definitions/response.CDATA"
                 },
-                "has_more": {
-                    "type": "boolean"
+                "description": {
+                    "$ref": "#/definitions/response.CDATA"
                 },
-                "per_page": {
-                    "type": "integer"
+                "enclosure": {
+                    "$ref": "#/definitions/response.RSSEnclosure"
                 },
-                "total": {
-                    "type": "integer"
+                "guid": {
+                    "$ref": "#/definitions/response.RSSGUID"
                 },
-                "total_pages": {
-                    "type": "integer"
+                "link": {
+                    "type": "string"
+                },
+                "pubDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
@@ -1586,6 +5548,36 @@ const docTemplate = `{
                 }
             }
         },
+        "response.StatsCount": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "google.com"
+                },
+                "views": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "response.StatsPoint": {
+            "type": "object",
+            "properties": {
+                "time": {
+                    "description": "Start of the bucket (server time)",
+                    "type": "string"
+                },
+                "unique_visitors": {
+                    "type": "integer",
+                    "example": 37
+                },
+                "views": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
         "response.Unauthorized": {
             "description": "Unauthorized error response structure",
             "type": "object",
@@ -1607,6 +5599,9 @@ const docTemplate = `{
                 "avatar": {
                     "type": "string"
                 },
+                "birthdate": {
+                    "type": "string"
+                },
                 "blocked_at": {},
                 "created_at": {
                     "type": "string"
@@ -1643,6 +5638,158 @@ const docTemplate = `{
                 "verified_at": {}
             }
         },
+        "response.Video": {
+            "type": "object",
+            "properties": {
+                "canonical_url": {
+                    "type": "string",
+                    "example": "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+                },
+                "embed_url": {
+                    "type": "string",
+                    "example": "https://www.youtube.com/embed/dQw4w9WgXcQ?start=90"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "youtube"
+                },
+                "short_code": {
+                    "type": "string",
+                    "example": "ZMeAbCdEf"
+                },
+                "start_time": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "video_id": {
+                    "type": "string",
+                    "example": "dQw4w9WgXcQ"
+                }
+            }
+        },
+        "response.Videos": {
+            "type": "object",
+            "properties": {
+                "tiktok": {
+                    "$ref": "#/definitions/response.Video"
+                },
+                "youtube": {
+                    "$ref": "#/definitions/response.Video"
+                }
+            }
+        },
+        "response.Webhook": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Discord bot"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "article.published",
+                        "article.deleted"
+                    ]
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "secret": {
+                    "description": "Signs the X-Webhook-Signature header, only returned on creation",
+                    "type": "string",
+                    "example": "whsec_9f8e7d6c5b4a39281706f5e4d3c2b1a0"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://bot.example.com/hooks/stories"
+                }
+            }
+        },
+        "response.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "dial tcp: connection refused"
+                },
+                "event": {
+                    "type": "string",
+                    "example": "article.published"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string",
+                    "example": "{\"event\":\"article.published\",\"created_at\":\"2025-01-01T08:00:00Z\",\"data\":{\"id\":7}}"
+                },
+                "response_body": {
+                    "type": "string",
+                    "example": "Service Unavailable"
+                },
+                "response_code": {
+                    "description": "Of the last attempt",
+                    "type": "integer",
+                    "example": 503
+                },
+                "status": {
+                    "description": "pending, delivered, retrying or failed",
+                    "type": "string",
+                    "example": "retrying"
+                },
+                "webhook_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "types.AccessLevel": {
+            "type": "string",
+            "enum": [
+                "public",
+                "members",
+                "premium"
+            ],
+            "x-enum-comments": {
+                "AccessLevelMembers": "Users with a members or premium plan",
+                "AccessLevelPremium": "Users with a premium plan",
+                "AccessLevelPublic": "Everyone"
+            },
+            "x-enum-varnames": [
+                "AccessLevelPublic",
+                "AccessLevelMembers",
+                "AccessLevelPremium"
+            ]
+        },
         "types.ArticleStatus": {
             "type": "string",
             "enum": [
@@ -1656,6 +5803,85 @@ const docTemplate = `{
                 "ArticleStatusArchived"
             ]
         },
+        "types.BulkArticleAction": {
+            "type": "string",
+            "enum": [
+                "publish",
+                "archive",
+                "delete",
+                "restore",
+                "assign_categories"
+            ],
+            "x-enum-comments": {
+                "BulkArticleDelete": "Soft delete, reverted by restore",
+                "BulkArticleRestore": "Undo a bulk delete"
+            },
+            "x-enum-varnames": [
+                "BulkArticlePublish",
+                "BulkArticleArchive",
+                "BulkArticleDelete",
+                "BulkArticleRestore",
+                "BulkArticleAssignCategories"
+            ]
+        },
+        "types.BulkUserAction": {
+            "type": "string",
+            "enum": [
+                "activate",
+                "block",
+                "delete",
+                "set_roles"
+            ],
+            "x-enum-varnames": [
+                "BulkUserActivate",
+                "BulkUserBlock",
+                "BulkUserDelete",
+                "BulkUserSetRoles"
+            ]
+        },
+        "types.ContentWarning": {
+            "type": "string",
+            "enum": [
+                "violence",
+                "gore",
+                "suicide",
+                "self_harm",
+                "sexual_content",
+                "abuse",
+                "drugs"
+            ],
+            "x-enum-varnames": [
+                "ContentWarningViolence",
+                "ContentWarningGore",
+                "ContentWarningSuicide",
+                "ContentWarningSelfHarm",
+                "ContentWarningSexualContent",
+                "ContentWarningAbuse",
+                "ContentWarningDrugs"
+            ]
+        },
+        "types.FollowType": {
+            "type": "string",
+            "enum": [
+                "category",
+                "author"
+            ],
+            "x-enum-varnames": [
+                "FollowTypeCategory",
+                "FollowTypeAuthor"
+            ]
+        },
+        "types.Plan": {
+            "type": "string",
+            "enum": [
+                "members",
+                "premium"
+            ],
+            "x-enum-varnames": [
+                "PlanMembers",
+                "PlanPremium"
+            ]
+        },
         "types.Role": {
             "type": "string",
             "enum": [
@@ -1685,6 +5911,32 @@ const docTemplate = `{
                 "UserStatusPending",
                 "UserStatusBlocked"
             ]
+        },
+        "types.WebhookEvent": {
+            "type": "string",
+            "enum": [
+                "article.published",
+                "article.updated",
+                "article.deleted",
+                "user.created"
+            ],
+            "x-enum-varnames": [
+                "WebhookEventArticlePublished",
+                "WebhookEventArticleUpdated",
+                "WebhookEventArticleDeleted",
+                "WebhookEventUserCreated"
+            ]
+        },
+        "xml.Name": {
+            "type": "object",
+            "properties": {
+                "local": {
+                    "type": "string"
+                },
+                "space": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -1,7 +1,9 @@
 package main
 
 import (
+	_ "gfly/app/console/queues" // Register tasks dispatched by HTTP handlers.
 	"gfly/app/http/routes"
+	_ "gfly/app/listeners" // Register listeners of the domain events.
 	"gfly/docs"
 	"github.com/gflydev/cache"
 	cacheRedis "github.com/gflydev/cache/redis"
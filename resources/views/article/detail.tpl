@@ -0,0 +1,60 @@
+{% extends "../master.tpl" %}
+    {% block head %}
+    <!-- OpenGraph -->
+    <meta property="og:type" content="article"/>
+    <meta property="og:site_name" content="{{ appName }}"/>
+    <meta property="og:title" content="{{ article.Title }}"/>
+    <meta property="og:description" content="{{ meta_description }}"/>
+    <meta property="og:url" content="{{ canonical_url }}"/>
+    {% if og_image %}
+    <meta property="og:image" content="{{ og_image }}"/>
+    {% endif %}
+    {% if article.PublishedAt.Valid %}
+    <meta property="article:published_time" content="{{ article.PublishedAt.Time|date:"2006-01-02T15:04:05Z07:00" }}"/>
+    {% endif %}
+
+    <!-- Twitter card -->
+    <meta name="twitter:card" content="{% if og_image %}summary_large_image{% else %}summary{% endif %}"/>
+    <meta name="twitter:title" content="{{ article.Title }}"/>
+    <meta name="twitter:description" content="{{ meta_description }}"/>
+    {% if og_image %}
+    <meta name="twitter:image" content="{{ og_image }}"/>
+    {% endif %}
+
+    <!-- Structured data -->
+    <script type="application/ld+json">{{ json_ld|safe }}</script>
+    <script type="application/ld+json">{{ breadcrumb_json_ld|safe }}</script>
+    {% endblock %}
+    {% block body %}
+<!-- =========={ Story }==========  -->
+<article class="container xl:max-w-4xl mx-auto px-4 pt-28 pb-20">
+    {% include "../breadcrumbs.tpl" %}
+    <h1 class="text-4xl font-bold mb-3 text-gray-800 dark:text-gray-200">{{ article.Title }}</h1>
+    <p class="text-sm mb-6">
+        {% if author %}{{ author.Fullname }} · {% endif %}
+        {% if article.PublishedAt.Valid %}<time datetime="{{ article.PublishedAt.Time|date:"2006-01-02" }}">{{ formatTime(article.PublishedAt.Time) }}</time>{% endif %}
+    </p>
+    {% if og_image %}
+    <img src="{{ og_image }}" alt="{{ article.Title }}" class="w-full rounded mb-8"/>
+    {% endif %}
+    {% if content_warnings %}
+    <p class="text-sm mb-6 text-red-700 dark:text-red-400">
+        Content warnings: {{ content_warnings|join:", " }}
+    </p>
+    {% endif %}
+    {% if age_gated %}
+    <div class="leading-relaxed rounded border border-gray-300 dark:border-gray-700 p-6">
+        This story is rated {{ article.AgeRating }}+. Please confirm your age to read it.
+    </div>
+    {% else %}
+    <div class="leading-relaxed">
+        {{ article.Content|safe }}
+    </div>
+    {% if locked %}
+    <div class="leading-relaxed rounded border border-gray-300 dark:border-gray-700 p-6 mt-8">
+        The rest of this story is for {% if article.AccessLevel == "premium" %}premium{% else %}members{% endif %} readers. Sign in with your plan to keep reading.
+    </div>
+    {% endif %}
+    {% endif %}
+</article><!-- end story -->
+    {% endblock %}
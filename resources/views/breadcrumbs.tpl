@@ -0,0 +1,13 @@
+<nav aria-label="Breadcrumb" class="text-sm mb-6">
+    <ol class="flex flex-wrap">
+        {% for breadcrumb in breadcrumbs %}
+        <li>
+            {% if forloop.Last %}
+            <span aria-current="page">{{ breadcrumb.Name }}</span>
+            {% else %}
+            <a href="{{ breadcrumb.URL }}" class="hover:text-indigo-600">{{ breadcrumb.Name }}</a><span class="mx-2">/</span>
+            {% endif %}
+        </li>
+        {% endfor %}
+    </ol>
+</nav>
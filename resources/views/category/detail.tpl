@@ -0,0 +1,51 @@
+{% extends "../master.tpl" %}
+    {% block head %}
+    <!-- OpenGraph -->
+    <meta property="og:type" content="website"/>
+    <meta property="og:site_name" content="{{ appName }}"/>
+    <meta property="og:title" content="{{ category.Name }}"/>
+    <meta property="og:description" content="{{ meta_description }}"/>
+    <meta property="og:url" content="{{ canonical_url }}"/>
+
+    <!-- Twitter card -->
+    <meta name="twitter:card" content="summary"/>
+    <meta name="twitter:title" content="{{ category.Name }}"/>
+    <meta name="twitter:description" content="{{ meta_description }}"/>
+
+    <!-- Feed of the category -->
+    <link rel="alternate" type="application/rss+xml" title="{{ category.Name }}" href="{{ feed_url }}"/>
+
+    <!-- Structured data -->
+    <script type="application/ld+json">{{ json_ld|safe }}</script>
+    <script type="application/ld+json">{{ breadcrumb_json_ld|safe }}</script>
+    {% endblock %}
+    {% block body %}
+<!-- =========={ Category }==========  -->
+<section class="container xl:max-w-6xl mx-auto px-4 pt-28 pb-20">
+    {% include "../breadcrumbs.tpl" %}
+    <h1 class="text-4xl font-bold mb-8 text-gray-800 dark:text-gray-200">{{ category.Name }}</h1>
+    {% if articles %}
+    <div class="flex flex-wrap -mx-4">
+        {% for article in articles %}
+        <div class="w-full md:w-1/2 lg:w-1/3 px-4 mb-8">
+            <a href="/truyen/{{ article.Slug }}" class="block hover:text-indigo-600">
+                {% if article.CoverImage.Valid %}
+                <img src="{{ article.CoverImage.String }}" alt="{{ article.Title }}" class="w-full rounded mb-3" loading="lazy"/>
+                {% endif %}
+                <h2 class="text-xl font-bold mb-2">{{ article.Title }}</h2>
+                <p>{{ article.Excerpt.String }}</p>
+            </a>
+        </div>
+        {% endfor %}
+    </div>
+    {% else %}
+    <p>No stories in this category yet.</p>
+    {% endif %}
+    {% if prev_page or next_page %}
+    <nav class="flex justify-between mt-8">
+        {% if prev_page %}<a href="?page={{ prev_page }}" rel="prev" class="hover:text-indigo-600">Newer stories</a>{% else %}<span></span>{% endif %}
+        {% if next_page %}<a href="?page={{ next_page }}" rel="next" class="hover:text-indigo-600">Older stories</a>{% endif %}
+    </nav>
+    {% endif %}
+</section><!-- end category -->
+    {% endblock %}
@@ -0,0 +1,14 @@
+{% extends "master.tpl" %}
+    {% block head %}
+    <meta name="robots" content="noindex"/>
+    {% endblock %}
+    {% block body %}
+<!-- =========={ Error }==========  -->
+<div id="hero" class="relative z-0 pt-36 lg:pt-44 xl:pt-48 pb-20 lg:pb-32 text-gray-300 bg-indigo-600 bg-gradient-to-r from-indigo-600 via-indigo-500 to-teal-500 dark:from-gray-800 dark:via-gray-700 dark:to-green-700 overflow-hidden h-screen">
+    <div class="container xl:max-w-6xl mx-auto px-4 text-center">
+        <h1 class="text-6xl font-bold mb-3">{{ status }}</h1>
+        <p class="text-xl font-light pb-6">{{ message }}</p>
+        <a class="py-2 px-4 inline-block rounded text-gray-700 bg-gray-300 hover:bg-gray-200" href="/">Home</a>
+    </div>
+</div><!-- end error -->
+    {% endblock %}
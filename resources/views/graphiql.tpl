@@ -0,0 +1,36 @@
+<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8"/>
+    <meta name="viewport" content="width=device-width, initial-scale=1"/>
+    <meta name="robots" content="noindex"/>
+    <title>{{ title_page }}</title>
+    <link rel="icon" href="/assets/favicon.png"/>
+    <link rel="stylesheet" href="https://unpkg.com/graphiql@3/graphiql.min.css"/>
+    <style>
+        body { height: 100vh; margin: 0; overflow: hidden; }
+        #graphiql { height: 100vh; }
+    </style>
+</head>
+<body>
+<div id="graphiql">Loading...</div>
+
+<script crossorigin src="https://unpkg.com/react@18/umd/react.production.min.js"></script>
+<script crossorigin src="https://unpkg.com/react-dom@18/umd/react-dom.production.min.js"></script>
+<script crossorigin src="https://unpkg.com/graphiql@3/graphiql.min.js"></script>
+<script>
+    const fetcher = GraphiQL.createFetcher({ url: "{{ endpoint }}" });
+    const defaultQuery = `{
+  articles(perPage: 5, orderBy: "-published_at") {
+    items { title slug publishedAt author { fullname } categories { name } }
+    pagination { total hasMore }
+  }
+}
+`;
+
+    ReactDOM.createRoot(document.getElementById("graphiql")).render(
+        React.createElement(GraphiQL, { fetcher, defaultQuery })
+    );
+</script>
+</body>
+</html>
@@ -32,4 +32,24 @@
         </div><!-- end row -->
     </div>
 </div><!-- end hero -->
+
+<!-- =========={ Latest Stories }==========  -->
+{% if articles %}
+<section id="stories" class="container xl:max-w-6xl mx-auto px-4 py-16">
+    <h2 class="text-3xl font-bold mb-8 text-gray-800 dark:text-gray-200">Latest stories</h2>
+    <div class="flex flex-wrap -mx-4">
+        {% for article in articles %}
+        <div class="w-full md:w-1/2 lg:w-1/3 px-4 mb-8">
+            <a href="/truyen/{{ article.Slug }}" class="block hover:text-indigo-600">
+                {% if article.CoverImage.Valid %}
+                <img src="{{ article.CoverImage.String }}" alt="{{ article.Title }}" class="w-full rounded mb-3" loading="lazy"/>
+                {% endif %}
+                <h3 class="text-xl font-bold mb-2">{{ article.Title }}</h3>
+                <p>{{ article.Excerpt.String }}</p>
+            </a>
+        </div>
+        {% endfor %}
+    </div>
+</section><!-- end stories -->
+{% endif %}
     {% endblock %}
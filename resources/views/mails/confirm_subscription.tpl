@@ -0,0 +1,17 @@
+{% extends "master.tpl" %}
+    {% block body %}
+    <p style="font-family: Helvetica, sans-serif; font-size: 16px; font-weight: normal; margin: 0; margin-bottom: 16px;">
+        Hi
+    </p>
+    <p style="font-family: Helvetica, sans-serif; font-size: 16px; font-weight: normal; margin: 0; margin-bottom: 16px;">
+        Please confirm that <b>{{ email }}</b> should receive <b>Top ghost stories</b>, our weekly selection of the most read stories.
+    </p>
+    <p style="font-family: Helvetica, sans-serif; font-size: 16px; font-weight: normal; margin: 0; margin-bottom: 16px;">
+        <a href="{{ confirm_url }}" target="_blank" style="border: solid 2px #0867ec; border-radius: 4px; box-sizing: border-box; cursor: pointer; display: inline-block; font-size: 16px; font-weight: bold; margin: 0; padding: 12px 24px; text-decoration: none; text-transform: capitalize; background-color: #0867ec; border-color: #0867ec; color: #ffffff;">
+            Confirm subscription
+        </a>
+    </p>
+    <p style="font-family: Helvetica, sans-serif; font-size: 16px; font-weight: normal; margin: 0; margin-bottom: 16px;">
+        If you didn't subscribe, just ignore this email. The link expires in two days.
+    </p>
+    {% endblock %}
@@ -109,7 +109,7 @@
                         <tr>
                             <td class="content-block" style="font-family: Helvetica, sans-serif; vertical-align: top; color: #9a9ea6; font-size: 16px; text-align: center;" valign="top" align="center">
                                 <span class="apple-link" style="color: #9a9ea6; font-size: 16px; text-align: center;">gFly - Laravel inspired web framework written in Go</span>
-                                <br/> Don't like these emails? <a href="{{ base_url }}/unsubscribe?email={{ email }}" style="text-decoration: underline; color: #9a9ea6; font-size: 16px; text-align: center;">Unsubscribe</a>.
+                                <br/> Don't like these emails? <a href="{% if unsubscribe_url %}{{ unsubscribe_url }}{% else %}{{ base_url }}/unsubscribe?email={{ email }}{% endif %}" style="text-decoration: underline; color: #9a9ea6; font-size: 16px; text-align: center;">Unsubscribe</a>.
                             </td>
                         </tr>
                         <tr>
@@ -0,0 +1,33 @@
+{% extends "master.tpl" %}
+    {% block body %}
+    <h1 style="font-family: Helvetica, sans-serif; font-size: 24px; font-weight: bold; margin: 0; margin-bottom: 8px;">
+        Top ghost stories
+    </h1>
+    <p style="font-family: Helvetica, sans-serif; font-size: 14px; font-weight: normal; color: #9a9ea6; margin: 0; margin-bottom: 24px;">
+        The most read stories of the week {{ week }}
+    </p>
+    {% for story in stories %}
+    <table role="presentation" border="0" cellpadding="0" cellspacing="0" style="border-collapse: separate; width: 100%; margin-bottom: 24px;" width="100%">
+        {% if story.CoverImage %}
+        <tr>
+            <td style="padding-bottom: 12px;">
+                <a href="{{ story.URL }}" target="_blank"><img src="{{ story.CoverImage }}" alt="{{ story.Title }}" width="752" style="border: none; border-radius: 8px; display: block; max-width: 100%; height: auto;"/></a>
+            </td>
+        </tr>
+        {% endif %}
+        <tr>
+            <td style="font-family: Helvetica, sans-serif; font-size: 16px; vertical-align: top;" valign="top">
+                <p style="font-family: Helvetica, sans-serif; font-size: 18px; font-weight: bold; margin: 0; margin-bottom: 8px;">
+                    {{ forloop.Counter }}. <a href="{{ story.URL }}" target="_blank" style="color: #0867ec; text-decoration: none;">{{ story.Title }}</a>
+                </p>
+                {% if story.Excerpt %}
+                <p style="font-family: Helvetica, sans-serif; font-size: 16px; font-weight: normal; margin: 0; margin-bottom: 8px;">
+                    {{ story.Excerpt }}
+                </p>
+                {% endif %}
+                <a href="{{ story.URL }}" target="_blank" style="font-family: Helvetica, sans-serif; font-size: 14px; color: #0867ec;">Read the story</a>
+            </td>
+        </tr>
+    </table>
+    {% endfor %}
+    {% endblock %}
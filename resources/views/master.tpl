@@ -1,5 +1,5 @@
 <!DOCTYPE html>
-<html lang="en" dir="ltr">
+<html lang="{% if page_locale %}{{ page_locale }}{% else %}en{% endif %}" dir="ltr">
 <head>
     <!-- Required meta tags -->
     <meta charset="UTF-8"/>
@@ -8,7 +8,16 @@
 
     <!-- Title  -->
     <title>{{ title_page }}</title>
-    <meta name="description" content="Built on top of FastHttp, the fastest HTTP engine for Go. Quick development with zero memory allocation and high performance. Very simple and easy to use."/>
+    <meta name="description" content="{% if meta_description %}{{ meta_description }}{% else %}Built on top of FastHttp, the fastest HTTP engine for Go. Quick development with zero memory allocation and high performance. Very simple and easy to use.{% endif %}"/>
+    {% if canonical_url %}
+    <link rel="canonical" href="{{ canonical_url }}"/>
+    {% endif %}
+    {% for alternate in alternates %}
+    <link rel="alternate" hreflang="{{ alternate.Hreflang }}" href="{{ alternate.URL }}"/>
+    {% endfor %}
+
+    <!-- Page specific meta tags -->
+    {% block head %}{% endblock %}
 
     <!-- Development css (used in all pages) -->
     <link rel="stylesheet" id="stylesheet" href="/css/style.css"/>
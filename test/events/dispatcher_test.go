@@ -0,0 +1,111 @@
+package events
+
+import (
+	"errors"
+	"gfly/app/events"
+	"slices"
+	"testing"
+)
+
+type storyRead struct {
+	ArticleID int
+}
+
+func (e storyRead) EventName() string {
+	return "test.story_read"
+}
+
+type storyShared struct {
+	ArticleID int
+	Network   string
+}
+
+func (e storyShared) EventName() string {
+	return "test.story_shared"
+}
+
+func TestDispatch(t *testing.T) {
+	var calls []string
+
+	events.Listen(func(event storyRead) error {
+		calls = append(calls, "count")
+
+		if event.ArticleID != 7 {
+			t.Errorf("Expected article 7, got %d", event.ArticleID)
+		}
+
+		return nil
+	})
+	events.Listen(func(event storyRead) error {
+		calls = append(calls, "fail")
+
+		return errors.New("listener failed")
+	})
+	events.Listen(func(event storyRead) error {
+		calls = append(calls, "log")
+
+		return nil
+	})
+
+	err := events.Dispatch(storyRead{ArticleID: 7})
+
+	if !slices.Equal(calls, []string{"count", "fail", "log"}) {
+		t.Errorf("Expected every listener to run in registration order, got %v", calls)
+	}
+
+	if err == nil || err.Error() != "listener failed" {
+		t.Errorf("Expected the listener error, got %v", err)
+	}
+}
+
+func TestDispatchWithoutListeners(t *testing.T) {
+	if err := events.Dispatch(storyShared{ArticleID: 7}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestHandleQueued(t *testing.T) {
+	var handled []storyShared
+
+	events.ListenQueued("share-counter", func(event storyShared) error {
+		handled = append(handled, event)
+
+		return nil
+	})
+	events.ListenQueued("share-mailer", func(event storyShared) error {
+		return errors.New("mail failed")
+	})
+
+	tests := []struct {
+		name     string
+		queued   events.Queued
+		hasError bool
+		handled  int
+	}{
+		{"Listener", events.Queued{Event: "test.story_shared", Listener: "share-counter", Payload: []byte(`{"ArticleID":7,"Network":"x"}`)}, false, 1},
+		{"Failed listener", events.Queued{Event: "test.story_shared", Listener: "share-mailer", Payload: []byte(`{"ArticleID":7}`)}, true, 0},
+		{"Unknown listener", events.Queued{Event: "test.story_shared", Listener: "share-archiver", Payload: []byte(`{}`)}, true, 0},
+		{"Unknown event", events.Queued{Event: "test.story_liked", Listener: "share-counter", Payload: []byte(`{}`)}, true, 0},
+		{"Invalid payload", events.Queued{Event: "test.story_shared", Listener: "share-counter", Payload: []byte(`{`)}, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled = nil
+
+			err := events.HandleQueued(tt.queued)
+
+			if (err != nil) != tt.hasError {
+				t.Errorf("Expected error %v, got %v", tt.hasError, err)
+			}
+
+			if len(handled) != tt.handled {
+				t.Fatalf("Expected %d handled events, got %d", tt.handled, len(handled))
+			}
+
+			if tt.handled > 0 && (handled[0].ArticleID != 7 || handled[0].Network != "x") {
+				t.Errorf("Expected the decoded event, got %+v", handled[0])
+			}
+		})
+	}
+}
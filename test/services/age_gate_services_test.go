@@ -0,0 +1,46 @@
+package services
+
+import (
+	"gfly/app/services"
+	"testing"
+	"time"
+)
+
+func TestAgeTokenKey(t *testing.T) {
+	birthdate := time.Now().AddDate(-30, 0, 0)
+
+	tests := []struct {
+		name      string
+		issueKey  string
+		verifyKey string
+		hasToken  bool
+		ageRating int
+	}{
+		{"Signed and verified", "age-secret", "age-secret", true, services.AllowedAgeRating(30)},
+		{"Other key", "age-secret", "other-secret", true, 0},
+		{"Verified without key", "age-secret", "", true, 0},
+		{"Issued without key", "", "", false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JWT_SECRET_KEY", "")
+			t.Setenv("AGE_TOKEN_KEY", tt.issueKey)
+
+			ageToken, err := services.IssueAgeToken(birthdate)
+			if (err == nil) != tt.hasToken {
+				t.Fatalf("Expected a token %v, got %+v and %v", tt.hasToken, ageToken, err)
+			}
+
+			if ageToken == nil {
+				return
+			}
+
+			t.Setenv("AGE_TOKEN_KEY", tt.verifyKey)
+
+			if rating := services.VerifyAgeToken(ageToken.Token); rating != tt.ageRating {
+				t.Errorf("Expected rating %d, got %d", tt.ageRating, rating)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"database/sql"
+	"gfly/app/domain/models"
+	"gfly/app/services"
+	"testing"
+	"time"
+)
+
+func TestCheckArticleNotDeleted(t *testing.T) {
+	tests := []struct {
+		name     string
+		article  *models.Article
+		expected string
+	}{
+		{
+			name:     "Missing slug",
+			article:  nil,
+			expected: "Article not found",
+		},
+		{
+			name:     "Live article",
+			article:  &models.Article{ID: 1, Slug: "the-grey-lady"},
+			expected: "",
+		},
+		{
+			name:     "Soft-deleted slug",
+			article:  &models.Article{ID: 2, Slug: "the-hollow-house", DeletedAt: sql.NullTime{Time: time.Now(), Valid: true}},
+			expected: "Article no longer available",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := services.CheckArticleNotDeleted(test.article)
+
+			message := ""
+			if err != nil {
+				message = err.Error()
+			}
+
+			if message != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, message)
+			}
+		})
+	}
+}
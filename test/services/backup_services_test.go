@@ -0,0 +1,73 @@
+package services
+
+import (
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBackupRoundTrip(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	publishedAt := createdAt.Add(time.Hour)
+
+	backup := dto.BackupContent{
+		Manifest: dto.BackupManifest{
+			Version:    dto.BackupVersion,
+			ExportedAt: createdAt,
+			Media:      []string{"articles/cover.jpg"},
+		},
+		Categories: []dto.BackupCategory{
+			{ID: 3, Name: "Urban legends", Slug: "urban-legends", CreatedAt: createdAt},
+		},
+		Authors: []dto.BackupAuthor{
+			{ID: 7, Email: "writer@example.com", Fullname: "Jane Writer", Status: types.UserStatusActive, Roles: []types.Role{types.RoleMember}, CreatedAt: createdAt},
+		},
+		Articles: []dto.BackupArticle{
+			{ID: 11, Title: "Ghost", Slug: "ghost", Content: "<p>Boo</p>", Status: types.ArticleStatusPublished, AuthorID: 7, CategoryIDs: []int{3}, PublishedAt: &publishedAt, CreatedAt: createdAt},
+		},
+		Translations: []dto.BackupTranslation{
+			{ArticleID: 11, Locale: "en", Title: "Ghost", Slug: "ghost-en", Content: "<p>Boo</p>", CreatedAt: createdAt},
+		},
+	}
+
+	data, err := services.EncodeBackup(backup)
+	if err != nil {
+		t.Fatalf("Unexpected encoding error: %v", err)
+	}
+
+	restored, err := services.DecodeBackup(data)
+	if err != nil {
+		t.Fatalf("Unexpected decoding error: %v", err)
+	}
+
+	expected := backup
+	expected.Manifest.Categories, expected.Manifest.Authors = 1, 1
+	expected.Manifest.Articles, expected.Manifest.Translations = 1, 1
+
+	if !reflect.DeepEqual(restored, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, restored)
+	}
+}
+
+func TestDecodeBackupErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"Empty", ""},
+		{"MissingManifest", `{"type":"article","article":{"id":1}}`},
+		{"NewerVersion", `{"type":"manifest","manifest":{"version":99}}`},
+		{"InvalidRecord", "{\"type\":\"manifest\",\"manifest\":{\"version\":1}}\n{"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := services.DecodeBackup([]byte(test.content)); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
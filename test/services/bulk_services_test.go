@@ -0,0 +1,26 @@
+package services
+
+import (
+	"gfly/app/domain/models/types"
+	"gfly/app/dto"
+	"gfly/app/services"
+	"testing"
+)
+
+func TestBulkUpdateUsersSelf(t *testing.T) {
+	actions := []types.BulkUserAction{types.BulkUserActivate, types.BulkUserBlock, types.BulkUserDelete, types.BulkUserSetRoles}
+
+	for _, action := range actions {
+		t.Run(string(action), func(t *testing.T) {
+			result := services.BulkUpdateUsers(dto.BulkUsers{IDs: []int{7}, Action: action}, 7)
+
+			if result.Succeeded != 0 || result.Failed != 1 || len(result.Results) != 1 {
+				t.Fatalf("Expected one failed item, got %+v", result)
+			}
+
+			if item := result.Results[0]; item.ID != 7 || item.Success || item.Error != "Don't allow update yourself" {
+				t.Errorf("Expected the actor to be refused, got %+v", item)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"gfly/app/dto"
+	"gfly/app/services"
+	"testing"
+	"time"
+)
+
+func TestParseImportFilePublishedAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   dto.ImportFormat
+		data     string
+		expected time.Time
+	}{
+		{"JSONRFC3339", dto.ImportFormatJSON, `[{"title":"Ghost","published_at":"2021-03-04T05:06:07Z"}]`, time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)},
+		{"JSONDateTime", dto.ImportFormatJSON, `[{"title":"Ghost","published_at":"2021-03-04 05:06:07"}]`, time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)},
+		{"JSONDate", dto.ImportFormatJSON, `{"articles":[{"title":"Ghost","published_at":"2021-03-04"}]}`, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"JSONMissing", dto.ImportFormatJSON, `[{"title":"Ghost"}]`, time.Time{}},
+		{"CSVDate", dto.ImportFormatCSV, "title,published_at\nGhost,2021-03-04\n", time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rows, err := services.ParseImportFile(test.format, []byte(test.data))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(rows) != 1 || rows[0].Title != "Ghost" || !rows[0].PublishedAt.Equal(test.expected) {
+				t.Errorf("Expected one row published at %v, got %+v", test.expected, rows)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"errors"
+	"gfly/app/domain/models"
+	"gfly/app/domain/models/types"
+	"gfly/app/services"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestDigestKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		time     time.Time
+		expected string
+	}{
+		{"Monday", time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC), "2026-W42"},
+		{"Sunday of the same week", time.Date(2026, 10, 18, 23, 59, 0, 0, time.UTC), "2026-W42"},
+		{"Next Monday", time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), "2026-W43"},
+		{"New Year's Day of the last week", time.Date(2027, 1, 1, 8, 0, 0, 0, time.UTC), "2026-W53"},
+		{"Single digit week", time.Date(2026, 1, 5, 8, 0, 0, 0, time.UTC), "2026-W02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if key := services.DigestKey(tt.time); key != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, key)
+			}
+		})
+	}
+}
+
+func TestDigestBatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		deliveryIDs []int
+		batchSize   int
+		expected    [][]int
+	}{
+		{"No delivery", nil, 2, nil},
+		{"Single batch", []int{1, 2}, 3, [][]int{{1, 2}}},
+		{"Full batches", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"Last batch smaller", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"Invalid batch size", []int{1, 2}, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if batches := services.DigestBatches(tt.deliveryIDs, tt.batchSize); !reflect.DeepEqual(batches, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, batches)
+			}
+		})
+	}
+}
+
+func TestIsDigestDeliveryPending(t *testing.T) {
+	tests := []struct {
+		status   types.DeliveryStatus
+		expected bool
+	}{
+		{types.DeliveryStatusQueued, true},
+		{types.DeliveryStatusSent, false},
+		{types.DeliveryStatusFailed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			if pending := services.IsDigestDeliveryPending(models.DigestDelivery{Status: tt.status}); pending != tt.expected {
+				t.Errorf("Expected pending %v, got %v", tt.expected, pending)
+			}
+		})
+	}
+}
+
+func TestRecordDigestAttempt(t *testing.T) {
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	sendErr := errors.New("smtp: connection refused")
+
+	tests := []struct {
+		name     string
+		attempts int
+		sendErr  error
+		status   types.DeliveryStatus
+		retry    bool
+	}{
+		{"Sent", 1, nil, types.DeliveryStatusSent, false},
+		{"Sent on retry", 2, nil, types.DeliveryStatusSent, false},
+		{"Failed", 1, sendErr, types.DeliveryStatusQueued, true},
+		{"Given up", 3, sendErr, types.DeliveryStatusFailed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := &models.DigestDelivery{Status: types.DeliveryStatusQueued, Attempts: tt.attempts}
+
+			err := services.RecordDigestAttempt(delivery, tt.sendErr, 3, now)
+
+			if (err != nil) != tt.retry {
+				t.Errorf("Expected retry %v, got %v", tt.retry, err)
+			}
+
+			if delivery.Status != tt.status {
+				t.Errorf("Expected status %s, got %s", tt.status, delivery.Status)
+			}
+
+			if tt.sendErr == nil && (delivery.Error.Valid || !delivery.SentAt.Valid || !delivery.SentAt.Time.Equal(now)) {
+				t.Errorf("Expected a sent delivery without error, got %+v", delivery)
+			}
+
+			if tt.sendErr != nil && delivery.Error.String != tt.sendErr.Error() {
+				t.Errorf("Expected error %q, got %q", tt.sendErr.Error(), delivery.Error.String)
+			}
+		})
+	}
+}
+
+func TestRecordDigestAttemptLongError(t *testing.T) {
+	delivery := &models.DigestDelivery{Status: types.DeliveryStatusQueued, Attempts: 1}
+
+	_ = services.RecordDigestAttempt(delivery, errors.New("a"+strings.Repeat("ỷ", 100)), 3, time.Now())
+
+	if len(delivery.Error.String) > 255 || !utf8.ValidString(delivery.Error.String) {
+		t.Errorf("Expected a valid error of at most 255 bytes, got %d bytes", len(delivery.Error.String))
+	}
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/dto"
+	"gfly/app/services"
+	netHttp "net/http"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+)
+
+var ignoredParams = []string{"age_token", "variant"}
+
+func TestResponseCacheKey(t *testing.T) {
+	base := services.ResponseCacheKey("/api/v1/articles", url.Values{"page": {"2"}, "category_id": {"3"}}, ignoredParams)
+
+	tests := []struct {
+		name     string
+		path     string
+		query    url.Values
+		variants []string
+		same     bool
+	}{
+		{"Same query", "/api/v1/articles", url.Values{"page": {"2"}, "category_id": {"3"}}, nil, true},
+		{"Empty values", "/api/v1/articles", url.Values{"page": {"2"}, "category_id": {"3"}, "keyword": {""}}, nil, true},
+		{"Ignored params", "/api/v1/articles", url.Values{"page": {"2"}, "category_id": {"3"}, "age_token": {"abc"}, "variant": {"4"}}, nil, true},
+		{"Other value", "/api/v1/articles", url.Values{"page": {"2"}, "category_id": {"4"}}, nil, false},
+		{"Other path", "/api/v1/articles/trending", url.Values{"page": {"2"}, "category_id": {"3"}}, nil, false},
+		{"Variants", "/api/v1/articles", url.Values{"page": {"2"}, "category_id": {"3"}}, []string{"vi"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := services.ResponseCacheKey(tt.path, tt.query, ignoredParams, tt.variants...)
+
+			if (key == base) != tt.same {
+				t.Errorf("Expected same key %v, got %q and %q", tt.same, key, base)
+			}
+		})
+	}
+}
+
+func TestArticleListTags(t *testing.T) {
+	tests := []struct {
+		name         string
+		filter       dto.ArticleFilter
+		categorySlug string
+		variantIDs   []int
+		expected     []string
+	}{
+		{"Plain list", dto.ArticleFilter{}, "", nil, []string{services.TagArticleList}},
+		{"Trending", dto.ArticleFilter{Filter: dto.Filter{OrderBy: "-trending"}}, "", nil, []string{services.TagArticleList, services.TagTrendingList}},
+		{"Category", dto.ArticleFilter{CategoryID: 3}, "urban-legends", nil, []string{services.TagArticleList, "category:urban-legends"}},
+		{"Variants", dto.ArticleFilter{}, "", []int{4, 5}, []string{services.TagArticleList, services.VariantTag(4), services.VariantTag(5)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tags := services.ArticleListTags(tt.filter, tt.categorySlug, tt.variantIDs); !slices.Equal(tags, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, tags)
+			}
+		})
+	}
+}
+
+func TestListTagsInvalidation(t *testing.T) {
+	article := models.Article{ID: 9}
+	category := models.Category{ID: 3, Slug: "urban-legends"}
+
+	tests := []struct {
+		name   string
+		filter dto.ArticleFilter
+	}{
+		{"Plain list", dto.ArticleFilter{}},
+		{"Trending", dto.ArticleFilter{Filter: dto.Filter{OrderBy: "trending"}}},
+		{"Category", dto.ArticleFilter{CategoryID: category.ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categorySlug := ""
+			if tt.filter.CategoryID == category.ID {
+				categorySlug = category.Slug
+			}
+
+			tags := services.ArticleListTags(tt.filter, categorySlug, nil)
+
+			// A change of any article invalidates every list
+			if !slices.ContainsFunc(services.ArticleResponseTags(article), func(tag string) bool { return slices.Contains(tags, tag) }) {
+				t.Errorf("Expected an article change to invalidate the list tagged %v", tags)
+			}
+
+			// A change of the category invalidates the lists filtered by it
+			if categorySlug != "" && !slices.Contains(tags, services.CategoryTag(category.Slug)) {
+				t.Errorf("Expected a category change to invalidate the list tagged %v", tags)
+			}
+		})
+	}
+
+	if articleID := services.TaggedArticleID(services.ArticleResponseTags(article)); articleID != article.ID {
+		t.Errorf("Expected the article tags to carry article %d, got %d", article.ID, articleID)
+	}
+}
+
+func TestIsNotModified(t *testing.T) {
+	etag := `"9f86d081"`
+	lastModified := time.Date(2026, 3, 1, 12, 0, 0, 500, time.UTC)
+	httpTime := func(t time.Time) string { return t.Format(netHttp.TimeFormat) }
+
+	tests := []struct {
+		name            string
+		ifNoneMatch     string
+		ifModifiedSince string
+		etag            string
+		lastModified    time.Time
+		expected        bool
+	}{
+		{"No validator", "", "", etag, lastModified, false},
+		{"Matching ETag", etag, "", etag, lastModified, true},
+		{"Weak ETag", "W/" + etag, "", etag, lastModified, true},
+		{"ETag in a list", `"other", ` + etag, "", etag, lastModified, true},
+		{"Any ETag", "*", "", etag, lastModified, true},
+		{"Changed ETag", `"other"`, "", etag, lastModified, false},
+		{"ETag without current ETag", etag, "", "", lastModified, false},
+		{"Same modification time", "", httpTime(lastModified), etag, lastModified, true},
+		{"Later copy", "", httpTime(lastModified.Add(time.Hour)), etag, lastModified, true},
+		{"Modified since", "", httpTime(lastModified.Add(-time.Second)), etag, lastModified, false},
+		{"Invalid date", "", "yesterday", etag, lastModified, false},
+		{"Date without modification time", "", httpTime(lastModified), etag, time.Time{}, false},
+		{"ETag takes precedence", `"other"`, httpTime(lastModified), etag, lastModified, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if notModified := services.IsNotModified(tt.ifNoneMatch, tt.ifModifiedSince, tt.etag, tt.lastModified); notModified != tt.expected {
+				t.Errorf("Expected not modified %v, got %v", tt.expected, notModified)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"fmt"
+	"gfly/app/domain/models"
+	"gfly/app/services"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gflydev/core"
+	dbNull "github.com/gflydev/db/null"
+	storageLocal "github.com/gflydev/storage/local"
+)
+
+func TestArticleSitemapURLs(t *testing.T) {
+	appURL := strings.TrimSuffix(core.AppURL, "/")
+	createdAt := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		article models.Article
+		lastMod string
+		image   string
+	}{
+		{
+			"Created only",
+			models.Article{Slug: "ghost-story", CreatedAt: createdAt},
+			"2026-01-01T08:00:00Z",
+			"",
+		},
+		{
+			"Published later",
+			models.Article{Slug: "ghost-story", CreatedAt: createdAt, PublishedAt: dbNull.Time(createdAt.AddDate(0, 0, 2))},
+			"2026-01-03T08:00:00Z",
+			"",
+		},
+		{
+			"Updated after publishing",
+			models.Article{Slug: "ghost-story", CreatedAt: createdAt, PublishedAt: dbNull.Time(createdAt.AddDate(0, 0, 2)), UpdatedAt: dbNull.Time(createdAt.AddDate(0, 0, 5))},
+			"2026-01-06T08:00:00Z",
+			"",
+		},
+		{
+			"Relative cover",
+			models.Article{Title: "Ghost", Slug: "ghost-story", CreatedAt: createdAt, CoverImage: dbNull.String("/storage/covers/ghost.jpg")},
+			"2026-01-01T08:00:00Z",
+			appURL + "/storage/covers/ghost.jpg",
+		},
+		{
+			"Absolute cover",
+			models.Article{Title: "Ghost", Slug: "ghost-story", CreatedAt: createdAt, CoverImage: dbNull.String("https://cdn.example.com/ghost.jpg")},
+			"2026-01-01T08:00:00Z",
+			"https://cdn.example.com/ghost.jpg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urls, latest := services.ArticleSitemapURLs([]models.Article{tt.article})
+
+			if len(urls) != 1 {
+				t.Fatalf("Expected one entry, got %d", len(urls))
+			}
+
+			if urls[0].Loc != appURL+"/truyen/ghost-story" {
+				t.Errorf("Expected the story page, got %q", urls[0].Loc)
+			}
+
+			if urls[0].LastMod != tt.lastMod || latest != tt.lastMod {
+				t.Errorf("Expected lastmod %q, got %q and %q", tt.lastMod, urls[0].LastMod, latest)
+			}
+
+			if tt.image == "" && len(urls[0].Images) != 0 {
+				t.Errorf("Expected no image, got %+v", urls[0].Images)
+			}
+
+			if tt.image != "" && (len(urls[0].Images) != 1 || urls[0].Images[0].Loc != tt.image) {
+				t.Errorf("Expected image %q, got %+v", tt.image, urls[0].Images)
+			}
+		})
+	}
+}
+
+func TestArticleSitemapURLsLatest(t *testing.T) {
+	older := models.Article{Slug: "older", CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	newer := models.Article{Slug: "newer", CreatedAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	if _, latest := services.ArticleSitemapURLs([]models.Article{newer, older}); latest != "2026-02-01T00:00:00Z" {
+		t.Errorf("Expected the latest lastmod of the page, got %q", latest)
+	}
+
+	if urls, latest := services.ArticleSitemapURLs(nil); len(urls) != 0 || latest != "" {
+		t.Errorf("Expected no entry and no lastmod, got %v and %q", urls, latest)
+	}
+}
+
+func TestStaticSitemapURLs(t *testing.T) {
+	appURL := strings.TrimSuffix(core.AppURL, "/")
+
+	tests := []struct {
+		name     string
+		pages    string
+		expected []string
+	}{
+		{"Home page", "/", []string{appURL + "/"}},
+		{"Pages", "/, /about ,contact,", []string{appURL + "/", appURL + "/about", appURL + "/contact"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SITEMAP_STATIC_PAGES", tt.pages)
+
+			urls := services.StaticSitemapURLs()
+			if len(urls) != len(tt.expected) {
+				t.Fatalf("Expected %d entries, got %+v", len(tt.expected), urls)
+			}
+
+			for i, url := range urls {
+				if url.Loc != tt.expected[i] {
+					t.Errorf("Expected %q, got %q", tt.expected[i], url.Loc)
+				}
+			}
+
+			if urls[0].Priority != "1.0" {
+				t.Errorf("Expected the home page first with priority 1.0, got %q", urls[0].Priority)
+			}
+		})
+	}
+}
+
+func TestPruneArticleSitemaps(t *testing.T) {
+	tests := []struct {
+		name      string
+		pages     []int
+		fromPage  int
+		removed   int
+		remaining []int
+	}{
+		{"Fewer articles", []int{1, 2, 3, 4}, 3, 2, []int{1, 2}},
+		{"Same articles", []int{1, 2}, 3, 0, []int{1, 2}},
+		{"No article", []int{1, 2}, 1, 2, nil},
+		{"Gap ends the stale pages", []int{1, 2, 4}, 2, 1, []int{1, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := &storageLocal.Storage{BaseDir: t.TempDir()}
+			fs.MakeDir(services.SitemapDir)
+
+			for _, page := range tt.pages {
+				fs.Put(sitemapPath(page), "<urlset/>")
+			}
+
+			if removed := services.PruneArticleSitemaps(fs, tt.fromPage); removed != tt.removed {
+				t.Errorf("Expected %d removed sitemaps, got %d", tt.removed, removed)
+			}
+
+			for _, page := range tt.pages {
+				kept := false
+				for _, remaining := range tt.remaining {
+					kept = kept || remaining == page
+				}
+
+				if fs.Exists(sitemapPath(page)) != kept {
+					t.Errorf("Expected page %d kept %v", page, kept)
+				}
+			}
+		})
+	}
+}
+
+func sitemapPath(page int) string {
+	return fmt.Sprintf("%s/articles-%d.xml", services.SitemapDir, page)
+}
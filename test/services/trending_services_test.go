@@ -0,0 +1,72 @@
+package services
+
+import (
+	"gfly/app/domain/models"
+	"gfly/app/services"
+	"math"
+	"testing"
+	"time"
+
+	dbNull "github.com/gflydev/db/null"
+)
+
+func TestTrendingScore(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	publishedHoursAgo := func(hours int) models.Article {
+		return models.Article{
+			CreatedAt:   now.AddDate(0, -1, 0),
+			PublishedAt: dbNull.Time(now.Add(-time.Duration(hours) * time.Hour)),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		article  models.Article
+		views    int
+		expected float64
+	}{
+		{"Just published", publishedHoursAgo(0), 100, 100 / math.Pow(2, 1.8)},
+		{"Published a day ago", publishedHoursAgo(22), 100, 100 / math.Pow(24, 1.8)},
+		{"Published in the future", publishedHoursAgo(-5), 100, 100 / math.Pow(2, 1.8)},
+		{"Without publishing time", models.Article{CreatedAt: now.Add(-6 * time.Hour)}, 100, 100 / math.Pow(8, 1.8)},
+		{"Without views", publishedHoursAgo(1), 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if score := services.TrendingScore(tt.article, tt.views, now, 2, 1.8); math.Abs(score-tt.expected) > 1e-9 {
+				t.Errorf("Expected score %f, got %f", tt.expected, score)
+			}
+		})
+	}
+}
+
+func TestTrendingScoreRanking(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	article := func(hoursAgo int) models.Article {
+		return models.Article{PublishedAt: dbNull.Time(now.Add(-time.Duration(hoursAgo) * time.Hour))}
+	}
+
+	tests := []struct {
+		name        string
+		higher      models.Article
+		higherViews int
+		lower       models.Article
+		lowerViews  int
+	}{
+		{"More views of the same age", article(3), 200, article(3), 100},
+		{"Newer with the same views", article(1), 100, article(10), 100},
+		{"Fresh story beats an old hit", article(2), 100, article(48), 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			higher := services.TrendingScore(tt.higher, tt.higherViews, now, 2, 1.8)
+			lower := services.TrendingScore(tt.lower, tt.lowerViews, now, 2, 1.8)
+
+			if higher <= lower {
+				t.Errorf("Expected %f to rank above %f", higher, lower)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"testing"
+	"time"
+)
+
+func TestAge(t *testing.T) {
+	date := func(value string) time.Time {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return parsed
+	}
+
+	tests := []struct {
+		name      string
+		birthdate string
+		now       string
+		expected  int
+	}{
+		{"Birthday", "2008-10-19", "2026-10-19", 18},
+		{"DayBeforeBirthday", "2008-10-20", "2026-10-19", 17},
+		{"EarlierMonth", "2008-11-01", "2026-10-19", 17},
+		{"LaterMonth", "2008-09-30", "2026-10-19", 18},
+		{"LeapDayOnFebruary28", "2008-02-29", "2026-02-28", 17},
+		{"LeapDayOnMarch1", "2008-02-29", "2026-03-01", 18},
+		{"NotBornYet", "2030-01-01", "2026-10-19", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := utils.Age(date(test.birthdate), date(test.now))
+			if result != test.expected {
+				t.Errorf("Expected %d, got %d for birthdate %s at %s", test.expected, result, test.birthdate, test.now)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAuditSnapshot(t *testing.T) {
+	snapshot := utils.AuditSnapshot(map[string]any{
+		"id":         7,
+		"roles":      []string{"admin"},
+		"blocked_at": time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC),
+	})
+
+	expected := map[string]any{
+		"id":         float64(7),
+		"roles":      []any{"admin"},
+		"blocked_at": "2024-05-01T08:00:00Z",
+	}
+	if !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("Expected %v, got %v", expected, snapshot)
+	}
+
+	if utils.AuditSnapshot(nil) != nil {
+		t.Errorf("Expected a nil snapshot of a missing target")
+	}
+}
+
+func TestAuditDiff(t *testing.T) {
+	before := utils.AuditSnapshot(map[string]any{"status": "active", "roles": []string{"member"}, "fullname": "Lan"})
+	after := utils.AuditSnapshot(map[string]any{"status": "blocked", "roles": []string{"member"}, "fullname": "Lan"})
+
+	changes := utils.AuditDiff(before, after)
+	expected := map[string]utils.AuditChange{
+		"status": {Before: "active", After: "blocked"},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected %v, got %v", expected, changes)
+	}
+
+	if changes := utils.AuditDiff(before, before); changes != nil {
+		t.Errorf("Expected no change, got %v", changes)
+	}
+
+	// Creation and deletion
+	if changes := utils.AuditDiff(nil, after); len(changes) != 3 || changes["status"].Before != nil || changes["status"].After != "blocked" {
+		t.Errorf("Expected every field to be created, got %v", changes)
+	}
+
+	if changes := utils.AuditDiff(before, nil); len(changes) != 3 || changes["fullname"].Before != "Lan" || changes["fullname"].After != nil {
+		t.Errorf("Expected every field to be removed, got %v", changes)
+	}
+}
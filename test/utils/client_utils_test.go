@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"testing"
+)
+
+func TestDeviceClass(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		expected  string
+	}{
+		{"Desktop", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 Chrome/126.0 Safari/537.36", "desktop"},
+		{"iPhone", "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 Mobile/15E148", "mobile"},
+		{"AndroidPhone", "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 Chrome/126.0 Mobile Safari/537.36", "mobile"},
+		{"AndroidTablet", "Mozilla/5.0 (Linux; Android 13; SM-X710) AppleWebKit/537.36 Chrome/126.0 Safari/537.36", "tablet"},
+		{"iPad", "Mozilla/5.0 (iPad; CPU OS 17_5 like Mac OS X) AppleWebKit/605.1.15 Mobile/15E148", "tablet"},
+		{"Googlebot", "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", "bot"},
+		{"Empty", "", "bot"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := utils.DeviceClass(test.userAgent)
+			if result != test.expected {
+				t.Errorf("Expected %q, got %q for User-Agent: %s", test.expected, result, test.userAgent)
+			}
+		})
+	}
+}
+
+func TestReferrerHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		referrer string
+		expected string
+	}{
+		{"Search", "https://www.google.com/search?q=ghost", "google.com"},
+		{"Port", "http://example.com:8080/page", "example.com"},
+		{"Uppercase", "https://M.Facebook.com/", "m.facebook.com"},
+		{"Direct", "", ""},
+		{"AndroidApp", "android-app://com.google.android.gm/", ""},
+		{"Invalid", "::not a url", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := utils.ReferrerHost(test.referrer)
+			if result != test.expected {
+				t.Errorf("Expected %q, got %q for referrer: %s", test.expected, result, test.referrer)
+			}
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	proxies := []string{"10.0.0.0/8", "192.168.1.5"}
+
+	tests := []struct {
+		name         string
+		remoteIP     string
+		forwardedFor string
+		realIP       string
+		expected     string
+	}{
+		{"Direct", "203.0.113.7", "", "", "203.0.113.7"},
+		{"UntrustedForwardedFor", "203.0.113.7", "198.51.100.1", "", "203.0.113.7"},
+		{"UntrustedRealIP", "203.0.113.7", "", "198.51.100.1", "203.0.113.7"},
+		{"TrustedProxy", "10.1.2.3", "198.51.100.1", "", "198.51.100.1"},
+		{"TrustedProxyIP", "192.168.1.5", "", "198.51.100.1", "198.51.100.1"},
+		{"SpoofedHop", "10.1.2.3", "1.2.3.4, 198.51.100.1", "", "198.51.100.1"},
+		{"ProxyChain", "10.1.2.3", "198.51.100.1, 10.4.5.6", "", "198.51.100.1"},
+		{"InvalidHop", "10.1.2.3", "not-an-ip", "", "10.1.2.3"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := utils.ClientIP(test.remoteIP, test.forwardedFor, test.realIP, proxies)
+			if result != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+
+	if result := utils.ClientIP("10.1.2.3", "198.51.100.1", "", []string{""}); result != "10.1.2.3" {
+		t.Errorf("Expected headers to be ignored without trusted proxies, got %q", result)
+	}
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []utils.Cursor{
+		{OrderBy: "-published_at", Value: "2024-01-02T15:04:05.123456Z", ID: 42},
+		{OrderBy: "title", Value: "Chuyện ma \"đêm\" khuya", ID: 7, Backward: true},
+		{OrderBy: "id", ID: 1},
+	}
+
+	for _, cursor := range tests {
+		encoded := utils.EncodeCursor(cursor)
+
+		decoded, err := utils.DecodeCursor(encoded)
+		if err != nil {
+			t.Fatalf("Unexpected error %v for cursor %+v", err, cursor)
+		}
+
+		if decoded != cursor {
+			t.Errorf("Expected %+v, got %+v", cursor, decoded)
+		}
+	}
+}
+
+func TestDecodeInvalidCursor(t *testing.T) {
+	tests := []string{
+		"",
+		"not a cursor",
+		utils.EncodeCursor(utils.Cursor{OrderBy: "id"}),
+		"eyJvIjoiaWQifQ", // {"o":"id"} without ID
+	}
+
+	for _, value := range tests {
+		if _, err := utils.DecodeCursor(value); err == nil {
+			t.Errorf("Expected error for cursor %q", value)
+		}
+	}
+}
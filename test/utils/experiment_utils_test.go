@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestBucket(t *testing.T) {
+	if utils.Bucket("user:42", 12) != utils.Bucket("user:42", 12) {
+		t.Errorf("Expected the same bucket for the same identifier")
+	}
+
+	counts := make([]int, 4)
+	for i := 0; i < 4000; i++ {
+		bucket := utils.Bucket("visitor-"+strconv.Itoa(i), 4)
+		if bucket < 0 || bucket > 3 {
+			t.Fatalf("Bucket %d out of range", bucket)
+		}
+		counts[bucket]++
+	}
+
+	for bucket, count := range counts {
+		if count < 800 || count > 1200 {
+			t.Errorf("Expected about 1000 identifiers in bucket %d, got %d", bucket, count)
+		}
+	}
+
+	if utils.Bucket("user:42", 0) != 0 {
+		t.Errorf("Expected bucket 0 without buckets")
+	}
+}
+
+func TestClickThroughRate(t *testing.T) {
+	if rate := utils.ClickThroughRate(25, 1000); rate != 0.025 {
+		t.Errorf("Expected 0.025, got %v", rate)
+	}
+
+	if rate := utils.ClickThroughRate(3, 0); rate != 0 {
+		t.Errorf("Expected 0 without impressions, got %v", rate)
+	}
+}
+
+func TestProportionConfidence(t *testing.T) {
+	tests := []struct {
+		name                              string
+		controlClicks, controlImpressions int
+		variantClicks, variantImpressions int
+		expected                          float64
+	}{
+		// z = 2.10 => 96.45%
+		{"Significant", 100, 1000, 130, 1000, 0.9645},
+		{"SameRate", 50, 1000, 50, 1000, 0},
+		{"SmallSample", 1, 10, 2, 10, 0.4688},
+		{"NoImpressions", 0, 0, 10, 100, 0},
+		{"NoClicks", 0, 100, 0, 100, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := utils.ProportionConfidence(test.controlClicks, test.controlImpressions, test.variantClicks, test.variantImpressions)
+			if math.Abs(result-test.expected) > 0.0005 {
+				t.Errorf("Expected %.4f, got %.4f", test.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"testing"
+)
+
+func TestMeasureGraphQL(t *testing.T) {
+	sizes := map[string]int{"articles": 10}
+
+	tests := []struct {
+		name      string
+		query     string
+		operation string
+		variables map[string]any
+		expected  utils.GraphQLCost
+	}{
+		{
+			name:     "Flat fields",
+			query:    `{ categories { id name } }`,
+			expected: utils.GraphQLCost{Depth: 2, Complexity: 3},
+		},
+		{
+			name:     "Default page size",
+			query:    `{ articles { items { id author { fullname } } } }`,
+			expected: utils.GraphQLCost{Depth: 4, Complexity: 1 + 10*(1+2+1)},
+		},
+		{
+			name:     "Page size argument",
+			query:    `{ articles(perPage: 3) { items { id } } }`,
+			expected: utils.GraphQLCost{Depth: 3, Complexity: 1 + 3*2},
+		},
+		{
+			name:      "Page size variable",
+			query:     `query List($size: Int) { trending(limit: $size) { id title } }`,
+			variables: map[string]any{"size": float64(5)},
+			expected:  utils.GraphQLCost{Depth: 2, Complexity: 1 + 5*2},
+		},
+		{
+			name:     "Fragments",
+			query:    `{ article(slug: "a") { ...card ... on Article { slug } } } fragment card on Article { id author { id } }`,
+			expected: utils.GraphQLCost{Depth: 3, Complexity: 1 + 1 + 2 + 1},
+		},
+		{
+			name:     "Introspection is free",
+			query:    `{ __schema { types { name fields { name type { ofType { ofType { name } } } } } } categories { id } }`,
+			expected: utils.GraphQLCost{Depth: 2, Complexity: 2},
+		},
+		{
+			name:      "Named operation",
+			query:     `query A { categories { id } } query B { articles { items { id } } }`,
+			operation: "A",
+			expected:  utils.GraphQLCost{Depth: 2, Complexity: 2},
+		},
+		{
+			name:     "Largest operation",
+			query:    `query A { categories { id } } query B { articles { items { id } } }`,
+			expected: utils.GraphQLCost{Depth: 3, Complexity: 1 + 10*2},
+		},
+		{
+			name:     "Huge page size",
+			query:    `{ articles(perPage: 99999999999999999999) { items { articles(perPage: 99999999999999999999) { items { id } } } } }`,
+			expected: utils.GraphQLCost{Depth: 5, Complexity: 1 + 10000*(1+1+10000*2)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cost, err := utils.MeasureGraphQL(test.query, test.operation, test.variables, sizes)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if cost != test.expected {
+				t.Errorf("Expected %+v, got %+v", test.expected, cost)
+			}
+		})
+	}
+}
+
+func TestMeasureGraphQLErrors(t *testing.T) {
+	if _, err := utils.MeasureGraphQL(`{ articles { `, "", nil, nil); err == nil {
+		t.Errorf("Expected a syntax error")
+	}
+
+	if _, err := utils.MeasureGraphQL(`query A { categories { id } }`, "B", nil, nil); err == nil {
+		t.Errorf("Expected an error for an unknown operation")
+	}
+
+	// A fragment cycle is reported by the validation of the query, it must not loop here
+	if _, err := utils.MeasureGraphQL(`{ ...a } fragment a on Query { ...b } fragment b on Query { ...a }`, "", nil, nil); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"testing"
+)
+
+func TestSanitizeHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"Formatting", `<p>Hello <strong>ghost</strong></p>`, `<p>Hello <strong>ghost</strong></p>`},
+		{"Script", `<p>Hi</p><script>alert(1)</script>`, `<p>Hi</p>`},
+		{"EventHandler", `<p onclick="alert(1)">Hi</p>`, `<p>Hi</p>`},
+		{"JavaScriptLink", `<a href="javascript:alert(1)">x</a>`, `<a>x</a>`},
+		{"SafeLink", `<a href="https://example.com" target="_blank">x</a>`, `<a href="https://example.com">x</a>`},
+		{"UnknownTag", `<p><font color="red">Hi</font></p>`, `<p>Hi</p>`},
+		{"UnclosedTag", `<p>Hi <em>there`, `<p>Hi <em>there</em></p>`},
+		{"EscapedText", `<p>1 &lt; 2</p>`, `<p>1 &lt; 2</p>`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := utils.SanitizeHTML(test.content)
+			if result != test.expected {
+				t.Errorf("Expected %q, got %q for content: %s", test.expected, result, test.content)
+			}
+		})
+	}
+}
+
+func TestTeaserHTML(t *testing.T) {
+	content := `<p>First paragraph.</p><p>Second paragraph.</p><p>Third paragraph.</p>`
+
+	tests := []struct {
+		name     string
+		content  string
+		maxChars int
+		expected string
+		cut      bool
+	}{
+		{"FirstParagraph", content, 5, `<p>First paragraph.</p>`, true},
+		{"TwoParagraphs", content, 20, `<p>First paragraph.</p><p>Second paragraph.</p>`, true},
+		{"WholeContent", content, 1000, content, false},
+		{"NoLimit", content, 0, content, false},
+		{"TrailingSpace", "<p>First paragraph.</p>\n", 20, `<p>First paragraph.</p>`, false},
+		{"NestedList", `<ul><li>One</li><li>Two</li></ul><p>After</p>`, 2, `<ul><li>One</li></ul>`, true},
+		{"WrappedContent", `<div>` + content + `</div>`, 5, `<div><p>First paragraph.</p></div>`, true},
+		{"PlainText", `Once upon a time there was a ghost.`, 12, `Once upon a…`, true},
+		{"SingleParagraph", `<div><p>Once upon a time there was a ghost.</p></div>`, 12, `<div><p>Once upon a…</p></div>`, true},
+		{"EndOfWrapper", `<div><p>Once upon a time.</p></div>`, 17, `<div><p>Once upon a time.</p></div>`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, cut := utils.TeaserHTML(test.content, test.maxChars)
+			if result != test.expected || cut != test.cut {
+				t.Errorf("Expected %q (cut %v), got %q (cut %v)", test.expected, test.cut, result, cut)
+			}
+		})
+	}
+}
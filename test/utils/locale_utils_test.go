@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"testing"
+)
+
+func TestNegotiateLocale(t *testing.T) {
+	supported := []string{"vi", "en"}
+
+	tests := []struct {
+		lang           string
+		acceptLanguage string
+		expected       string
+	}{
+		{"", "", "vi"},
+		{"en", "vi-VN,vi;q=0.9", "en"},
+		{"EN-us", "", "en"},
+		{"fr", "", "vi"},
+		{"fr", "en-GB,en;q=0.8", "en"},
+		{"", "fr-FR,fr;q=0.9,en;q=0.8,vi;q=0.7", "en"},
+		{"", "vi;q=0.5,en;q=0.9", "en"},
+		{"", "en;q=0,fr", "vi"},
+		{"", "*", "vi"},
+		{"", "not a language header;;", "vi"},
+	}
+
+	for _, test := range tests {
+		if locale := utils.NegotiateLocale(test.lang, test.acceptLanguage, supported, "vi"); locale != test.expected {
+			t.Errorf("NegotiateLocale(%q, %q) = %q, expected %q", test.lang, test.acceptLanguage, locale, test.expected)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"slices"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"Simple", "Hello World", "hello-world"},
+		{"Vietnamese", "Chuyện ma đêm khuya", "chuyen-ma-dem-khuya"},
+		{"UppercaseD", "Đường Về Nhà", "duong-ve-nha"},
+		{"Punctuation", "  Ghost -- Story!! (Part 2) ", "ghost-story-part-2"},
+		{"AlreadySlug", "how-to-build-go-web-application", "how-to-build-go-web-application"},
+		{"OnlySymbols", "!!!", ""},
+		{"Empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := utils.Slugify(test.text)
+			if result != test.expected {
+				t.Errorf("Expected %q, got %q for text: %s", test.expected, result, test.text)
+			}
+		})
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{"Simple", "violence,gore", []string{"violence", "gore"}},
+		{"Spaces", " violence , gore ", []string{"violence", "gore"}},
+		{"EmptyItems", "violence,,gore,", []string{"violence", "gore"}},
+		{"Duplicates", "gore,violence,gore", []string{"gore", "violence"}},
+		{"Empty", "", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := utils.SplitList(test.value)
+			if !slices.Equal(result, test.expected) {
+				t.Errorf("Expected %v, got %v for value: %q", test.expected, result, test.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"testing"
+)
+
+func TestParseYouTubeURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		expected  bool
+		videoID   string
+		startTime int
+		embedURL  string
+	}{
+		{"Watch", "https://www.youtube.com/watch?v=dQw4w9WgXcQ", true, "dQw4w9WgXcQ", 0, "https://www.youtube.com/embed/dQw4w9WgXcQ"},
+		{"WatchMobile", "https://m.youtube.com/watch?v=dQw4w9WgXcQ&feature=share", true, "dQw4w9WgXcQ", 0, "https://www.youtube.com/embed/dQw4w9WgXcQ"},
+		{"WatchNoScheme", "youtube.com/watch?v=dQw4w9WgXcQ", true, "dQw4w9WgXcQ", 0, "https://www.youtube.com/embed/dQw4w9WgXcQ"},
+		{"ShortLink", "https://youtu.be/dQw4w9WgXcQ", true, "dQw4w9WgXcQ", 0, "https://www.youtube.com/embed/dQw4w9WgXcQ"},
+		{"ShortLinkWithSeconds", "https://youtu.be/dQw4w9WgXcQ?t=90", true, "dQw4w9WgXcQ", 90, "https://www.youtube.com/embed/dQw4w9WgXcQ?start=90"},
+		{"WatchWithDuration", "https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=1m30s", true, "dQw4w9WgXcQ", 90, "https://www.youtube.com/embed/dQw4w9WgXcQ?start=90"},
+		{"WatchWithHours", "https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=1h2m3s", true, "dQw4w9WgXcQ", 3723, "https://www.youtube.com/embed/dQw4w9WgXcQ?start=3723"},
+		{"Shorts", "https://www.youtube.com/shorts/dQw4w9WgXcQ", true, "dQw4w9WgXcQ", 0, "https://www.youtube.com/embed/dQw4w9WgXcQ"},
+		{"Embed", "https://www.youtube.com/embed/dQw4w9WgXcQ?start=10", true, "dQw4w9WgXcQ", 10, "https://www.youtube.com/embed/dQw4w9WgXcQ?start=10"},
+		{"NoCookie", "https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ", true, "dQw4w9WgXcQ", 0, "https://www.youtube.com/embed/dQw4w9WgXcQ"},
+		{"Live", "https://www.youtube.com/live/dQw4w9WgXcQ", true, "dQw4w9WgXcQ", 0, "https://www.youtube.com/embed/dQw4w9WgXcQ"},
+		{"InvalidID", "https://www.youtube.com/watch?v=abc", false, "", 0, ""},
+		{"InvalidChannel", "https://www.youtube.com/@channel", false, "", 0, ""},
+		{"InvalidHost", "https://example.com/watch?v=dQw4w9WgXcQ", false, "", 0, ""},
+		{"InvalidScheme", "ftp://youtu.be/dQw4w9WgXcQ", false, "", 0, ""},
+		{"Empty", "", false, "", 0, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			video, ok := utils.ParseYouTubeURL(test.url)
+			if ok != test.expected {
+				t.Fatalf("Expected %v, got %v for URL: %s", test.expected, ok, test.url)
+			}
+
+			if !ok {
+				return
+			}
+
+			if video.Provider != utils.VideoProviderYouTube || video.VideoID != test.videoID || video.StartTime != test.startTime || video.EmbedURL != test.embedURL {
+				t.Errorf("Unexpected video %+v for URL: %s", *video, test.url)
+			}
+
+			if video.CanonicalURL != "https://www.youtube.com/watch?v="+test.videoID {
+				t.Errorf("Unexpected canonical URL %s for URL: %s", video.CanonicalURL, test.url)
+			}
+		})
+	}
+}
+
+func TestParseTikTokURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		expected     bool
+		videoID      string
+		shortCode    string
+		canonicalURL string
+	}{
+		{"Video", "https://www.tiktok.com/@scout2015/video/6718335390845095173", true, "6718335390845095173", "", "https://www.tiktok.com/@scout2015/video/6718335390845095173"},
+		{"VideoWithQuery", "https://www.tiktok.com/@scout2015/video/6718335390845095173?is_from_webapp=1", true, "6718335390845095173", "", "https://www.tiktok.com/@scout2015/video/6718335390845095173"},
+		{"Mobile", "https://m.tiktok.com/v/6718335390845095173.html", true, "6718335390845095173", "", "https://www.tiktok.com/video/6718335390845095173"},
+		{"Embed", "https://www.tiktok.com/embed/v2/6718335390845095173", true, "6718335390845095173", "", "https://www.tiktok.com/video/6718335390845095173"},
+		{"Player", "https://www.tiktok.com/player/v1/6718335390845095173", true, "6718335390845095173", "", "https://www.tiktok.com/video/6718335390845095173"},
+		{"ShortLink", "https://vm.tiktok.com/ZMeAbCdEf/", true, "", "ZMeAbCdEf", "https://vm.tiktok.com/ZMeAbCdEf/"},
+		{"ShortLinkVT", "vt.tiktok.com/ZSabc123", true, "", "ZSabc123", "https://vm.tiktok.com/ZSabc123/"},
+		{"ShortLinkPath", "https://www.tiktok.com/t/ZTRabc123/", true, "", "ZTRabc123", "https://vm.tiktok.com/ZTRabc123/"},
+		{"InvalidProfile", "https://www.tiktok.com/@scout2015", false, "", "", ""},
+		{"InvalidID", "https://www.tiktok.com/@scout2015/video/abc", false, "", "", ""},
+		{"InvalidHost", "https://youtube.com/@scout2015/video/6718335390845095173", false, "", "", ""},
+		{"Empty", "", false, "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			video, ok := utils.ParseTikTokURL(test.url)
+			if ok != test.expected {
+				t.Fatalf("Expected %v, got %v for URL: %s", test.expected, ok, test.url)
+			}
+
+			if !ok {
+				return
+			}
+
+			if video.Provider != utils.VideoProviderTikTok || video.VideoID != test.videoID || video.ShortCode != test.shortCode || video.CanonicalURL != test.canonicalURL {
+				t.Errorf("Unexpected video %+v for URL: %s", *video, test.url)
+			}
+
+			if test.videoID != "" && video.EmbedURL != "https://www.tiktok.com/embed/v2/"+test.videoID {
+				t.Errorf("Unexpected embed URL %s for URL: %s", video.EmbedURL, test.url)
+			}
+
+			if test.videoID == "" && video.EmbedURL != "" {
+				t.Errorf("Expected empty embed URL for short link: %s", test.url)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package utils
+
+import (
+	"gfly/app/utils"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSignWebhook(t *testing.T) {
+	timestamp := time.Unix(1700000000, 0)
+	body := []byte(`{"event":"article.published"}`)
+
+	// HMAC-SHA256("secret", "1700000000.{"event":"article.published"}")
+	signature := utils.SignWebhook("secret", timestamp, body)
+	if signature != "t=1700000000,v1=232e72872fb999d09181a95b88968a619c1b47fdc1471ec8d41f231661e4b46c" {
+		t.Fatalf("Unexpected signature %q", signature)
+	}
+
+	if !utils.VerifyWebhook("secret", signature, body, 5*time.Minute, timestamp.Add(time.Minute)) {
+		t.Errorf("Expected the signature to be valid")
+	}
+
+	if utils.VerifyWebhook("other", signature, body, 0, timestamp) {
+		t.Errorf("Expected another secret to be rejected")
+	}
+
+	if utils.VerifyWebhook("secret", signature, []byte(`{"event":"article.deleted"}`), 0, timestamp) {
+		t.Errorf("Expected a changed body to be rejected")
+	}
+
+	if utils.VerifyWebhook("secret", signature, body, 5*time.Minute, timestamp.Add(time.Hour)) {
+		t.Errorf("Expected an old signature to be rejected")
+	}
+
+	if utils.VerifyWebhook("secret", "v1=abc", body, 0, timestamp) {
+		t.Errorf("Expected a signature without timestamp to be rejected")
+	}
+}
+
+func TestSendWebhook(t *testing.T) {
+	var received *http.Request
+	var receivedBody []byte
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer receiver.Close()
+
+	body := []byte(`{"id":"12","event":"article.published","data":{"id":7}}`)
+	response, err := utils.SendWebhook(receiver.Client(), utils.WebhookRequest{
+		URL:        receiver.URL,
+		Secret:     "s3cr3t",
+		Event:      "article.published",
+		DeliveryID: "12",
+		Body:       body,
+		Timestamp:  time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.StatusCode != http.StatusAccepted || response.Body != "ok" {
+		t.Errorf("Expected 202 ok, got %d %q", response.StatusCode, response.Body)
+	}
+
+	if received.Method != http.MethodPost || received.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Expected a JSON POST, got %s %s", received.Method, received.Header.Get("Content-Type"))
+	}
+
+	if received.Header.Get(utils.WebhookEventHeader) != "article.published" || received.Header.Get(utils.WebhookDeliveryHeader) != "12" {
+		t.Errorf("Expected the event and delivery headers, got %v", received.Header)
+	}
+
+	if string(receivedBody) != string(body) {
+		t.Errorf("Expected the payload to be sent as is, got %s", receivedBody)
+	}
+
+	if !utils.VerifyWebhook("s3cr3t", received.Header.Get(utils.WebhookSignatureHeader), receivedBody, 5*time.Minute, time.Now()) {
+		t.Errorf("Expected the receiver to verify the signature")
+	}
+}
+
+func TestSendWebhookUnreachable(t *testing.T) {
+	receiver := httptest.NewServer(http.NotFoundHandler())
+	url := receiver.URL
+	receiver.Close()
+
+	if _, err := utils.SendWebhook(http.DefaultClient, utils.WebhookRequest{URL: url, Body: []byte("{}")}); err == nil {
+		t.Errorf("Expected an error for an unreachable receiver")
+	}
+}
+
+func TestSendWebhookPrivateTarget(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	client := utils.NewWebhookClient(time.Second)
+	if _, err := utils.SendWebhook(client, utils.WebhookRequest{URL: receiver.URL, Body: []byte("{}")}); err == nil {
+		t.Errorf("Expected a loopback receiver to be refused")
+	}
+}
+
+func TestCheckWebhookURL(t *testing.T) {
+	tests := []struct {
+		url       string
+		allowHTTP bool
+		valid     bool
+	}{
+		{"https://bot.example.com/hooks", false, true},
+		{"https://93.184.216.34:8443/hooks", false, true},
+		{"http://bot.example.com/hooks", false, false},
+		{"http://bot.example.com/hooks", true, true},
+		{"ftp://bot.example.com/hooks", true, false},
+		{"/hooks", true, false},
+		{"https://localhost/hooks", false, false},
+		{"https://api.localhost./hooks", false, false},
+		{"https://127.0.0.1/hooks", false, false},
+		{"https://10.1.2.3/hooks", false, false},
+		{"https://169.254.169.254/latest/meta-data", false, false},
+		{"https://[::1]/hooks", false, false},
+		{"https://[fd00::1]/hooks", false, false},
+	}
+
+	for _, test := range tests {
+		if err := utils.CheckWebhookURL(test.url, test.allowHTTP); (err == nil) != test.valid {
+			t.Errorf("%s (allow http %v): expected valid %v, got %v", test.url, test.allowHTTP, test.valid, err)
+		}
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip     string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"10.0.0.1", false},
+		{"172.16.5.4", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"255.255.255.255", false},
+		{"224.0.0.1", false},
+		{"::1", false},
+		{"fe80::1", false},
+		{"fc00::1", false},
+		{"::ffff:127.0.0.1", false},
+	}
+
+	for _, test := range tests {
+		if public := utils.IsPublicIP(net.ParseIP(test.ip)); public != test.public {
+			t.Errorf("%s: expected public %v, got %v", test.ip, test.public, public)
+		}
+	}
+}
+
+func TestWebhookDialControl(t *testing.T) {
+	if err := utils.WebhookDialControl("tcp", "93.184.216.34:443", nil); err != nil {
+		t.Errorf("Expected a public address to be accepted, got %v", err)
+	}
+
+	if err := utils.WebhookDialControl("tcp", "169.254.169.254:80", nil); err == nil {
+		t.Errorf("Expected the metadata address to be refused")
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{6, 32 * time.Minute},
+		{10, time.Hour},
+		{0, time.Minute},
+	}
+
+	for _, test := range tests {
+		if delay := utils.WebhookBackoff(test.attempt, time.Minute, time.Hour); delay != test.expected {
+			t.Errorf("Attempt %d: expected %v, got %v", test.attempt, test.expected, delay)
+		}
+	}
+}